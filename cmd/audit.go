@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/keep-network/keep-common/pkg/logging"
+	"github.com/keep-network/keep-ecdsa/config"
+	"github.com/keep-network/keep-ecdsa/pkg/audit"
+	"github.com/urfave/cli"
+)
+
+// AuditCommand contains the definition of the `audit` command-line
+// subcommand and its own subcommands.
+var AuditCommand cli.Command
+
+func init() {
+	AuditCommand = cli.Command{
+		Name:  "audit",
+		Usage: "Provides tools to inspect the audit log of signatures",
+		Before: func(c *cli.Context) error {
+			// disable the regular logger
+			_ = logging.Configure("keep*=fatal")
+			return nil
+		},
+		Subcommands: []cli.Command{
+			{
+				Name: "verify",
+				Usage: "Verifies the chain of hashes of the audit log " +
+					"and the operator signatures of the records; the log " +
+					"and the operator address are taken from flags or " +
+					"from the configuration. Prints the number of entries " +
+					"and the hash of the last entry which should be " +
+					"compared with the one printed by the previous " +
+					"verification",
+				Action: VerifyAuditLog,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "file,f",
+						Usage: "Audit log file",
+					},
+					cli.StringFlag{
+						Name: "operator,o",
+						Usage: "Address of the operator signing the " +
+							"audit log; defaults to the address of the " +
+							"configured Ethereum key file",
+					},
+				},
+			},
+		},
+	}
+}
+
+// VerifyAuditLog verifies the chain of hashes of the audit log and the
+// operator signatures of its records. The log can be verified offline, it does
+// not require any connection, the storage encryption key nor the password of
+// the operator key file.
+func VerifyAuditLog(c *cli.Context) error {
+	auditLogFile := c.String("file")
+	operator := c.String("operator")
+
+	if auditLogFile == "" || operator == "" {
+		config, err := config.ReadConfig(c.GlobalString("config"))
+		if err != nil {
+			return fmt.Errorf("failed while reading config file: [%v]", err)
+		}
+
+		if auditLogFile == "" {
+			auditLogFile = config.Storage.GetAuditLogFile()
+		}
+
+		if operator == "" {
+			operator, err = keyFileAddress(config.Ethereum.Account.KeyFile)
+			if err != nil {
+				return fmt.Errorf(
+					"failed to read operator address from key file: [%v]",
+					err,
+				)
+			}
+		}
+	}
+
+	if !common.IsHexAddress(operator) {
+		return fmt.Errorf("invalid operator address: [%s]", operator)
+	}
+
+	entries, lastHash, err := audit.Verify(
+		auditLogFile,
+		common.HexToAddress(operator),
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"audit log verification failed after [%d] entries: [%v]",
+			entries,
+			err,
+		)
+	}
+
+	fmt.Printf("verified entries: [%d]\n", entries)
+	fmt.Printf("last entry hash:  [%s]\n", lastHash)
+
+	return nil
+}
+
+// keyFileAddress reads the address of the account from the Ethereum key file.
+// The address is stored in the key file in plain text so the key file does not
+// need to be decrypted.
+func keyFileAddress(keyFile string) (string, error) {
+	// #nosec G304 (file path provided as taint input)
+	// The path is provided in the client configuration.
+	content, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return "", err
+	}
+
+	key := struct {
+		Address string `json:"address"`
+	}{}
+	if err := json.Unmarshal(content, &key); err != nil {
+		return "", err
+	}
+
+	return key.Address, nil
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-ecdsa/config"
+	"github.com/urfave/cli"
+)
+
+// KeepsCommand contains the definition of the `keeps` command-line
+// subcommand and its own subcommands.
+var KeepsCommand cli.Command
+
+func init() {
+	KeepsCommand = cli.Command{
+		Name: "keeps",
+		Usage: "Manages keeps of the running client through the admin API; " +
+			"the admin port has to be configured",
+		Subcommands: []cli.Command{
+			{
+				Name: "refresh",
+				Usage: "Refreshes key shares of the keep with all other " +
+					"members of the keep; the group public key does not " +
+					"change",
+				ArgsUsage: "[keep-address]",
+				Action:    RefreshKeep,
+			},
+		},
+	}
+}
+
+// RefreshKeep requests the running client to refresh key shares of the keep.
+// The key refresh is executed by the client in the background.
+func RefreshKeep(c *cli.Context) error {
+	keepAddressHex := c.Args().First()
+	if !common.IsHexAddress(keepAddressHex) {
+		return fmt.Errorf("invalid keep address [%s]", keepAddressHex)
+	}
+	keepAddress := common.HexToAddress(keepAddressHex)
+
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	if config.Diagnostics.AdminPort == 0 {
+		return fmt.Errorf("admin port is not configured")
+	}
+
+	if err := postKeepAction(
+		config.Diagnostics.AdminPort,
+		keepAddress,
+		"refresh",
+	); err != nil {
+		return err
+	}
+
+	fmt.Printf("key refresh of keep [%s] started\n", keepAddress.Hex())
+
+	return nil
+}
+
+func postKeepAction(
+	adminPort int,
+	keepAddress common.Address,
+	action string,
+) error {
+	url := fmt.Sprintf(
+		"http://127.0.0.1:%d/keeps/%s/%s",
+		adminPort,
+		keepAddress.Hex(),
+		action,
+	)
+
+	response, err := http.Post(url, "application/json", nil)
+	if err != nil {
+		return fmt.Errorf("could not connect to the admin API: [%v]", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("could not read the admin API response: [%v]", err)
+	}
+
+	var apiError struct {
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(body, &apiError); err != nil || apiError.Error == "" {
+		return fmt.Errorf(
+			"admin API responded with status [%d]",
+			response.StatusCode,
+		)
+	}
+
+	return fmt.Errorf("%s action failed: [%s]", action, apiError.Error)
+}
@@ -22,7 +22,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
-	"github.com/keep-network/keep-common/pkg/persistence"
 	"github.com/keep-network/keep-ecdsa/config"
 	"github.com/keep-network/keep-ecdsa/pkg/registry"
 	"github.com/urfave/cli"
@@ -79,20 +78,16 @@ func DecryptKeyShare(c *cli.Context) error {
 
 	keepAddress := common.HexToAddress(keepAddressHex)
 
-	handle, err := persistence.NewDiskHandle(config.Storage.DataDir)
+	storage, err := initializeStorage(config)
 	if err != nil {
 		return fmt.Errorf(
-			"failed while creating a storage disk handler: [%v]",
+			"failed while creating a storage: [%v]",
 			err,
 		)
 	}
+	defer storage.Close()
 
-	persistence := persistence.NewEncryptedPersistence(
-		handle,
-		config.Ethereum.Account.KeyFilePassword,
-	)
-
-	keepRegistry := registry.NewKeepsRegistry(persistence)
+	keepRegistry := registry.NewKeepsRegistry(storage)
 
 	keepRegistry.LoadExistingKeeps()
 
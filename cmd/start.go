@@ -6,9 +6,10 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/keep-network/keep-ecdsa/pkg/diagnostics"
 	"github.com/keep-network/keep-ecdsa/pkg/metrics"
 
-	"github.com/keep-network/keep-core/pkg/diagnostics"
+	coreDiagnostics "github.com/keep-network/keep-core/pkg/diagnostics"
 
 	"github.com/keep-network/keep-core/pkg/chain"
 	coreMetrics "github.com/keep-network/keep-core/pkg/metrics"
@@ -17,7 +18,6 @@ import (
 	"github.com/ipfs/go-log"
 
 	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
-	"github.com/keep-network/keep-common/pkg/persistence"
 
 	"github.com/keep-network/keep-core/pkg/net/key"
 	"github.com/keep-network/keep-core/pkg/net/libp2p"
@@ -25,6 +25,10 @@ import (
 	"github.com/keep-network/keep-core/pkg/operator"
 
 	"github.com/keep-network/keep-ecdsa/config"
+	"github.com/keep-network/keep-ecdsa/pkg/admin"
+	"github.com/keep-network/keep-ecdsa/pkg/audit"
+	"github.com/keep-network/keep-ecdsa/pkg/authorization"
+	"github.com/keep-network/keep-ecdsa/pkg/bond"
 	"github.com/keep-network/keep-ecdsa/pkg/chain/ethereum"
 	"github.com/keep-network/keep-ecdsa/pkg/client"
 	"github.com/keep-network/keep-ecdsa/pkg/extensions/tbtc"
@@ -134,6 +138,55 @@ func Start(c *cli.Context) error {
 		)
 	}
 
+	firewallPolicy := firewall.NewStakeOrActiveKeepPolicy(ethereumChain, stakeMonitor)
+
+	var fullyBackedChain *ethereum.FullyBackedEthereumChain
+	if _, err := config.Ethereum.ContractAddress(
+		ethereum.FullyBackedECDSAKeepFactoryContractName,
+	); err == nil {
+		fullyBackedChain, err = ethereum.WithFullyBackedECDSAKeepFactory(
+			ethereumChain,
+		)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to connect to fully-backed keep factory: [%v]",
+				err,
+			)
+		}
+
+		fullyBackedStakeMonitor, err := fullyBackedChain.StakeMonitor()
+		if err != nil {
+			return fmt.Errorf(
+				"error obtaining fully-backed stake monitor handle: [%v]",
+				err,
+			)
+		}
+		hasMinimumBond, err := fullyBackedStakeMonitor.HasMinimumStake(
+			ethereumKey.Address.Hex(),
+		)
+		if err != nil {
+			return fmt.Errorf("could not check the ETH bond: [%v]", err)
+		}
+		if !hasMinimumBond {
+			logger.Errorf(
+				"no minimum ETH bond for fully-backed keeps or operator " +
+					"has not been initialized yet; please make sure the " +
+					"operator has ETH delegated in the bonding contract and " +
+					"the fully-backed factory has been authorized",
+			)
+		}
+
+		// Peers serving only fully-backed keeps have no KEEP stake so they
+		// are let in if they have the minimum ETH bond.
+		firewallPolicy = firewall.AnyOf(
+			firewallPolicy,
+			firewall.NewStakeOrActiveKeepPolicy(
+				fullyBackedChain,
+				fullyBackedStakeMonitor,
+			),
+		)
+	}
+
 	operatorPrivateKey, operatorPublicKey := operator.EthereumKeyToOperatorKey(ethereumKey)
 
 	networkPrivateKey, _ := key.OperatorKeyToNetworkKey(
@@ -145,7 +198,7 @@ func Start(c *cli.Context) error {
 		config.LibP2P,
 		networkPrivateKey,
 		libp2p.ProtocolECDSA,
-		firewall.NewStakeOrActiveKeepPolicy(ethereumChain, stakeMonitor),
+		firewallPolicy,
 		retransmission.NewTimeTicker(ctx, 1*time.Second),
 		libp2p.WithRoutingTableRefreshPeriod(routingTableRefreshPeriod),
 	)
@@ -155,35 +208,80 @@ func Start(c *cli.Context) error {
 
 	nodeHeader(networkProvider.ConnectionManager().AddrStrings(), config.LibP2P.Port)
 
-	handle, err := persistence.NewDiskHandle(config.Storage.DataDir)
+	storage, err := initializeStorage(config)
 	if err != nil {
-		return fmt.Errorf("failed while creating a storage disk handler: [%v]", err)
+		return fmt.Errorf("failed while creating a storage: [%v]", err)
 	}
-	persistence := persistence.NewEncryptedPersistence(
-		handle,
-		config.Ethereum.Account.KeyFilePassword,
+	defer storage.Close()
+
+	auditLog, err := audit.Open(
+		config.Storage.GetAuditLogFile(),
+		ethereumChain.Signing(),
 	)
+	if err != nil {
+		return fmt.Errorf("failed while opening the audit log: [%v]", err)
+	}
+	defer auditLog.Close()
 
 	sanctionedApplications, err := config.SanctionedApplications.Addresses()
 	if err != nil {
 		return fmt.Errorf("failed to get sanctioned applications addresses: [%v]", err)
 	}
 
+	keepFactories := []*client.KeepFactory{
+		{
+			Chain:                  ethereumChain,
+			SanctionedApplications: sanctionedApplications,
+		},
+	}
+
+	if fullyBackedChain != nil {
+		fullyBackedApplications, err := config.SanctionedApplications.FullyBackedAddresses()
+		if err != nil {
+			return fmt.Errorf(
+				"failed to get fully-backed sanctioned applications addresses: [%v]",
+				err,
+			)
+		}
+
+		keepFactories = append(keepFactories, &client.KeepFactory{
+			Chain:                  fullyBackedChain,
+			SanctionedApplications: fullyBackedApplications,
+		})
+	}
+
+	signingPolicies, err := initializeSigningPolicies(
+		config,
+		ethereumChain,
+		keepFactories,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to initialize signing authorization policies: [%v]",
+			err,
+		)
+	}
+
 	clientHandle := client.Initialize(
 		ctx,
 		operatorPublicKey,
 		ethereumChain,
 		networkProvider,
-		persistence,
-		sanctionedApplications,
+		storage,
+		auditLog,
+		signingPolicies,
+		keepFactories,
 		&config.Client,
 		&config.TSS,
 	)
 	logger.Debugf("initialized operator with address: [%s]", ethereumKey.Address.String())
 
-	initializeExtensions(ctx, config.Extensions, ethereumChain)
-	initializeMetrics(ctx, config, networkProvider, stakeMonitor, ethereumKey.Address.Hex(), clientHandle)
-	initializeDiagnostics(config, networkProvider)
+	bondMonitor := initializeBondMonitoring(ctx, config, ethereumChain, keepFactories, clientHandle)
+
+	initializeExtensions(ctx, config.Extensions, ethereumChain, clientHandle)
+	initializeMetrics(ctx, config, networkProvider, stakeMonitor, ethereumKey.Address.Hex(), clientHandle, bondMonitor)
+	initializeDiagnostics(config, networkProvider, bondMonitor, clientHandle)
+	initializeAdminAPI(config, clientHandle)
 	initializeBalanceMonitoring(ctx, ethereumChain, config, ethereumKey.Address.Hex())
 
 	logger.Info("client started")
@@ -198,10 +296,97 @@ func Start(c *cli.Context) error {
 	}
 }
 
+// initializeSigningPolicies creates signing authorization policies enabled in
+// the configuration. The rate limit policy is the last one so that only
+// signing requests authorized by all other policies count towards the limit.
+func initializeSigningPolicies(
+	config *config.Config,
+	ethereumChain *ethereum.EthereumChain,
+	keepFactories []*client.KeepFactory,
+) ([]authorization.Policy, error) {
+	authorizationConfig := config.SigningAuthorization
+
+	policies := make([]authorization.Policy, 0)
+
+	applications, err := authorizationConfig.Applications()
+	if err != nil {
+		return nil, err
+	}
+	if len(applications) > 0 {
+		sources := make([]authorization.KeepApplicationSource, len(keepFactories))
+		for i, keepFactory := range keepFactories {
+			sources[i] = keepFactory.Chain
+		}
+
+		logger.Infof("signing is allowed only for applications [%v]", applications)
+		policies = append(
+			policies,
+			authorization.NewApplicationAllowlistPolicy(sources, applications),
+		)
+	}
+
+	if authorizationConfig.TBTCRedemptionCheck {
+		if len(config.Extensions.TBTC.TBTCSystem) == 0 {
+			return nil, fmt.Errorf(
+				"tbtc redemption check requires the tbtc extension",
+			)
+		}
+
+		tbtcEthereumChain, err := ethereum.WithTBTCExtension(
+			ethereumChain,
+			config.Extensions.TBTC.TBTCSystem,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not initialize tbtc chain extension: [%v]",
+				err,
+			)
+		}
+
+		logger.Infof("signing of tbtc keeps is allowed only for redemptions")
+		policies = append(
+			policies,
+			authorization.NewTBTCRedemptionPolicy(tbtcEthereumChain),
+		)
+	}
+
+	if len(authorizationConfig.ApprovalHook) > 0 {
+		logger.Infof(
+			"signing requests are approved by hook [%s]",
+			authorizationConfig.ApprovalHook,
+		)
+		policies = append(
+			policies,
+			authorization.NewApprovalHookPolicy(
+				authorizationConfig.ApprovalHook,
+				authorizationConfig.GetApprovalHookTimeout(),
+			),
+		)
+	}
+
+	if authorizationConfig.RateLimit > 0 {
+		logger.Infof(
+			"signing is limited to [%d] digests per keep per [%s]",
+			authorizationConfig.RateLimit,
+			authorizationConfig.GetRateLimitPeriod(),
+		)
+		policies = append(
+			policies,
+			authorization.NewRateLimitPolicy(
+				authorizationConfig.RateLimit,
+				authorizationConfig.GetRateLimitPeriod(),
+			),
+		)
+	}
+
+	return policies, nil
+}
+
 func initializeExtensions(
 	ctx context.Context,
 	config config.Extensions,
 	ethereumChain *ethereum.EthereumChain,
+	clientHandle *client.Handle,
 ) {
 	if len(config.TBTC.TBTCSystem) > 0 {
 		tbtcEthereumChain, err := ethereum.WithTBTCExtension(
@@ -216,7 +401,12 @@ func initializeExtensions(
 			return
 		}
 
-		tbtc.Initialize(ctx, tbtcEthereumChain)
+		tbtc.Initialize(
+			ctx,
+			tbtcEthereumChain,
+			clientHandle.KeepsRegistry(),
+			clientHandle.MetricsCollector(),
+		)
 	}
 }
 
@@ -227,6 +417,7 @@ func initializeMetrics(
 	stakeMonitor chain.StakeMonitor,
 	ethereumAddres string,
 	clientHandle *client.Handle,
+	bondMonitor *bond.Monitor,
 ) {
 	registry, isConfigured := coreMetrics.Initialize(
 		config.Metrics.Port,
@@ -270,13 +461,64 @@ func initializeMetrics(
 		clientHandle,
 		time.Duration(config.Metrics.ClientMetricsTick)*time.Second,
 	)
+
+	metrics.ObserveRewardsWithdrawals(
+		ctx,
+		registry,
+		clientHandle,
+		time.Duration(config.Metrics.ClientMetricsTick)*time.Second,
+	)
+
+	metrics.ObserveActiveKeeps(
+		ctx,
+		registry,
+		clientHandle,
+		time.Duration(config.Metrics.ClientMetricsTick)*time.Second,
+	)
+
+	metrics.ObserveProtocols(
+		ctx,
+		registry,
+		clientHandle,
+		time.Duration(config.Metrics.ClientMetricsTick)*time.Second,
+	)
+
+	metrics.ObserveSigningAuthorization(
+		ctx,
+		registry,
+		clientHandle,
+		time.Duration(config.Metrics.ClientMetricsTick)*time.Second,
+	)
+
+	metrics.ObservePeerReliability(
+		ctx,
+		registry,
+		clientHandle,
+		time.Duration(config.Metrics.ClientMetricsTick)*time.Second,
+	)
+
+	metrics.ObserveExtensionActions(
+		ctx,
+		registry,
+		clientHandle,
+		time.Duration(config.Metrics.ClientMetricsTick)*time.Second,
+	)
+
+	metrics.ObserveBondStatus(
+		ctx,
+		registry,
+		bondMonitor,
+		time.Duration(config.Metrics.ClientMetricsTick)*time.Second,
+	)
 }
 
 func initializeDiagnostics(
 	config *config.Config,
 	netProvider net.Provider,
+	bondMonitor *bond.Monitor,
+	clientHandle *client.Handle,
 ) {
-	registry, isConfigured := diagnostics.Initialize(
+	registry, isConfigured := coreDiagnostics.Initialize(
 		config.Diagnostics.Port,
 	)
 	if !isConfigured {
@@ -289,8 +531,75 @@ func initializeDiagnostics(
 		config.Diagnostics.Port,
 	)
 
-	diagnostics.RegisterConnectedPeersSource(registry, netProvider)
-	diagnostics.RegisterClientInfoSource(registry, netProvider)
+	coreDiagnostics.RegisterConnectedPeersSource(registry, netProvider)
+	coreDiagnostics.RegisterClientInfoSource(registry, netProvider)
+	diagnostics.RegisterBondingSource(registry, bondMonitor)
+	diagnostics.RegisterPeerReliabilitySource(
+		registry,
+		clientHandle.PeerReliability(),
+	)
+}
+
+func initializeAdminAPI(
+	config *config.Config,
+	clientHandle *client.Handle,
+) {
+	isConfigured := admin.Initialize(
+		config.Diagnostics.AdminPort,
+		clientHandle,
+		clientHandle.KeepsRegistry(),
+	)
+	if !isConfigured {
+		logger.Infof("admin API is not configured")
+		return
+	}
+
+	logger.Infof(
+		"enabled admin API on local port [%v]",
+		config.Diagnostics.AdminPort,
+	)
+}
+
+func initializeBondMonitoring(
+	ctx context.Context,
+	config *config.Config,
+	ethereumChain *ethereum.EthereumChain,
+	keepFactories []*client.KeepFactory,
+	clientHandle *client.Handle,
+) *bond.Monitor {
+	// Bonds of BondedECDSAKeepFactory keeps are held by the KeepBonding
+	// contract which address is not required by the client otherwise.
+	_, err := config.Ethereum.ContractAddress(ethereum.KeepBondingContractName)
+	isKeepBondingConfigured := err == nil
+	if !isKeepBondingConfigured {
+		logger.Warningf(
+			"[%s] contract address is not configured; bonds of [%s] "+
+				"keeps will not be monitored",
+			ethereum.KeepBondingContractName,
+			ethereum.BondedECDSAKeepFactoryContractName,
+		)
+	}
+
+	var bondFactories []*bond.Factory
+	for _, keepFactory := range keepFactories {
+		if keepFactory.Chain == ethereumChain && !isKeepBondingConfigured {
+			continue
+		}
+
+		bondFactories = append(bondFactories, &bond.Factory{
+			Chain:        keepFactory.Chain,
+			Applications: keepFactory.SanctionedApplications,
+		})
+	}
+
+	bondMonitor := bond.NewMonitor(
+		bondFactories,
+		clientHandle.KeepsRegistry(),
+		&config.BondMonitoring,
+	)
+	bondMonitor.Start(ctx, config.Client.GetChainRetryPolicy())
+
+	return bondMonitor
 }
 
 func initializeBalanceMonitoring(
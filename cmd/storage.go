@@ -0,0 +1,538 @@
+package cmd
+
+import (
+	cecdsa "crypto/ecdsa"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+	"github.com/keep-network/keep-common/pkg/logging"
+	"github.com/keep-network/keep-core/pkg/operator"
+	"github.com/keep-network/keep-ecdsa/config"
+	"github.com/keep-network/keep-ecdsa/pkg/backup"
+	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/ethereum"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
+	"github.com/urfave/cli"
+)
+
+// NewStorageEncryptionKeyEnvVariable environment variable name for the new
+// hex-encoded storage encryption key used by the key rotation.
+// #nosec G101 (look for hardcoded credentials)
+// This line doesn't contain any credentials.
+// It's just the name of the environment variable.
+const NewStorageEncryptionKeyEnvVariable = "KEEP_STORAGE_NEW_ENCRYPTION_KEY"
+
+// NewStoragePassphraseEnvVariable environment variable name for the new
+// passphrase the storage encryption key is derived from used by the key
+// rotation.
+// #nosec G101 (look for hardcoded credentials)
+// This line doesn't contain any credentials.
+// It's just the name of the environment variable.
+const NewStoragePassphraseEnvVariable = "KEEP_STORAGE_NEW_PASSPHRASE"
+
+// BackupPassphraseEnvVariable environment variable name for the passphrase
+// encrypting and decrypting backups of key shares.
+// #nosec G101 (look for hardcoded credentials)
+// This line doesn't contain any credentials.
+// It's just the name of the environment variable.
+const BackupPassphraseEnvVariable = "KEEP_BACKUP_PASSPHRASE"
+
+// StorageCommand contains the definition of the `storage` command-line
+// subcommand and its own subcommands.
+var StorageCommand cli.Command
+
+func init() {
+	StorageCommand = cli.Command{
+		Name:  "storage",
+		Usage: "Provides tools to manage the storage of key shares",
+		Before: func(c *cli.Context) error {
+			// disable the regular logger
+			_ = logging.Configure("keep*=fatal")
+			return nil
+		},
+		Subcommands: []cli.Command{
+			{
+				Name: "rotate-key",
+				Usage: "Re-encrypts all key shares with a new storage " +
+					"encryption key; the client must be stopped. The new " +
+					"key is read from the file passed as a flag or from " +
+					"the " + NewStorageEncryptionKeyEnvVariable + " or " +
+					NewStoragePassphraseEnvVariable + " environment variables",
+				Action: RotateStorageKey,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "new-key-file",
+						Usage: "File with the new hex-encoded encryption key",
+					},
+				},
+			},
+			{
+				Name: "export",
+				Usage: "Exports key shares of the given keeps, or all keeps " +
+					"if none is given, together with their snapshots to " +
+					"an encrypted backup archive. The archive is encrypted " +
+					"to the backup public key or with the passphrase from " +
+					"the " + BackupPassphraseEnvVariable + " environment " +
+					"variable",
+				ArgsUsage: "[keep-address...]",
+				Action:    ExportKeyShares,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "output-file,o",
+						Usage: "Output file for the backup archive",
+					},
+					cli.StringFlag{
+						Name:  "public-key",
+						Usage: "Hex-encoded secp256k1 backup public key",
+					},
+				},
+			},
+			{
+				Name: "import",
+				Usage: "Imports key shares from the encrypted backup " +
+					"archive; the client must be stopped. Each key share " +
+					"must belong to the operator, who must be a member " +
+					"of the keep, and is validated against the keep " +
+					"public key on-chain before it is written. The " +
+					"archive is decrypted " +
+					"with the backup private key or with the passphrase " +
+					"from the " + BackupPassphraseEnvVariable +
+					" environment variable",
+				ArgsUsage: "[archive-file]",
+				Action:    ImportKeyShares,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "private-key-file",
+						Usage: "File with the hex-encoded secp256k1 backup private key",
+					},
+				},
+			},
+			{
+				Name: "verify",
+				Usage: "Verifies key shares in the storage against their " +
+					"threshold keys and the chain and prints a report of " +
+					"corrupted, orphaned, archived-but-active and " +
+					"active-but-missing keeps; the command fails if any " +
+					"problem is found",
+				Action: VerifyStorage,
+				Flags: []cli.Flag{
+					cli.Int64Flag{
+						Name: "keeps-to-scan",
+						Usage: "Number of the most recently created keeps " +
+							"scanned for active keeps with no key share " +
+							"stored; all keeps are scanned if zero",
+						Value: defaultKeepsToScan,
+					},
+				},
+			},
+		},
+	}
+}
+
+// defaultKeepsToScan is the default number of the most recently created keeps
+// scanned by the storage verification for active keeps with no signer stored.
+const defaultKeepsToScan = 1000
+
+// connectVerificationChain connects to the chain the storage is verified
+// against and returns it with the address of the operator. It is a variable
+// so the chain can be replaced in tests.
+var connectVerificationChain = func(
+	config *config.Config,
+) (registry.VerificationChain, common.Address, error) {
+	ethereumKey, err := ethutil.DecryptKeyFile(
+		config.Ethereum.Account.KeyFile,
+		config.Ethereum.Account.KeyFilePassword,
+	)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf(
+			"failed to read key file [%s]: [%v]",
+			config.Ethereum.Account.KeyFile,
+			err,
+		)
+	}
+
+	ethereumChain, err := ethereum.Connect(ethereumKey, &config.Ethereum)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf(
+			"failed to connect to ethereum node: [%v]",
+			err,
+		)
+	}
+
+	return ethereumChain, ethereumChain.Address(), nil
+}
+
+// RotateStorageKey re-encrypts all records in the storage with a new
+// encryption key.
+func RotateStorageKey(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	currentKey, err := registry.ResolveEncryptionKey(
+		config.StorageEncryptionKeySource(),
+		config.Storage.DataDir,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to resolve current encryption key: [%v]", err)
+	}
+
+	newKeySource := &registry.EncryptionKeySource{
+		KeyFile:    c.String("new-key-file"),
+		Key:        os.Getenv(NewStorageEncryptionKeyEnvVariable),
+		Passphrase: os.Getenv(NewStoragePassphraseEnvVariable),
+	}
+	if !newKeySource.IsDedicated() {
+		return fmt.Errorf("new encryption key is not provided")
+	}
+
+	newKey, err := registry.ResolveEncryptionKey(
+		newKeySource,
+		config.Storage.DataDir,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to resolve new encryption key: [%v]", err)
+	}
+
+	count, err := registry.RotateEncryptionKey(
+		config.Storage.Backend,
+		config.Storage.DataDir,
+		currentKey,
+		newKey,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to rotate encryption key after re-encrypting [%d] "+
+				"records: [%v]",
+			count,
+			err,
+		)
+	}
+
+	fmt.Printf(
+		"re-encrypted and verified [%d] records; update the storage "+
+			"encryption key in the client configuration\n",
+		count,
+	)
+
+	return nil
+}
+
+// ExportKeyShares exports key shares of the given keeps to the encrypted
+// backup archive.
+func ExportKeyShares(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	key := &backup.Key{Passphrase: os.Getenv(BackupPassphraseEnvVariable)}
+	if publicKeyHex := c.String("public-key"); publicKeyHex != "" {
+		publicKeyBytes, err := hex.DecodeString(
+			strings.TrimPrefix(publicKeyHex, "0x"),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to decode public key: [%v]", err)
+		}
+
+		key.PublicKey, err = unmarshalPublicKey(publicKeyBytes)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal public key: [%v]", err)
+		}
+	}
+
+	selectedKeeps := make(map[common.Address]bool)
+	for _, keepAddressHex := range c.Args() {
+		if !common.IsHexAddress(keepAddressHex) {
+			return fmt.Errorf("invalid keep address [%s]", keepAddressHex)
+		}
+		selectedKeeps[common.HexToAddress(keepAddressHex)] = true
+	}
+	isSelected := func(keepAddress common.Address) bool {
+		return len(selectedKeeps) == 0 || selectedKeeps[keepAddress]
+	}
+
+	storage, err := initializeStorage(config)
+	if err != nil {
+		return fmt.Errorf("failed while creating a storage: [%v]", err)
+	}
+	defer storage.Close()
+
+	keepsRegistry := registry.NewKeepsRegistry(storage)
+	keepsRegistry.LoadExistingKeeps()
+
+	for keepAddress := range selectedKeeps {
+		if !keepsRegistry.HasSigner(keepAddress) {
+			return fmt.Errorf("no signer for keep [%s]", keepAddress.String())
+		}
+	}
+
+	var entries []*backup.Entry
+	for _, keepAddress := range keepsRegistry.GetKeepsAddresses() {
+		if !isSelected(keepAddress) {
+			continue
+		}
+
+		signer, err := keepsRegistry.GetSigner(keepAddress)
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, &backup.Entry{
+			KeepAddress: keepAddress,
+			Signer:      signer,
+		})
+	}
+
+	snapshots, snapshotsErrors := storage.ReadSnapshots()
+	go func() {
+		for err := range snapshotsErrors {
+			fmt.Fprintf(os.Stderr, "could not read snapshot: [%v]\n", err)
+		}
+	}()
+	for snapshot := range snapshots {
+		if !isSelected(snapshot.KeepAddress) {
+			continue
+		}
+
+		entries = append(entries, &backup.Entry{
+			KeepAddress: snapshot.KeepAddress,
+			Signer:      snapshot.Signer,
+			Snapshot:    true,
+		})
+	}
+
+	if len(entries) == 0 {
+		return fmt.Errorf("no key shares to export")
+	}
+
+	archive, err := backup.Export(entries, key)
+	if err != nil {
+		return fmt.Errorf("failed to export key shares: [%v]", err)
+	}
+
+	archiveBytes, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal archive: [%v]", err)
+	}
+
+	return outputData(c, archiveBytes, 0600)
+}
+
+// ImportKeyShares imports key shares from the encrypted backup archive.
+func ImportKeyShares(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	archiveBytes, err := ioutil.ReadFile(c.Args().First())
+	if err != nil {
+		return fmt.Errorf("failed to read archive: [%v]", err)
+	}
+
+	archive := &backup.Archive{}
+	if err := json.Unmarshal(archiveBytes, archive); err != nil {
+		return fmt.Errorf("failed to unmarshal archive: [%v]", err)
+	}
+
+	key := &backup.Key{Passphrase: os.Getenv(BackupPassphraseEnvVariable)}
+	if privateKeyFile := c.String("private-key-file"); privateKeyFile != "" {
+		key.PrivateKey, err = crypto.LoadECDSA(privateKeyFile)
+		if err != nil {
+			return fmt.Errorf("failed to load private key: [%v]", err)
+		}
+	}
+
+	entries, err := archive.Decrypt(key)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt archive: [%v]", err)
+	}
+
+	ethereumKey, err := ethutil.DecryptKeyFile(
+		config.Ethereum.Account.KeyFile,
+		config.Ethereum.Account.KeyFilePassword,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to read key file [%s]: [%v]",
+			config.Ethereum.Account.KeyFile,
+			err,
+		)
+	}
+
+	ethereumChain, err := ethereum.Connect(ethereumKey, &config.Ethereum)
+	if err != nil {
+		return fmt.Errorf("failed to connect to ethereum node: [%v]", err)
+	}
+
+	_, operatorPublicKey := operator.EthereumKeyToOperatorKey(ethereumKey)
+
+	storage, err := initializeStorage(config)
+	if err != nil {
+		return fmt.Errorf("failed while creating a storage: [%v]", err)
+	}
+	defer storage.Close()
+
+	keepsRegistry := registry.NewKeepsRegistry(storage)
+	keepsRegistry.LoadExistingKeeps()
+
+	failed := 0
+	for _, entry := range entries {
+		if err := importEntry(
+			ethereumChain,
+			operatorPublicKey,
+			keepsRegistry,
+			entry,
+		); err != nil {
+			fmt.Printf(
+				"could not import key share of keep [%s]: [%v]\n",
+				entry.KeepAddress.String(),
+				err,
+			)
+			failed++
+			continue
+		}
+
+		kind := "key share"
+		if entry.Snapshot {
+			kind = "key share snapshot"
+		}
+		fmt.Printf(
+			"imported %s of keep [%s]\n",
+			kind,
+			entry.KeepAddress.String(),
+		)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf(
+			"could not import [%d] of [%d] key shares",
+			failed,
+			len(entries),
+		)
+	}
+
+	return nil
+}
+
+// VerifyStorage verifies key shares in the storage and prints the report.
+func VerifyStorage(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	verificationChain, operatorAddress, err := connectVerificationChain(config)
+	if err != nil {
+		return err
+	}
+
+	storage, err := initializeStorage(config)
+	if err != nil {
+		return fmt.Errorf("failed while creating a storage: [%v]", err)
+	}
+	defer storage.Close()
+
+	keepsRegistry := registry.NewKeepsRegistry(storage)
+	keepsRegistry.LoadExistingKeeps()
+
+	report, err := keepsRegistry.Verify(
+		verificationChain,
+		operatorAddress,
+		c.Int64("keeps-to-scan"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to verify storage: [%v]", err)
+	}
+
+	for _, loadError := range report.LoadErrors {
+		fmt.Fprintf(
+			c.App.Writer,
+			"record\t%s\t%v\n",
+			registry.KeepCorrupted,
+			loadError,
+		)
+	}
+
+	for _, keepReport := range report.Keeps {
+		fmt.Fprintf(
+			c.App.Writer,
+			"%s\t%s\t%s\n",
+			keepReport.KeepAddress.String(),
+			keepReport.Status,
+			keepReport.Details,
+		)
+	}
+
+	if !report.IsHealthy() {
+		return fmt.Errorf("storage verification found problems")
+	}
+
+	return nil
+}
+
+func importEntry(
+	ethereumChain eth.Handle,
+	operatorPublicKey *operator.PublicKey,
+	keepsRegistry *registry.Keeps,
+	entry *backup.Entry,
+) error {
+	// Both signers and snapshots are validated against the operator and
+	// the chain so a backup of another operator is never written.
+	if err := entry.Validate(ethereumChain, operatorPublicKey); err != nil {
+		return err
+	}
+
+	// Snapshots are not loaded as current signers. They are kept only to let
+	// recover the key share if needed.
+	if entry.Snapshot {
+		return keepsRegistry.SnapshotSigner(entry.KeepAddress, entry.Signer)
+	}
+
+	if keepsRegistry.HasSigner(entry.KeepAddress) {
+		return fmt.Errorf("signer is already registered")
+	}
+
+	return keepsRegistry.RegisterSigner(entry.KeepAddress, entry.Signer)
+}
+
+func unmarshalPublicKey(publicKeyBytes []byte) (*cecdsa.PublicKey, error) {
+	if len(publicKeyBytes) == 33 {
+		return crypto.DecompressPubkey(publicKeyBytes)
+	}
+
+	return crypto.UnmarshalPubkey(publicKeyBytes)
+}
+
+// initializeStorage opens the configured storage using the configured
+// encryption key.
+func initializeStorage(config *config.Config) (registry.Storage, error) {
+	keySource := config.StorageEncryptionKeySource()
+	if !keySource.IsDedicated() {
+		logger.Warningf(
+			"dedicated storage encryption key is not configured; " +
+				"key shares are encrypted with a key derived from the " +
+				"ethereum key file password",
+		)
+	}
+
+	key, err := registry.ResolveEncryptionKey(keySource, config.Storage.DataDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve encryption key: [%v]", err)
+	}
+
+	return registry.NewStorage(
+		config.Storage.Backend,
+		config.Storage.DataDir,
+		key,
+	)
+}
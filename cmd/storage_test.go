@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-ecdsa/config"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/local"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
+	"github.com/urfave/cli"
+)
+
+func TestVerifyStorageCommand(t *testing.T) {
+	var tests = map[string]struct {
+		openKeep       bool
+		expectedError  bool
+		expectedOutput string
+	}{
+		"no keeps": {
+			openKeep:       false,
+			expectedError:  false,
+			expectedOutput: "",
+		},
+		"active keep with no key share stored": {
+			openKeep:       true,
+			expectedError:  true,
+			expectedOutput: registry.KeepActiveButMissing,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancelCtx := context.WithCancel(context.Background())
+			defer cancelCtx()
+
+			configFile := newTestStorageConfig(t)
+			defer os.RemoveAll(filepath.Dir(configFile))
+
+			localChain := local.Connect(ctx)
+			if test.openKeep {
+				localChain.OpenKeep(
+					common.HexToAddress("0x1"),
+					[]common.Address{localChain.Address()},
+				)
+			}
+
+			connectChain := connectVerificationChain
+			defer func() { connectVerificationChain = connectChain }()
+			connectVerificationChain = func(
+				*config.Config,
+			) (registry.VerificationChain, common.Address, error) {
+				return localChain, localChain.Address(), nil
+			}
+
+			output := &bytes.Buffer{}
+
+			app := cli.NewApp()
+			app.Writer = output
+			app.Flags = []cli.Flag{
+				cli.StringFlag{Name: "config,c"},
+			}
+			app.Commands = []cli.Command{StorageCommand}
+
+			err := app.Run([]string{
+				"keep-ecdsa",
+				"--config", configFile,
+				"storage", "verify",
+				"--keeps-to-scan", "10",
+			})
+			if (err != nil) != test.expectedError {
+				t.Fatalf(
+					"unexpected verification result\n"+
+						"expected error: [%v]\nactual error: [%v]",
+					test.expectedError,
+					err,
+				)
+			}
+
+			if !strings.Contains(output.String(), test.expectedOutput) {
+				t.Errorf(
+					"unexpected output\nexpected to contain: [%s]\nactual: [%s]",
+					test.expectedOutput,
+					output.String(),
+				)
+			}
+		})
+	}
+}
+
+func newTestStorageConfig(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "storage-verify")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyFile := filepath.Join(dir, "storage.key")
+	if err := ioutil.WriteFile(
+		keyFile,
+		[]byte(strings.Repeat("ab", registry.KeyLength)),
+		0600,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	dataDir := filepath.Join(dir, "data")
+	if err := os.Mkdir(dataDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	configFile := filepath.Join(dir, "config.toml")
+	if err := ioutil.WriteFile(
+		configFile,
+		[]byte(fmt.Sprintf(
+			"[Storage]\nDataDir = %q\nBackend = %q\nEncryptionKeyFile = %q\n",
+			dataDir,
+			registry.DiskBackend,
+			keyFile,
+		)),
+		0600,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	return configFile
+}
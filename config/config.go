@@ -3,21 +3,43 @@ package config
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/BurntSushi/toml"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/keep-network/keep-common/pkg/chain/ethereum"
 	"github.com/keep-network/keep-core/pkg/net/libp2p"
+	"github.com/keep-network/keep-ecdsa/pkg/authorization"
+	"github.com/keep-network/keep-ecdsa/pkg/bond"
 	"github.com/keep-network/keep-ecdsa/pkg/client"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
 )
 
+// defaultAuditLogFileName is the name of the audit log file created in the
+// data directory if no other file is configured.
+const defaultAuditLogFileName = "audit.log"
+
 // PasswordEnvVariable environment variable name for ethereum key password.
 // #nosec G101 (look for hardcoded credentials)
 // This line doesn't contain any credentials.
 // It's just the name of the environment variable.
 const PasswordEnvVariable = "KEEP_ETHEREUM_PASSWORD"
 
+// StorageEncryptionKeyEnvVariable environment variable name for the
+// hex-encoded key encrypting data in the storage.
+// #nosec G101 (look for hardcoded credentials)
+// This line doesn't contain any credentials.
+// It's just the name of the environment variable.
+const StorageEncryptionKeyEnvVariable = "KEEP_STORAGE_ENCRYPTION_KEY"
+
+// StoragePassphraseEnvVariable environment variable name for the passphrase
+// the key encrypting data in the storage is derived from.
+// #nosec G101 (look for hardcoded credentials)
+// This line doesn't contain any credentials.
+// It's just the name of the environment variable.
+const StoragePassphraseEnvVariable = "KEEP_STORAGE_PASSPHRASE"
+
 // Config is the top level config structure.
 type Config struct {
 	Ethereum               ethereum.Config
@@ -25,6 +47,8 @@ type Config struct {
 	Storage                Storage
 	LibP2P                 libp2p.Config
 	Client                 client.Config
+	BondMonitoring         bond.Config
+	SigningAuthorization   authorization.Config
 	TSS                    tss.Config
 	Metrics                Metrics
 	Diagnostics            Diagnostics
@@ -32,16 +56,31 @@ type Config struct {
 }
 
 // SanctionedApplications contains addresses of applications approved by the
-// operator.
+// operator. Applications are listed separately for each keep factory the
+// client serves keeps of.
 type SanctionedApplications struct {
-	AddressesStrings []string `toml:"Addresses"`
+	AddressesStrings            []string `toml:"Addresses"`
+	FullyBackedAddressesStrings []string `toml:"FullyBackedAddresses"`
 }
 
-// Addresses returns list of sanctioned applications as a slice of ethereum addresses.
+// Addresses returns list of sanctioned applications of BondedECDSAKeepFactory
+// as a slice of ethereum addresses.
 func (sa *SanctionedApplications) Addresses() ([]common.Address, error) {
-	applicationsAddresses := make([]common.Address, len(sa.AddressesStrings))
+	return toApplicationsAddresses(sa.AddressesStrings)
+}
 
-	for i, application := range sa.AddressesStrings {
+// FullyBackedAddresses returns list of sanctioned applications of
+// FullyBackedECDSAKeepFactory as a slice of ethereum addresses.
+func (sa *SanctionedApplications) FullyBackedAddresses() ([]common.Address, error) {
+	return toApplicationsAddresses(sa.FullyBackedAddressesStrings)
+}
+
+func toApplicationsAddresses(
+	applicationsStrings []string,
+) ([]common.Address, error) {
+	applicationsAddresses := make([]common.Address, len(applicationsStrings))
+
+	for i, application := range applicationsStrings {
 		if !common.IsHexAddress(application) {
 			return applicationsAddresses, fmt.Errorf(
 				"application address [%v] is not valid hex address",
@@ -58,6 +97,44 @@ func (sa *SanctionedApplications) Addresses() ([]common.Address, error) {
 // Storage stores meta-info about keeping data on disk
 type Storage struct {
 	DataDir string
+	// Backend is the storage backend keeping signers of keeps. Supported
+	// backends are `disk` (default) and `bolt`.
+	Backend string
+	// EncryptionKeyFile is the file holding the hex-encoded key encrypting
+	// data in the storage.
+	EncryptionKeyFile string
+	// EncryptionKey is the hex-encoded key encrypting data in the storage.
+	// It is expected to be provided as environment variable.
+	EncryptionKey string
+	// EncryptionPassphrase is the passphrase the key encrypting data in the
+	// storage is derived from. It is expected to be provided as environment
+	// variable.
+	EncryptionPassphrase string
+	// AuditLogFile is the file of the audit log of signatures calculated
+	// by the client.
+	AuditLogFile string
+}
+
+// GetAuditLogFile returns the file of the audit log of signatures. If a value
+// is not set it returns the audit log file in the data directory.
+func (s *Storage) GetAuditLogFile() string {
+	if s.AuditLogFile == "" {
+		return filepath.Join(s.DataDir, defaultAuditLogFileName)
+	}
+
+	return s.AuditLogFile
+}
+
+// StorageEncryptionKeySource returns the source of the key encrypting data
+// in the storage. If no dedicated key is configured, the key is derived from
+// the Ethereum key file password.
+func (c *Config) StorageEncryptionKeySource() *registry.EncryptionKeySource {
+	return &registry.EncryptionKeySource{
+		KeyFile:    c.Storage.EncryptionKeyFile,
+		Key:        c.Storage.EncryptionKey,
+		Passphrase: c.Storage.EncryptionPassphrase,
+		Password:   c.Ethereum.Account.KeyFilePassword,
+	}
 }
 
 // Metrics stores meta-info about metrics.
@@ -71,6 +148,9 @@ type Metrics struct {
 // Diagnostics stores diagnostics-related configuration.
 type Diagnostics struct {
 	Port int
+	// Port of the local admin API allowing to inspect and manage keeps of
+	// the running client. The API is disabled if the port is not set.
+	AdminPort int
 }
 
 // Extensions stores app-specific extensions configuration.
@@ -85,7 +165,8 @@ type TBTC struct {
 }
 
 // ReadConfig reads in the configuration file in .toml format. Ethereum key file
-// password is expected to be provided as environment variable.
+// password and storage encryption key or passphrase are expected to be
+// provided as environment variables.
 func ReadConfig(filePath string) (*Config, error) {
 	config := &Config{}
 	if _, err := toml.DecodeFile(filePath, config); err != nil {
@@ -93,6 +174,8 @@ func ReadConfig(filePath string) (*Config, error) {
 	}
 
 	config.Ethereum.Account.KeyFilePassword = os.Getenv(PasswordEnvVariable)
+	config.Storage.EncryptionKey = os.Getenv(StorageEncryptionKeyEnvVariable)
+	config.Storage.EncryptionPassphrase = os.Getenv(StoragePassphraseEnvVariable)
 
 	return config, nil
 }
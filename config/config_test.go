@@ -6,6 +6,8 @@ import (
 	"reflect"
 	"testing"
 	"time"
+
+	"github.com/keep-network/keep-ecdsa/pkg/utils"
 )
 
 func TestReadConfig(t *testing.T) {
@@ -46,7 +48,9 @@ func TestReadConfig(t *testing.T) {
 		"Ethereum.ContractAddresses": {
 			readValueFunc: func(c *Config) interface{} { return c.Ethereum.ContractAddresses },
 			expectedValue: map[string]string{
-				"BondedECDSAKeepFactory": "0x2BBE98119100D664eb6dEe5b8DB978aEEeAf42D6",
+				"BondedECDSAKeepFactory":      "0x2BBE98119100D664eb6dEe5b8DB978aEEeAf42D6",
+				"FullyBackedECDSAKeepFactory": "0x95A2d5F9BE1D9B8A76E4c4D29b6b4C5B81ee3d05",
+				"FullyBackedBonding":          "0x9C4F2b0b9b7a3A4bAe2cD5C7D2cD1fE1c7e0A7d8",
 			},
 		},
 		"SanctionedApplications": {
@@ -56,10 +60,28 @@ func TestReadConfig(t *testing.T) {
 				"0xda4c869B9073deac021344fd592c1BB0DC6Fc9a5",
 			},
 		},
+		"SanctionedApplications.FullyBacked": {
+			readValueFunc: func(c *Config) interface{} { return c.SanctionedApplications.FullyBackedAddressesStrings },
+			expectedValue: []string{
+				"0x77a5E3d2b9Dd2C6C2cA3a1FBd5bE07f4B3c2c2bB",
+			},
+		},
 		"Storage.DataDir": {
 			readValueFunc: func(c *Config) interface{} { return c.Storage.DataDir },
 			expectedValue: "/my/secure/location",
 		},
+		"Storage.Backend": {
+			readValueFunc: func(c *Config) interface{} { return c.Storage.Backend },
+			expectedValue: "bolt",
+		},
+		"Storage.EncryptionKeyFile": {
+			readValueFunc: func(c *Config) interface{} { return c.Storage.EncryptionKeyFile },
+			expectedValue: "/my/secure/storage.key",
+		},
+		"Storage.AuditLogFile": {
+			readValueFunc: func(c *Config) interface{} { return c.Storage.GetAuditLogFile() },
+			expectedValue: "/my/secure/location/audit.log",
+		},
 		"LibP2P.Port": {
 			readValueFunc: func(c *Config) interface{} { return c.LibP2P.Port },
 			expectedValue: 27001,
@@ -80,6 +102,81 @@ func TestReadConfig(t *testing.T) {
 			readValueFunc: func(c *Config) interface{} { return c.Client.GetSigningTimeout() },
 			expectedValue: time.Duration(12600000000000),
 		},
+		"Client.RewardsWithdrawalThreshold": {
+			readValueFunc: func(c *Config) interface{} { return c.Client.GetRewardsWithdrawalThreshold() },
+			expectedValue: big.NewInt(250000000000000000),
+		},
+		"Client.RewardsCheckInterval": {
+			readValueFunc: func(c *Config) interface{} { return c.Client.GetRewardsCheckInterval() },
+			expectedValue: 2 * time.Hour,
+		},
+		"Client.KeyRefreshAge": {
+			readValueFunc: func(c *Config) interface{} { return c.Client.GetKeyRefreshAge() },
+			expectedValue: 720 * time.Hour,
+		},
+		"Client.KeyRefreshCheckInterval": {
+			readValueFunc: func(c *Config) interface{} { return c.Client.GetKeyRefreshCheckInterval() },
+			expectedValue: 3 * time.Hour,
+		},
+		"Client.ProtocolRetryPolicy": {
+			readValueFunc: func(c *Config) interface{} { return c.Client.GetProtocolRetryPolicy() },
+			expectedValue: utils.BackoffPolicy{
+				InitialBackoff: 2 * time.Second,
+				MaxBackoff:     2 * time.Minute,
+			},
+		},
+		"Client.ChainRetryPolicy": {
+			readValueFunc: func(c *Config) interface{} { return c.Client.GetChainRetryPolicy() },
+			expectedValue: utils.BackoffPolicy{
+				InitialBackoff: 1 * time.Second,
+				MaxBackoff:     30 * time.Second,
+			},
+		},
+		"Client.SubmissionRetryPolicy": {
+			readValueFunc: func(c *Config) interface{} { return c.Client.GetSubmissionRetryPolicy() },
+			expectedValue: utils.BackoffPolicy{
+				InitialBackoff: 1 * time.Minute,
+				MaxBackoff:     1 * time.Minute,
+			},
+		},
+		"BondMonitoring.Interval": {
+			readValueFunc: func(c *Config) interface{} { return c.BondMonitoring.GetInterval() },
+			expectedValue: 15 * time.Minute,
+		},
+		"BondMonitoring.MinimumBondAlertMargin": {
+			readValueFunc: func(c *Config) interface{} { return c.BondMonitoring.GetMinimumBondAlertMargin() },
+			expectedValue: uint(25),
+		},
+		"BondMonitoring.UnbondedValueAlertThreshold": {
+			readValueFunc: func(c *Config) interface{} { return c.BondMonitoring.GetUnbondedValueAlertThreshold() },
+			expectedValue: new(big.Int).Mul(big.NewInt(40), big.NewInt(1e18)),
+		},
+		"SigningAuthorization.RateLimit": {
+			readValueFunc: func(c *Config) interface{} { return c.SigningAuthorization.RateLimit },
+			expectedValue: 5,
+		},
+		"SigningAuthorization.RateLimitPeriod": {
+			readValueFunc: func(c *Config) interface{} { return c.SigningAuthorization.GetRateLimitPeriod() },
+			expectedValue: 12 * time.Hour,
+		},
+		"SigningAuthorization.Applications": {
+			readValueFunc: func(c *Config) interface{} { return c.SigningAuthorization.ApplicationsStrings },
+			expectedValue: []string{
+				"0xc3a96Ff1a18d4F0D0C3a94ba4bDBC5A1A6Bf40A8",
+			},
+		},
+		"SigningAuthorization.ApprovalHook": {
+			readValueFunc: func(c *Config) interface{} { return c.SigningAuthorization.ApprovalHook },
+			expectedValue: "unix:///var/run/keep-approval.sock",
+		},
+		"SigningAuthorization.ApprovalHookTimeout": {
+			readValueFunc: func(c *Config) interface{} { return c.SigningAuthorization.GetApprovalHookTimeout() },
+			expectedValue: 45 * time.Second,
+		},
+		"SigningAuthorization.TBTCRedemptionCheck": {
+			readValueFunc: func(c *Config) interface{} { return c.SigningAuthorization.TBTCRedemptionCheck },
+			expectedValue: true,
+		},
 		"TSS.PreParamsGenerationTimeout": {
 			readValueFunc: func(c *Config) interface{} { return c.TSS.GetPreParamsGenerationTimeout() },
 			expectedValue: time.Duration(397000000000),
@@ -88,6 +185,14 @@ func TestReadConfig(t *testing.T) {
 			readValueFunc: func(c *Config) interface{} { return c.TSS.GetPreParamsTargetPoolSize() },
 			expectedValue: 36,
 		},
+		"TSS.PreParamsGenerationConcurrency": {
+			readValueFunc: func(c *Config) interface{} { return c.TSS.GetPreParamsGenerationConcurrency() },
+			expectedValue: 3,
+		},
+		"TSS.PreParamsGenerationLowPriority": {
+			readValueFunc: func(c *Config) interface{} { return c.TSS.PreParamsGenerationLowPriority },
+			expectedValue: true,
+		},
 		"Extensions.TBTC.TBTCSystem": {
 			readValueFunc: func(c *Config) interface{} { return c.Extensions.TBTC.TBTCSystem },
 			expectedValue: "0xa4888eDD97A5a3A739B4E0807C71817c8a418273",
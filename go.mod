@@ -23,4 +23,6 @@ require (
 	github.com/keep-network/tbtc v1.1.1-0.20210128164215-c03b8cf351f0
 	github.com/pkg/errors v0.9.1
 	github.com/urfave/cli v1.22.1
+	go.etcd.io/bbolt v1.3.5
+	golang.org/x/crypto v0.0.0-20200423211502-4bdfaf469ed5
 )
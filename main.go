@@ -61,6 +61,9 @@ func main() {
 		cmd.StartCommand,
 		cmd.EthereumCommand,
 		cmd.SigningCommand,
+		cmd.StorageCommand,
+		cmd.KeepsCommand,
+		cmd.AuditCommand,
 	}
 
 	err = app.Run(os.Args)
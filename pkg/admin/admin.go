@@ -0,0 +1,278 @@
+// Package admin contains the local admin API allowing the operator to inspect
+// and manage keeps of a running client.
+//
+// The API is served over HTTP on the loopback interface only and exposes
+// the following endpoints:
+//
+//	GET  /keeps                    keeps with their public keys and members
+//	GET  /protocols                key generations, signings and key refreshes
+//	                               in progress
+//	GET  /tss                      TSS pre-parameters pool size
+//	POST /keeps/<address>/recheck  re-check if the keep awaits a signature
+//	POST /keeps/<address>/refresh  refresh key shares of the keep
+//	POST /keeps/<address>/archive  archive the keep confirmed to be closed
+//
+// Keep actions are executed in the background; the API responds with
+// 202 Accepted once the action has been started.
+package admin
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ipfs/go-log"
+
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+)
+
+var logger = log.Logger("keep-admin")
+
+const keepsPath = "/keeps/"
+
+// Client is the running client exposing its state and actions to the API.
+type Client interface {
+	// TSSPreParamsPoolSize returns the current size of the TSS params pool.
+	TSSPreParamsPoolSize() int
+	// KeyGenerationsInProgress returns addresses of keeps for which the
+	// client is currently generating a key.
+	KeyGenerationsInProgress() []common.Address
+	// SigningsInProgress returns digests the client is currently calculating
+	// signatures for, grouped by the keep address.
+	SigningsInProgress() map[common.Address][][32]byte
+	// KeyRefreshesInProgress returns addresses of keeps for which the client
+	// is currently refreshing the key.
+	KeyRefreshesInProgress() []common.Address
+	// RecheckKeep forces the client to check if the keep awaits a signature.
+	RecheckKeep(keepAddress common.Address) error
+	// RefreshKeep forces the client to refresh key shares of the keep.
+	RefreshKeep(keepAddress common.Address) error
+	// ArchiveKeep archives the keep confirmed to be no longer active and
+	// stops monitoring its events.
+	ArchiveKeep(keepAddress common.Address) error
+}
+
+// KeepsRegistry provides keeps the client is a member of.
+type KeepsRegistry interface {
+	// GetKeepsAddresses returns addresses of all registered keeps.
+	GetKeepsAddresses() []common.Address
+	// GetSigner returns the signer of the keep.
+	GetSigner(keepAddress common.Address) (*tss.ThresholdSigner, error)
+}
+
+// Initialize enables the admin API server on the given port of the loopback
+// interface. It returns false if the port is not configured.
+func Initialize(port int, client Client, keepsRegistry KeepsRegistry) bool {
+	if port == 0 {
+		return false
+	}
+
+	server := &http.Server{
+		Addr:    fmt.Sprintf("127.0.0.1:%d", port),
+		Handler: NewHandler(client, keepsRegistry),
+	}
+
+	go func() {
+		if err := server.ListenAndServe(); err != http.ErrServerClosed {
+			logger.Errorf("admin API server failed: [%v]", err)
+		}
+	}()
+
+	return true
+}
+
+// NewHandler creates the HTTP handler serving the admin API.
+func NewHandler(client Client, keepsRegistry KeepsRegistry) http.Handler {
+	api := &api{
+		client:        client,
+		keepsRegistry: keepsRegistry,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/keeps", api.handleKeeps)
+	mux.HandleFunc(keepsPath, api.handleKeepAction)
+	mux.HandleFunc("/protocols", api.handleProtocols)
+	mux.HandleFunc("/tss", api.handleTSS)
+
+	return mux
+}
+
+type api struct {
+	client        Client
+	keepsRegistry KeepsRegistry
+}
+
+type keepInfo struct {
+	Address   string   `json:"address"`
+	PublicKey string   `json:"public_key"`
+	Members   []string `json:"members"`
+}
+
+func (a *api) handleKeeps(response http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet {
+		writeError(response, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	keeps := make([]*keepInfo, 0)
+	for _, keepAddress := range a.keepsRegistry.GetKeepsAddresses() {
+		signer, err := a.keepsRegistry.GetSigner(keepAddress)
+		if err != nil {
+			logger.Warningf(
+				"could not get signer for keep [%s]: [%v]",
+				keepAddress.String(),
+				err,
+			)
+			continue
+		}
+
+		keeps = append(keeps, &keepInfo{
+			Address:   keepAddress.Hex(),
+			PublicKey: hex.EncodeToString(signer.PublicKey().Marshal()),
+			Members:   membersAddresses(signer.GroupMemberIDs()),
+		})
+	}
+
+	writeJSON(response, keeps)
+}
+
+func membersAddresses(memberIDs []tss.MemberID) []string {
+	members := make([]string, len(memberIDs))
+	for i, memberID := range memberIDs {
+		publicKey, err := memberID.PublicKey()
+		if err != nil {
+			// Fallback to the member ID so the member is still reported.
+			members[i] = memberID.String()
+			continue
+		}
+
+		members[i] = crypto.PubkeyToAddress(*publicKey).Hex()
+	}
+
+	return members
+}
+
+func (a *api) handleKeepAction(response http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		writeError(response, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	pathParts := strings.Split(strings.TrimPrefix(request.URL.Path, keepsPath), "/")
+	if len(pathParts) != 2 || !common.IsHexAddress(pathParts[0]) {
+		writeError(response, http.StatusNotFound, "not found")
+		return
+	}
+
+	keepAddress := common.HexToAddress(pathParts[0])
+
+	var err error
+	switch pathParts[1] {
+	case "recheck":
+		err = a.client.RecheckKeep(keepAddress)
+	case "archive":
+		err = a.client.ArchiveKeep(keepAddress)
+	case "refresh":
+		err = a.client.RefreshKeep(keepAddress)
+	default:
+		writeError(response, http.StatusNotFound, "not found")
+		return
+	}
+
+	if err != nil {
+		writeError(response, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeJSONWithStatus(
+		response,
+		http.StatusAccepted,
+		map[string]string{"status": "accepted"},
+	)
+}
+
+type protocolsInfo struct {
+	KeyGenerations []string            `json:"key_generations"`
+	Signings       map[string][]string `json:"signings"`
+	KeyRefreshes   []string            `json:"key_refreshes"`
+}
+
+func (a *api) handleProtocols(response http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet {
+		writeError(response, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	info := &protocolsInfo{
+		KeyGenerations: make([]string, 0),
+		Signings:       make(map[string][]string),
+		KeyRefreshes:   make([]string, 0),
+	}
+
+	for _, keepAddress := range a.client.KeyGenerationsInProgress() {
+		info.KeyGenerations = append(info.KeyGenerations, keepAddress.Hex())
+	}
+
+	for keepAddress, digests := range a.client.SigningsInProgress() {
+		digestsStrings := make([]string, len(digests))
+		for i, digest := range digests {
+			digestsStrings[i] = hex.EncodeToString(digest[:])
+		}
+
+		info.Signings[keepAddress.Hex()] = digestsStrings
+	}
+
+	for _, keepAddress := range a.client.KeyRefreshesInProgress() {
+		info.KeyRefreshes = append(info.KeyRefreshes, keepAddress.Hex())
+	}
+
+	writeJSON(response, info)
+}
+
+func (a *api) handleTSS(response http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet {
+		writeError(response, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	writeJSON(response, map[string]int{
+		"pre_params_pool_size": a.client.TSSPreParamsPoolSize(),
+	})
+}
+
+func writeJSON(response http.ResponseWriter, value interface{}) {
+	writeJSONWithStatus(response, http.StatusOK, value)
+}
+
+func writeJSONWithStatus(
+	response http.ResponseWriter,
+	status int,
+	value interface{},
+) {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		logger.Errorf("could not serialize response to JSON: [%v]", err)
+		response.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	response.WriteHeader(status)
+	if _, err := response.Write(bytes); err != nil {
+		logger.Errorf("could not write response: [%v]", err)
+	}
+}
+
+func writeError(response http.ResponseWriter, status int, message string) {
+	response.Header().Set("Content-Type", "application/json")
+	response.WriteHeader(status)
+
+	bytes, _ := json.Marshal(map[string]string{"error": message})
+	if _, err := response.Write(bytes); err != nil {
+		logger.Errorf("could not write response: [%v]", err)
+	}
+}
@@ -0,0 +1,314 @@
+package admin
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/keep-network/keep-ecdsa/internal/testdata"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss/gen/pb"
+)
+
+var (
+	keepAddress1 = common.HexToAddress("0x770a9E2F2Aa1eC2d3Ca916Fc3e6A55058A898632")
+	keepAddress2 = common.HexToAddress("0x8B3BccB3A3994681A1C1584DE4b4E8b23ed1Ed6d")
+)
+
+func TestKeeps(t *testing.T) {
+	memberKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signer, err := newTestSigner(tss.MemberIDFromPublicKey(&memberKey.PublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handler := NewHandler(
+		&testClient{},
+		&testKeepsRegistry{
+			signers: map[common.Address]*tss.ThresholdSigner{keepAddress1: signer},
+		},
+	)
+
+	var keeps []*keepInfo
+	if status := get(t, handler, "/keeps", &keeps); status != http.StatusOK {
+		t.Fatalf("unexpected status [%v]", status)
+	}
+
+	expectedKeeps := []*keepInfo{
+		{
+			Address:   keepAddress1.Hex(),
+			PublicKey: hex.EncodeToString(signer.PublicKey().Marshal()),
+			Members:   []string{crypto.PubkeyToAddress(memberKey.PublicKey).Hex()},
+		},
+	}
+
+	if !reflect.DeepEqual(expectedKeeps, keeps) {
+		t.Errorf(
+			"unexpected keeps\nexpected: [%+v]\nactual:   [%+v]",
+			expectedKeeps,
+			keeps,
+		)
+	}
+}
+
+func TestProtocols(t *testing.T) {
+	digest := [32]byte{1, 2, 3}
+
+	handler := NewHandler(
+		&testClient{
+			keyGenerations: []common.Address{keepAddress1},
+			signings: map[common.Address][][32]byte{
+				keepAddress2: {digest},
+			},
+			keyRefreshes: []common.Address{keepAddress2},
+		},
+		&testKeepsRegistry{},
+	)
+
+	var protocols protocolsInfo
+	if status := get(t, handler, "/protocols", &protocols); status != http.StatusOK {
+		t.Fatalf("unexpected status [%v]", status)
+	}
+
+	expectedProtocols := protocolsInfo{
+		KeyGenerations: []string{keepAddress1.Hex()},
+		Signings: map[string][]string{
+			keepAddress2.Hex(): {hex.EncodeToString(digest[:])},
+		},
+		KeyRefreshes: []string{keepAddress2.Hex()},
+	}
+
+	if !reflect.DeepEqual(expectedProtocols, protocols) {
+		t.Errorf(
+			"unexpected protocols\nexpected: [%+v]\nactual:   [%+v]",
+			expectedProtocols,
+			protocols,
+		)
+	}
+}
+
+func TestTSS(t *testing.T) {
+	handler := NewHandler(&testClient{poolSize: 7}, &testKeepsRegistry{})
+
+	var tssInfo map[string]int
+	if status := get(t, handler, "/tss", &tssInfo); status != http.StatusOK {
+		t.Fatalf("unexpected status [%v]", status)
+	}
+
+	if tssInfo["pre_params_pool_size"] != 7 {
+		t.Errorf(
+			"unexpected pool size\nexpected: [%v]\nactual:   [%v]",
+			7,
+			tssInfo["pre_params_pool_size"],
+		)
+	}
+}
+
+func TestKeepActions(t *testing.T) {
+	client := &testClient{
+		archiveErrs: map[common.Address]error{
+			keepAddress2: fmt.Errorf("keep is still active"),
+		},
+	}
+	handler := NewHandler(client, &testKeepsRegistry{})
+
+	var tests = map[string]struct {
+		method         string
+		path           string
+		expectedStatus int
+	}{
+		"recheck": {
+			method:         http.MethodPost,
+			path:           "/keeps/" + keepAddress1.Hex() + "/recheck",
+			expectedStatus: http.StatusAccepted,
+		},
+		"refresh": {
+			method:         http.MethodPost,
+			path:           "/keeps/" + keepAddress2.Hex() + "/refresh",
+			expectedStatus: http.StatusAccepted,
+		},
+		"archive": {
+			method:         http.MethodPost,
+			path:           "/keeps/" + keepAddress1.Hex() + "/archive",
+			expectedStatus: http.StatusAccepted,
+		},
+		"archive failed": {
+			method:         http.MethodPost,
+			path:           "/keeps/" + keepAddress2.Hex() + "/archive",
+			expectedStatus: http.StatusBadRequest,
+		},
+		"unknown action": {
+			method:         http.MethodPost,
+			path:           "/keeps/" + keepAddress1.Hex() + "/close",
+			expectedStatus: http.StatusNotFound,
+		},
+		"invalid address": {
+			method:         http.MethodPost,
+			path:           "/keeps/0x123/recheck",
+			expectedStatus: http.StatusNotFound,
+		},
+		"invalid method": {
+			method:         http.MethodGet,
+			path:           "/keeps/" + keepAddress1.Hex() + "/recheck",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(
+				recorder,
+				httptest.NewRequest(test.method, test.path, nil),
+			)
+
+			if recorder.Code != test.expectedStatus {
+				t.Errorf(
+					"unexpected status\nexpected: [%v]\nactual:   [%v]",
+					test.expectedStatus,
+					recorder.Code,
+				)
+			}
+		})
+	}
+
+	if !reflect.DeepEqual([]common.Address{keepAddress1}, client.rechecked) {
+		t.Errorf("unexpected rechecked keeps [%v]", client.rechecked)
+	}
+
+	if !reflect.DeepEqual([]common.Address{keepAddress2}, client.refreshed) {
+		t.Errorf("unexpected refreshed keeps [%v]", client.refreshed)
+	}
+
+	if !reflect.DeepEqual([]common.Address{keepAddress1}, client.archived) {
+		t.Errorf("unexpected archived keeps [%v]", client.archived)
+	}
+}
+
+func get(t *testing.T, handler http.Handler, path string, result interface{}) int {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+
+	if err := json.Unmarshal(recorder.Body.Bytes(), result); err != nil {
+		t.Fatalf("could not unmarshal response: [%v]", err)
+	}
+
+	return recorder.Code
+}
+
+type testClient struct {
+	poolSize       int
+	keyGenerations []common.Address
+	signings       map[common.Address][][32]byte
+	keyRefreshes   []common.Address
+	archiveErrs    map[common.Address]error
+	rechecked      []common.Address
+	refreshed      []common.Address
+	archived       []common.Address
+}
+
+func (tc *testClient) TSSPreParamsPoolSize() int {
+	return tc.poolSize
+}
+
+func (tc *testClient) KeyGenerationsInProgress() []common.Address {
+	return tc.keyGenerations
+}
+
+func (tc *testClient) SigningsInProgress() map[common.Address][][32]byte {
+	return tc.signings
+}
+
+func (tc *testClient) RecheckKeep(keepAddress common.Address) error {
+	tc.rechecked = append(tc.rechecked, keepAddress)
+	return nil
+}
+
+func (tc *testClient) KeyRefreshesInProgress() []common.Address {
+	return tc.keyRefreshes
+}
+
+func (tc *testClient) RefreshKeep(keepAddress common.Address) error {
+	tc.refreshed = append(tc.refreshed, keepAddress)
+	return nil
+}
+
+func (tc *testClient) ArchiveKeep(keepAddress common.Address) error {
+	if err, ok := tc.archiveErrs[keepAddress]; ok {
+		return err
+	}
+
+	tc.archived = append(tc.archived, keepAddress)
+	return nil
+}
+
+type testKeepsRegistry struct {
+	signers map[common.Address]*tss.ThresholdSigner
+}
+
+func (tkr *testKeepsRegistry) GetKeepsAddresses() []common.Address {
+	keeps := make([]common.Address, 0)
+	for keepAddress := range tkr.signers {
+		keeps = append(keeps, keepAddress)
+	}
+
+	return keeps
+}
+
+func (tkr *testKeepsRegistry) GetSigner(
+	keepAddress common.Address,
+) (*tss.ThresholdSigner, error) {
+	signer, ok := tkr.signers[keepAddress]
+	if !ok {
+		return nil, fmt.Errorf("no signer for keep [%s]", keepAddress.String())
+	}
+
+	return signer, nil
+}
+
+func newTestSigner(memberID tss.MemberID) (*tss.ThresholdSigner, error) {
+	testData, err := testdata.LoadKeygenTestFixtures(1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load key gen test fixtures: [%v]", err)
+	}
+
+	thresholdKey := tss.ThresholdKey(testData[0])
+	thresholdKeyBytes, err := thresholdKey.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal threshold key: [%v]", err)
+	}
+
+	pbSigner := &pb.ThresholdSigner{
+		GroupInfo: &pb.ThresholdSigner_GroupInfo{
+			GroupID:            "test-group-1",
+			MemberID:           memberID,
+			GroupMemberIDs:     [][]byte{memberID},
+			DishonestThreshold: 0,
+		},
+		ThresholdKey: thresholdKeyBytes,
+	}
+
+	bytes, err := proto.Marshal(pbSigner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal signer: [%v]", err)
+	}
+
+	signer := &tss.ThresholdSigner{}
+	if err := signer.Unmarshal(bytes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal signer: [%v]", err)
+	}
+
+	return signer, nil
+}
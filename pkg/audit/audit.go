@@ -0,0 +1,526 @@
+// Package audit contains the tamper-evident, append-only log of signatures
+// calculated with key shares of the operator.
+//
+// The log is a file with one JSON record per line. Each record holds the entry
+// and the hash of the entry. Each entry holds the hash of the previous entry,
+// so the log can be verified offline by recomputing the hashes. Any change of
+// an entry, removal of an entry from the middle of the log or change of the
+// entries order breaks the chain of hashes.
+//
+// The hashes alone can be recomputed by anyone able to modify the file, so
+// each record also holds the signature of its hash made with the operator
+// key. The key is not stored next to the log, so the log can not be rewritten
+// without it, and the verification checks that all records are signed by
+// the operator.
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ipfs/go-log"
+	"github.com/keep-network/keep-core/pkg/chain"
+)
+
+var logger = log.Logger("keep-audit")
+
+// Types of audit log entries.
+const (
+	// SignatureCalculated entry is recorded when the signature has been
+	// calculated with the key share of the operator.
+	SignatureCalculated = "signature_calculated"
+	// SignaturePublished entry is recorded when the calculated signature has
+	// been published on-chain and confirmed.
+	SignaturePublished = "signature_published"
+)
+
+// genesisHash is the previous hash of the first entry of the log.
+var genesisHash = hex.EncodeToString(make([]byte, sha256.Size))
+
+// Entry is a single entry of the audit log.
+type Entry struct {
+	// Sequence is the number of the entry in the log, starting from 1.
+	Sequence     uint64             `json:"sequence"`
+	Type         string             `json:"type"`
+	RecordedAt   time.Time          `json:"recordedAt"`
+	KeepAddress  common.Address     `json:"keepAddress"`
+	Digest       string             `json:"digest"`
+	Signature    *SignatureRecord   `json:"signature,omitempty"`
+	Publication  *PublicationRecord `json:"publication,omitempty"`
+	PreviousHash string             `json:"previousHash"`
+}
+
+// SignatureRecord describes the signature calculated with the key share of
+// the operator.
+type SignatureRecord struct {
+	// RequestBlock is the block in which the signature has been requested.
+	RequestBlock uint64 `json:"requestBlock"`
+	// ProtocolStart and ProtocolEnd are times of the start and the end of
+	// the signing protocol attempt which calculated the signature.
+	ProtocolStart time.Time `json:"protocolStart"`
+	ProtocolEnd   time.Time `json:"protocolEnd"`
+	R             string    `json:"r"`
+	S             string    `json:"s"`
+	RecoveryID    int       `json:"recoveryId"`
+}
+
+// PublicationRecord describes the on-chain publication of the signature,
+// as seen in the signature submitted event.
+type PublicationRecord struct {
+	// TransactionHash is the hash of the transaction which submitted
+	// the signature.
+	TransactionHash common.Hash `json:"transactionHash"`
+	// BlockNumber is the block in which the signature has been submitted.
+	BlockNumber uint64 `json:"blockNumber"`
+	// PublishedBy is the address of the account which submitted the
+	// signature; the operator or another member of the keep.
+	PublishedBy common.Address `json:"publishedBy"`
+}
+
+// record is a single line of the audit log file. The entry is kept in the
+// exact form it has been hashed in. The signature is the operator signature
+// of the hash.
+type record struct {
+	Entry     json.RawMessage `json:"entry"`
+	Hash      string          `json:"hash"`
+	Signature string          `json:"signature"`
+}
+
+// Log is the audit log appending entries to the file.
+type Log struct {
+	mutex    sync.Mutex
+	file     *os.File
+	signing  chain.Signing
+	sequence uint64
+	lastHash string
+
+	// offset is the size of the file ending with the last complete record.
+	offset int64
+	// failure is set when the failed write could not be rolled back. The log
+	// does not accept any new entries then, as they would follow a partial
+	// record.
+	failure error
+}
+
+// Open opens the audit log file under the given path, creating it if it does
+// not exist. Records are signed with the operator key of the provided signing.
+// Existing entries are verified before any new entry is appended, so the chain
+// is never extended from a tampered log. The partial last record left by
+// the client stopped while writing it is truncated with a warning.
+func Open(path string, signing chain.Signing) (*Log, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf(
+			"could not create audit log directory: [%v]",
+			err,
+		)
+	}
+
+	// #nosec G304 (file path provided as taint input)
+	// The path is provided in the client configuration.
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("could not open audit log: [%v]", err)
+	}
+
+	truncated, err := truncatePartialRecord(file)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf(
+			"could not truncate partial audit log record: [%v]",
+			err,
+		)
+	}
+	if truncated > 0 {
+		logger.Warningf(
+			"truncated [%d] bytes of the partial last record of audit log [%s]; "+
+				"the record has not been completely written, most likely "+
+				"because the client stopped while writing it",
+			truncated,
+			path,
+		)
+	}
+
+	operator := common.BytesToAddress(
+		signing.PublicKeyBytesToAddress(signing.PublicKey()),
+	)
+
+	sequence, lastHash, err := verify(file, operator)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("audit log verification failed: [%v]", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("could not read audit log size: [%v]", err)
+	}
+
+	return &Log{
+		file:     file,
+		signing:  signing,
+		sequence: sequence,
+		lastHash: lastHash,
+		offset:   info.Size(),
+	}, nil
+}
+
+// RecordSignatureCalculated appends the entry of the signature calculated for
+// the digest of the keep.
+func (l *Log) RecordSignatureCalculated(
+	keepAddress common.Address,
+	digest [32]byte,
+	signature *SignatureRecord,
+) error {
+	return l.append(&Entry{
+		Type:        SignatureCalculated,
+		KeepAddress: keepAddress,
+		Digest:      hex.EncodeToString(digest[:]),
+		Signature:   signature,
+	})
+}
+
+// RecordSignaturePublished appends the entry of the signature for the digest
+// of the keep published on-chain.
+func (l *Log) RecordSignaturePublished(
+	keepAddress common.Address,
+	digest [32]byte,
+	publication *PublicationRecord,
+) error {
+	return l.append(&Entry{
+		Type:        SignaturePublished,
+		KeepAddress: keepAddress,
+		Digest:      hex.EncodeToString(digest[:]),
+		Publication: publication,
+	})
+}
+
+// append writes the entry to the log. If the write fails, the file is
+// truncated back to the last complete record, so the failed entry leaves no
+// trace in the log. If that is not possible, all further appends fail.
+func (l *Log) append(entry *Entry) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.failure != nil {
+		return fmt.Errorf(
+			"audit log is in a failed state: [%v]",
+			l.failure,
+		)
+	}
+
+	entry.Sequence = l.sequence + 1
+	entry.RecordedAt = time.Now().UTC()
+	entry.PreviousHash = l.lastHash
+
+	entryBytes, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("could not marshal audit log entry: [%v]", err)
+	}
+
+	hash := entryHash(entryBytes)
+
+	signature, err := l.signRecord(hash)
+	if err != nil {
+		return fmt.Errorf("could not sign audit log entry: [%v]", err)
+	}
+
+	line, err := json.Marshal(&record{
+		Entry:     entryBytes,
+		Hash:      hash,
+		Signature: signature,
+	})
+	if err != nil {
+		return fmt.Errorf("could not marshal audit log record: [%v]", err)
+	}
+
+	line = append(line, '\n')
+
+	written, err := l.file.Write(line)
+	if err == nil && written != len(line) {
+		err = io.ErrShortWrite
+	}
+	if err != nil {
+		return l.rollback(fmt.Errorf(
+			"could not write audit log entry: [%v]",
+			err,
+		))
+	}
+
+	if err := l.file.Sync(); err != nil {
+		return l.rollback(fmt.Errorf("could not sync audit log: [%v]", err))
+	}
+
+	l.sequence = entry.Sequence
+	l.lastHash = hash
+	l.offset += int64(len(line))
+
+	logger.Debugf(
+		"recorded audit log entry [%d] of type [%s] for keep [%s]",
+		entry.Sequence,
+		entry.Type,
+		entry.KeepAddress.String(),
+	)
+
+	return nil
+}
+
+// signRecord signs the hash of the record with the operator key.
+func (l *Log) signRecord(hash string) (string, error) {
+	hashBytes, err := hex.DecodeString(hash)
+	if err != nil {
+		return "", err
+	}
+
+	signature, err := l.signing.Sign(hashBytes)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(signature), nil
+}
+
+// rollback truncates the file back to the last complete record after the
+// failed write. It returns the write error, extended with the truncation
+// error if the truncation failed too.
+func (l *Log) rollback(writeErr error) error {
+	err := l.file.Truncate(l.offset)
+	if err == nil {
+		err = l.file.Sync()
+	}
+	if err != nil {
+		l.failure = fmt.Errorf(
+			"could not truncate audit log to the last complete record "+
+				"after [%v]: [%v]",
+			writeErr,
+			err,
+		)
+		return l.failure
+	}
+
+	return writeErr
+}
+
+// Close closes the audit log file.
+func (l *Log) Close() error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.file.Close()
+}
+
+// Verify verifies the chain of hashes of the audit log file under the given
+// path and checks all records are signed by the given operator. It returns
+// the number of verified entries and the hash of the last entry, or an error
+// describing the first entry which could not be verified.
+//
+// The chain of hashes does not reveal entries removed from the end of the
+// log. To detect it, the hash of the last entry should be compared with the
+// one returned by the previous verification.
+func Verify(path string, operator common.Address) (uint64, string, error) {
+	// #nosec G304 (file path provided as taint input)
+	// The path is provided by the user verifying the log.
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, "", fmt.Errorf("could not open audit log: [%v]", err)
+	}
+	defer file.Close()
+
+	return verify(file, operator)
+}
+
+// truncatePartialRecord removes the last line of the file if it does not end
+// with the new line character. Records are written with the trailing new line
+// in a single write so such a line is the record the client has not finished
+// writing. It returns the number of removed bytes and leaves the file offset
+// at the beginning of the file.
+func truncatePartialRecord(file *os.File) (int64, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return 0, err
+	}
+	size := info.Size()
+
+	// Look for the last new line character reading the file backwards
+	// in chunks.
+	const chunkSize = 4096
+	chunk := make([]byte, chunkSize)
+	end := size
+	for end > 0 {
+		start := end - chunkSize
+		if start < 0 {
+			start = 0
+		}
+
+		n, err := file.ReadAt(chunk[:end-start], start)
+		if err != nil && err != io.EOF {
+			return 0, err
+		}
+
+		if index := bytes.LastIndexByte(chunk[:n], '\n'); index >= 0 {
+			end = start + int64(index) + 1
+			break
+		}
+
+		end = start
+	}
+
+	if end < size {
+		if err := file.Truncate(end); err != nil {
+			return 0, err
+		}
+		if err := file.Sync(); err != nil {
+			return 0, err
+		}
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	return size - end, nil
+}
+
+// verify reads all records and checks if each entry matches its hash signed
+// by the operator, refers to the hash of the previous entry and has the next
+// sequence number. It returns the sequence number and the hash of the last
+// entry.
+func verify(reader io.Reader, operator common.Address) (uint64, string, error) {
+	sequence := uint64(0)
+	lastHash := genesisHash
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		lineNumber := sequence + 1
+
+		record := &record{}
+		if err := json.Unmarshal(line, record); err != nil {
+			return sequence, lastHash, fmt.Errorf(
+				"could not unmarshal record at line [%d]: [%v]",
+				lineNumber,
+				err,
+			)
+		}
+
+		if hash := entryHash(record.Entry); hash != record.Hash {
+			return sequence, lastHash, fmt.Errorf(
+				"hash of entry at line [%d] does not match; "+
+					"expected: [%s], actual: [%s]",
+				lineNumber,
+				record.Hash,
+				hash,
+			)
+		}
+
+		signer, err := recordSigner(record.Hash, record.Signature)
+		if err != nil {
+			return sequence, lastHash, fmt.Errorf(
+				"invalid signature of entry at line [%d]: [%v]",
+				lineNumber,
+				err,
+			)
+		}
+		if signer != operator {
+			return sequence, lastHash, fmt.Errorf(
+				"entry at line [%d] is not signed by the operator; "+
+					"expected: [%s], actual: [%s]",
+				lineNumber,
+				operator.String(),
+				signer.String(),
+			)
+		}
+
+		entry := &Entry{}
+		if err := json.Unmarshal(record.Entry, entry); err != nil {
+			return sequence, lastHash, fmt.Errorf(
+				"could not unmarshal entry at line [%d]: [%v]",
+				lineNumber,
+				err,
+			)
+		}
+
+		if entry.Sequence != sequence+1 {
+			return sequence, lastHash, fmt.Errorf(
+				"unexpected sequence of entry at line [%d]; "+
+					"expected: [%d], actual: [%d]",
+				lineNumber,
+				sequence+1,
+				entry.Sequence,
+			)
+		}
+
+		if entry.PreviousHash != lastHash {
+			return sequence, lastHash, fmt.Errorf(
+				"entry at line [%d] does not refer to the previous entry; "+
+					"expected: [%s], actual: [%s]",
+				lineNumber,
+				lastHash,
+				entry.PreviousHash,
+			)
+		}
+
+		sequence = entry.Sequence
+		lastHash = record.Hash
+	}
+
+	if err := scanner.Err(); err != nil {
+		return sequence, lastHash, fmt.Errorf(
+			"could not read audit log: [%v]",
+			err,
+		)
+	}
+
+	return sequence, lastHash, nil
+}
+
+// recordSigner recovers the address of the account which signed the hash of
+// the record. Records are signed as Ethereum signed messages, with the
+// recovery ID in the last byte increased by 27.
+func recordSigner(hash string, signature string) (common.Address, error) {
+	hashBytes, err := hex.DecodeString(hash)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	signatureBytes, err := hex.DecodeString(signature)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(signatureBytes) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf(
+			"unexpected signature length [%d]",
+			len(signatureBytes),
+		)
+	}
+	signatureBytes[crypto.RecoveryIDOffset] -= 27
+
+	publicKey, err := crypto.SigToPub(
+		accounts.TextHash(hashBytes),
+		signatureBytes,
+	)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*publicKey), nil
+}
+
+func entryHash(entry []byte) string {
+	hash := sha256.Sum256(entry)
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,423 @@
+package audit
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+	"github.com/keep-network/keep-core/pkg/chain"
+)
+
+var (
+	keepAddress = common.HexToAddress("0x770a9E2F2Aa1eC2d3Ca916Fc3e6A55058A898632")
+	digest      = [32]byte{1, 2, 3}
+
+	otherMemberPublication = &PublicationRecord{
+		TransactionHash: common.Hash{5},
+		BlockNumber:     110,
+		PublishedBy:     common.HexToAddress("0x65ea55c1f10491038425725dc00dffeab2a1e28a"),
+	}
+
+	signing, operator = newTestSigning()
+)
+
+func TestRecordAndVerify(t *testing.T) {
+	path, cleanup := newTestLogPath(t)
+	defer cleanup()
+
+	auditLog, err := Open(path, signing)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recordTestEntries(t, auditLog)
+
+	if err := auditLog.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Entries are appended to the existing log after it is reopened.
+	reopened, err := Open(path, signing)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := reopened.RecordSignaturePublished(
+		keepAddress,
+		digest,
+		otherMemberPublication,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := reopened.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	count, lastHash, err := Verify(path, operator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if count != 3 {
+		t.Errorf(
+			"unexpected number of entries\nexpected: [%v]\nactual:   [%v]",
+			3,
+			count,
+		)
+	}
+
+	if lastHash == genesisHash {
+		t.Errorf("last hash should not be the genesis hash")
+	}
+}
+
+func TestVerifyTamperedLog(t *testing.T) {
+	var tests = map[string]struct {
+		tamper        func(lines []string) []string
+		expectedError string
+	}{
+		"modified entry": {
+			tamper: func(lines []string) []string {
+				lines[0] = strings.Replace(lines[0], "\"recoveryId\":1", "\"recoveryId\":0", 1)
+				return lines
+			},
+			expectedError: "hash of entry at line [1] does not match",
+		},
+		"removed entry": {
+			tamper: func(lines []string) []string {
+				return append(lines[:0], lines[1:]...)
+			},
+			expectedError: "unexpected sequence of entry at line [1]",
+		},
+		"modified entry with recomputed hash": {
+			tamper: func(lines []string) []string {
+				lines[1] = rehashRecord(
+					t,
+					lines[1],
+					"\"blockNumber\":105",
+					"\"blockNumber\":106",
+				)
+				return lines
+			},
+			expectedError: "entry at line [2] is not signed by the operator",
+		},
+		"reordered entries": {
+			tamper: func(lines []string) []string {
+				lines[0], lines[1] = lines[1], lines[0]
+				return lines
+			},
+			expectedError: "unexpected sequence of entry at line [1]",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			path, cleanup := newTestLogPath(t)
+			defer cleanup()
+
+			auditLog, err := Open(path, signing)
+			if err != nil {
+				t.Fatal(err)
+			}
+			recordTestEntries(t, auditLog)
+			auditLog.Close()
+
+			content, err := ioutil.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+			tampered := strings.Join(test.tamper(lines), "\n") + "\n"
+
+			if err := ioutil.WriteFile(path, []byte(tampered), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			_, _, err = Verify(path, operator)
+			if err == nil || !strings.Contains(err.Error(), test.expectedError) {
+				t.Errorf(
+					"unexpected error\nexpected: [%v]\nactual:   [%v]",
+					test.expectedError,
+					err,
+				)
+			}
+
+			// Entries must not be appended to the tampered log.
+			if _, err := Open(path, signing); err == nil {
+				t.Errorf("expected error when opening tampered log")
+			}
+		})
+	}
+}
+
+func TestOpenLogWithPartialRecord(t *testing.T) {
+	var tests = map[string]struct {
+		partialRecord string
+	}{
+		"partial record": {
+			partialRecord: "{\"entry\":{\"sequence\":3,\"type\":\"signa",
+		},
+		"partial record longer than chunk": {
+			partialRecord: "{\"entry\":\"" + strings.Repeat("a", 10000),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			path, cleanup := newTestLogPath(t)
+			defer cleanup()
+
+			auditLog, err := Open(path, signing)
+			if err != nil {
+				t.Fatal(err)
+			}
+			recordTestEntries(t, auditLog)
+			auditLog.Close()
+
+			complete, err := ioutil.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Simulate the client stopped while writing the record.
+			if err := ioutil.WriteFile(
+				path,
+				append(complete, []byte(test.partialRecord)...),
+				0600,
+			); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, _, err := Verify(path, operator); err == nil {
+				t.Errorf("expected error when verifying log with partial record")
+			}
+
+			reopened, err := Open(path, signing)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := reopened.RecordSignaturePublished(
+				keepAddress,
+				digest,
+				otherMemberPublication,
+			); err != nil {
+				t.Fatal(err)
+			}
+			reopened.Close()
+
+			count, _, err := Verify(path, operator)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if count != 3 {
+				t.Errorf(
+					"unexpected number of entries\nexpected: [%v]\nactual:   [%v]",
+					3,
+					count,
+				)
+			}
+		})
+	}
+}
+
+func TestOpenLogWithOnlyPartialRecord(t *testing.T) {
+	path, cleanup := newTestLogPath(t)
+	defer cleanup()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, []byte("{\"entry\""), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	auditLog, err := Open(path, signing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recordTestEntries(t, auditLog)
+	auditLog.Close()
+
+	count, _, err := Verify(path, operator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if count != 2 {
+		t.Errorf(
+			"unexpected number of entries\nexpected: [%v]\nactual:   [%v]",
+			2,
+			count,
+		)
+	}
+}
+
+func TestAppendFailsClosed(t *testing.T) {
+	path, cleanup := newTestLogPath(t)
+	defer cleanup()
+
+	auditLog, err := Open(path, signing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recordTestEntries(t, auditLog)
+
+	// Neither writes nor truncation are possible with the read-only file,
+	// so the failed entry could not be rolled back.
+	writableFile := auditLog.file
+	readOnlyFile, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auditLog.file = readOnlyFile
+
+	if err := auditLog.RecordSignaturePublished(
+		keepAddress,
+		digest,
+		otherMemberPublication,
+	); err == nil {
+		t.Fatal("expected error when writing to read-only file")
+	}
+
+	auditLog.file = writableFile
+	readOnlyFile.Close()
+
+	err = auditLog.RecordSignaturePublished(
+		keepAddress,
+		digest,
+		otherMemberPublication,
+	)
+	expectedError := "audit log is in a failed state"
+	if err == nil || !strings.Contains(err.Error(), expectedError) {
+		t.Errorf(
+			"unexpected error\nexpected: [%v]\nactual:   [%v]",
+			expectedError,
+			err,
+		)
+	}
+	auditLog.Close()
+
+	count, _, err := Verify(path, operator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if count != 2 {
+		t.Errorf(
+			"unexpected number of entries\nexpected: [%v]\nactual:   [%v]",
+			2,
+			count,
+		)
+	}
+}
+
+func recordTestEntries(t *testing.T, auditLog *Log) {
+	if err := auditLog.RecordSignatureCalculated(
+		keepAddress,
+		digest,
+		&SignatureRecord{
+			RequestBlock:  100,
+			ProtocolStart: time.Now().Add(-time.Minute),
+			ProtocolEnd:   time.Now(),
+			R:             big.NewInt(10).Text(16),
+			S:             big.NewInt(20).Text(16),
+			RecoveryID:    1,
+		},
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := auditLog.RecordSignaturePublished(
+		keepAddress,
+		digest,
+		&PublicationRecord{
+			TransactionHash: common.Hash{4},
+			BlockNumber:     105,
+			PublishedBy:     operator,
+		},
+	); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVerifyLogOfOtherOperator(t *testing.T) {
+	path, cleanup := newTestLogPath(t)
+	defer cleanup()
+
+	auditLog, err := Open(path, signing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recordTestEntries(t, auditLog)
+	auditLog.Close()
+
+	otherSigning, otherOperator := newTestSigning()
+
+	_, _, err = Verify(path, otherOperator)
+	expectedError := "entry at line [1] is not signed by the operator"
+	if err == nil || !strings.Contains(err.Error(), expectedError) {
+		t.Errorf(
+			"unexpected error\nexpected: [%v]\nactual:   [%v]",
+			expectedError,
+			err,
+		)
+	}
+
+	// Entries of the other operator must not be appended to the log.
+	if _, err := Open(path, otherSigning); err == nil {
+		t.Errorf("expected error when opening log of other operator")
+	}
+}
+
+// rehashRecord replaces the text in the entry of the record and recomputes
+// the hash of the entry, as anyone able to modify the log could do.
+func rehashRecord(t *testing.T, line, old, new string) string {
+	record := &record{}
+	if err := json.Unmarshal([]byte(line), record); err != nil {
+		t.Fatal(err)
+	}
+
+	record.Entry = json.RawMessage(
+		strings.Replace(string(record.Entry), old, new, 1),
+	)
+	record.Hash = entryHash(record.Entry)
+
+	rehashed, err := json.Marshal(record)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(rehashed)
+}
+
+func newTestSigning() (chain.Signing, common.Address) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		panic(err)
+	}
+
+	return ethutil.NewSigner(key), crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func newTestLogPath(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "audit-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return filepath.Join(dir, "audit", "signatures.log"), func() {
+		os.RemoveAll(dir)
+	}
+}
@@ -0,0 +1,91 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// KeepApplicationSource resolves the application which opened the keep.
+// It is implemented by chain handles of keep factories.
+type KeepApplicationSource interface {
+	KeepApplication(keepAddress common.Address) (common.Address, error)
+}
+
+// NewApplicationAllowlistPolicy is a policy authorizing signing requests only
+// of keeps opened by one of the allowed applications. The application of
+// the keep is resolved with the first source knowing the keep, so a source
+// should be provided for each factory the client serves keeps of.
+func NewApplicationAllowlistPolicy(
+	sources []KeepApplicationSource,
+	applications []common.Address,
+) Policy {
+	allowed := make(map[common.Address]bool, len(applications))
+	for _, application := range applications {
+		allowed[application] = true
+	}
+
+	return &applicationAllowlistPolicy{
+		sources:           sources,
+		allowed:           allowed,
+		keepsApplications: make(map[common.Address]common.Address),
+	}
+}
+
+type applicationAllowlistPolicy struct {
+	sources []KeepApplicationSource
+	allowed map[common.Address]bool
+
+	// The application of the keep never changes so it is resolved once.
+	mutex             sync.Mutex
+	keepsApplications map[common.Address]common.Address
+}
+
+func (aap *applicationAllowlistPolicy) Authorize(
+	ctx context.Context,
+	request *Request,
+) error {
+	application, err := aap.keepApplication(request.KeepAddress)
+	if err != nil {
+		return err
+	}
+
+	if !aap.allowed[application] {
+		return refuse(
+			"keep application [%s] is not allowed",
+			application.String(),
+		)
+	}
+
+	return nil
+}
+
+func (aap *applicationAllowlistPolicy) keepApplication(
+	keepAddress common.Address,
+) (common.Address, error) {
+	aap.mutex.Lock()
+	defer aap.mutex.Unlock()
+
+	if application, ok := aap.keepsApplications[keepAddress]; ok {
+		return application, nil
+	}
+
+	for _, source := range aap.sources {
+		application, err := source.KeepApplication(keepAddress)
+		if err != nil {
+			return common.Address{}, fmt.Errorf(
+				"could not resolve keep application: [%v]",
+				err,
+			)
+		}
+
+		if application != (common.Address{}) {
+			aap.keepsApplications[keepAddress] = application
+			return application, nil
+		}
+	}
+
+	return common.Address{}, refuse("keep application is unknown")
+}
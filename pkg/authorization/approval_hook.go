@@ -0,0 +1,130 @@
+package authorization
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// unixSocketScheme is the scheme of the approval hook endpoint listening on
+// a local Unix socket.
+const unixSocketScheme = "unix://"
+
+// approvalHookRequest is the body of the request sent to the approval hook.
+type approvalHookRequest struct {
+	KeepAddress  string `json:"keepAddress"`
+	Digest       string `json:"digest"`
+	RequestBlock uint64 `json:"requestBlock"`
+}
+
+// approvalHookResponse is the body of the response expected from
+// the approval hook.
+type approvalHookResponse struct {
+	Approved bool   `json:"approved"`
+	Reason   string `json:"reason"`
+}
+
+// NewApprovalHookPolicy is a policy asking an external service to approve
+// each signing request. The endpoint is either an HTTP URL or the path to
+// a local Unix socket prefixed with `unix://`, on which the service accepts
+// HTTP requests.
+//
+// The request is posted as a JSON object with the keep address, the hex
+// digest and the request block. The service is expected to respond with
+// the 200 status and a JSON object with the `approved` flag and an optional
+// `reason` of the refusal. Requests are refused if the service does not
+// respond within the timeout.
+func NewApprovalHookPolicy(endpoint string, timeout time.Duration) Policy {
+	client := &http.Client{Timeout: timeout}
+	url := endpoint
+
+	if strings.HasPrefix(endpoint, unixSocketScheme) {
+		socketPath := strings.TrimPrefix(endpoint, unixSocketScheme)
+
+		client.Transport = &http.Transport{
+			DialContext: func(
+				ctx context.Context,
+				_, _ string,
+			) (net.Conn, error) {
+				dialer := &net.Dialer{}
+				return dialer.DialContext(ctx, "unix", socketPath)
+			},
+		}
+		// The host is ignored when dialing the socket.
+		url = "http://localhost/"
+	}
+
+	return &approvalHookPolicy{
+		client: client,
+		url:    url,
+	}
+}
+
+type approvalHookPolicy struct {
+	client *http.Client
+	url    string
+}
+
+func (ahp *approvalHookPolicy) Authorize(
+	ctx context.Context,
+	request *Request,
+) error {
+	body, err := json.Marshal(&approvalHookRequest{
+		KeepAddress:  request.KeepAddress.Hex(),
+		Digest:       hex.EncodeToString(request.Digest[:]),
+		RequestBlock: request.RequestBlock,
+	})
+	if err != nil {
+		return fmt.Errorf("could not marshal approval request: [%v]", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		ahp.url,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return fmt.Errorf("could not create approval request: [%v]", err)
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	httpResponse, err := ahp.client.Do(httpRequest)
+	if err != nil {
+		return fmt.Errorf("approval hook request failed: [%v]", err)
+	}
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return fmt.Errorf(
+			"approval hook responded with status [%d]",
+			httpResponse.StatusCode,
+		)
+	}
+
+	responseBody, err := ioutil.ReadAll(httpResponse.Body)
+	if err != nil {
+		return fmt.Errorf("could not read approval hook response: [%v]", err)
+	}
+
+	response := &approvalHookResponse{}
+	if err := json.Unmarshal(responseBody, response); err != nil {
+		return fmt.Errorf(
+			"could not unmarshal approval hook response: [%v]",
+			err,
+		)
+	}
+
+	if !response.Approved {
+		return refuse("not approved by approval hook: [%s]", response.Reason)
+	}
+
+	return nil
+}
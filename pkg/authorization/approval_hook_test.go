@@ -0,0 +1,153 @@
+package authorization
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestApprovalHookPolicy(t *testing.T) {
+	approvedDigest := [32]byte{1}
+	notApprovedDigest := [32]byte{2}
+
+	var receivedRequest *approvalHookRequest
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			receivedRequest = &approvalHookRequest{}
+			if err := json.NewDecoder(r.Body).Decode(receivedRequest); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			switch receivedRequest.Digest {
+			case hex.EncodeToString(approvedDigest[:]):
+				fmt.Fprint(w, `{"approved": true}`)
+			case hex.EncodeToString(notApprovedDigest[:]):
+				fmt.Fprint(w, `{"approved": false, "reason": "unknown digest"}`)
+			default:
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		},
+	))
+	defer server.Close()
+
+	policy := NewApprovalHookPolicy(server.URL, time.Second)
+
+	var tests = map[string]struct {
+		digestByte       byte
+		expectAuthorized bool
+		expectRefusal    bool
+	}{
+		"approved": {
+			digestByte:       1,
+			expectAuthorized: true,
+		},
+		"not approved": {
+			digestByte:       2,
+			expectAuthorized: false,
+			expectRefusal:    true,
+		},
+		"hook error": {
+			digestByte:       3,
+			expectAuthorized: false,
+			expectRefusal:    false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			request := newTestRequest(test.digestByte)
+
+			err := policy.Authorize(context.Background(), request)
+			if (err == nil) != test.expectAuthorized {
+				t.Fatalf(
+					"unexpected authorization result\n"+
+						"expected authorized: [%v]\nactual error: [%v]",
+					test.expectAuthorized,
+					err,
+				)
+			}
+
+			if IsRefusal(err) != test.expectRefusal {
+				t.Errorf(
+					"unexpected refusal\nexpected: [%v]\nactual:   [%v]",
+					test.expectRefusal,
+					IsRefusal(err),
+				)
+			}
+
+			expectedRequest := &approvalHookRequest{
+				KeepAddress:  request.KeepAddress.Hex(),
+				Digest:       hex.EncodeToString(request.Digest[:]),
+				RequestBlock: request.RequestBlock,
+			}
+			if *receivedRequest != *expectedRequest {
+				t.Errorf(
+					"unexpected approval request\n"+
+						"expected: [%+v]\nactual:   [%+v]",
+					expectedRequest,
+					receivedRequest,
+				)
+			}
+		})
+	}
+}
+
+func TestApprovalHookPolicyUnixSocket(t *testing.T) {
+	dir, err := ioutil.TempDir("", "approval-hook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	socketPath := filepath.Join(dir, "approval.sock")
+
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"approved": true}`)
+		}),
+	}
+	go server.Serve(listener)
+	defer server.Close()
+
+	policy := NewApprovalHookPolicy(unixSocketScheme+socketPath, time.Second)
+
+	if err := policy.Authorize(context.Background(), newTestRequest(1)); err != nil {
+		t.Errorf("unexpected error: [%v]", err)
+	}
+}
+
+func TestApprovalHookPolicyTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(500 * time.Millisecond)
+			fmt.Fprint(w, `{"approved": true}`)
+		},
+	))
+	defer server.Close()
+
+	policy := NewApprovalHookPolicy(server.URL, 100*time.Millisecond)
+
+	err := policy.Authorize(context.Background(), newTestRequest(1))
+	if err == nil {
+		t.Fatalf("expected the request not approved within timeout to fail")
+	}
+	if IsRefusal(err) {
+		t.Errorf("expected the timeout not to be a refusal so it is retried")
+	}
+}
@@ -0,0 +1,121 @@
+// Package authorization contains policies consulted by the client before it
+// starts calculating a signature requested by a keep. The keep contract
+// accepts signing requests only from the keep owner but the operator may want
+// to put additional restrictions on digests signed with its key shares.
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ipfs/go-log"
+
+	"github.com/keep-network/keep-ecdsa/pkg/metrics/collector"
+)
+
+var logger = log.Logger("keep-authorization")
+
+// Request is a request to sign the digest with the key of the keep.
+type Request struct {
+	KeepAddress common.Address
+	Digest      [32]byte
+	// RequestBlock is the block in which the signature has been requested.
+	RequestBlock uint64
+}
+
+// Policy decides if the signing request is authorized.
+type Policy interface {
+	// Authorize returns a Refusal describing the reason of the refusal if
+	// the signing request is not authorized. Any other error means the policy
+	// could not decide, for example because the chain could not be reached,
+	// and the request should be consulted again later.
+	Authorize(ctx context.Context, request *Request) error
+}
+
+// Refusal is the error returned when a policy refused to authorize
+// the signing request. The refusal is final.
+type Refusal struct {
+	reason string
+}
+
+func refuse(format string, args ...interface{}) *Refusal {
+	return &Refusal{reason: fmt.Sprintf(format, args...)}
+}
+
+func (r *Refusal) Error() string {
+	return r.reason
+}
+
+// IsRefusal returns true if the error is a refusal of the signing request.
+// Otherwise, the error means policies could not decide and the request
+// should be consulted again later.
+func IsRefusal(err error) bool {
+	var refusal *Refusal
+	return errors.As(err, &refusal)
+}
+
+// Engine consults all configured policies before the digest is signed.
+// The request is authorized only if all policies authorize it.
+type Engine struct {
+	policies []Policy
+	metrics  *collector.Authorization
+}
+
+// NewEngine creates a new engine consulting the given policies in the order
+// they are provided. Policies counting authorized requests, like the rate
+// limit, should be provided last so that requests refused by other policies
+// are not counted. Each consultation, refusal and error of a policy which
+// could not decide are recorded in the metrics.
+func NewEngine(metrics *collector.Authorization, policies ...Policy) *Engine {
+	return &Engine{
+		policies: policies,
+		metrics:  metrics,
+	}
+}
+
+// Authorize consults all policies of the engine and returns the error of
+// the first policy refusing the signing request or failing to decide.
+// A refusal is returned as a Refusal. A nil engine or an engine with no
+// policies authorizes all requests.
+func (e *Engine) Authorize(ctx context.Context, request *Request) error {
+	if e == nil || len(e.policies) == 0 {
+		return nil
+	}
+
+	e.metrics.Checked()
+
+	for _, policy := range e.policies {
+		err := policy.Authorize(ctx, request)
+		if err == nil {
+			continue
+		}
+
+		if !IsRefusal(err) {
+			e.metrics.Errored()
+
+			logger.Warningf(
+				"could not authorize signing of digest [%+x] for keep [%s]: [%v]",
+				request.Digest,
+				request.KeepAddress.String(),
+				err,
+			)
+
+			return fmt.Errorf("could not authorize signing: [%v]", err)
+		}
+
+		e.metrics.Refused()
+
+		logger.Warningf(
+			"refused signing of digest [%+x] for keep [%s]: [%v]",
+			request.Digest,
+			request.KeepAddress.String(),
+			err,
+		)
+
+		return refuse("signing refused: [%v]", err)
+	}
+
+	return nil
+}
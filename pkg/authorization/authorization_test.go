@@ -0,0 +1,275 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/keep-network/keep-ecdsa/pkg/chain/local"
+	"github.com/keep-network/keep-ecdsa/pkg/metrics/collector"
+)
+
+type testPolicy struct {
+	err   error
+	calls int
+}
+
+func (tp *testPolicy) Authorize(ctx context.Context, request *Request) error {
+	tp.calls++
+	return tp.err
+}
+
+func TestEngineAuthorize(t *testing.T) {
+	var tests = map[string]struct {
+		policies         []*testPolicy
+		expectAuthorized bool
+		expectRefusal    bool
+		expectCalls      []int
+		expectRefusals   uint64
+		expectErrors     uint64
+	}{
+		"all policies authorize": {
+			policies:         []*testPolicy{{}, {}},
+			expectAuthorized: true,
+			expectCalls:      []int{1, 1},
+		},
+		"first policy refuses": {
+			policies:       []*testPolicy{{err: refuse("refused")}, {}},
+			expectRefusal:  true,
+			expectCalls:    []int{1, 0},
+			expectRefusals: 1,
+		},
+		"last policy refuses": {
+			policies:       []*testPolicy{{}, {err: refuse("refused")}},
+			expectRefusal:  true,
+			expectCalls:    []int{1, 1},
+			expectRefusals: 1,
+		},
+		"policy could not decide": {
+			policies:     []*testPolicy{{err: fmt.Errorf("unreachable")}, {}},
+			expectCalls:  []int{1, 0},
+			expectErrors: 1,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			metrics := &collector.Authorization{}
+
+			policies := make([]Policy, len(test.policies))
+			for i, policy := range test.policies {
+				policies[i] = policy
+			}
+
+			engine := NewEngine(metrics, policies...)
+
+			err := engine.Authorize(context.Background(), newTestRequest(1))
+			if (err == nil) != test.expectAuthorized {
+				t.Fatalf(
+					"unexpected authorization result\n"+
+						"expected authorized: [%v]\nactual error: [%v]",
+					test.expectAuthorized,
+					err,
+				)
+			}
+
+			if IsRefusal(err) != test.expectRefusal {
+				t.Errorf(
+					"unexpected refusal\nexpected: [%v]\nactual:   [%v]",
+					test.expectRefusal,
+					IsRefusal(err),
+				)
+			}
+
+			for i, policy := range test.policies {
+				if policy.calls != test.expectCalls[i] {
+					t.Errorf(
+						"unexpected number of calls of policy [%d]\n"+
+							"expected: [%d]\nactual:   [%d]",
+						i,
+						test.expectCalls[i],
+						policy.calls,
+					)
+				}
+			}
+
+			if metrics.Checks() != 1 {
+				t.Errorf(
+					"unexpected number of checks\nexpected: [1]\nactual:   [%d]",
+					metrics.Checks(),
+				)
+			}
+
+			if metrics.Refusals() != test.expectRefusals {
+				t.Errorf(
+					"unexpected number of refusals\n"+
+						"expected: [%d]\nactual:   [%d]",
+					test.expectRefusals,
+					metrics.Refusals(),
+				)
+			}
+
+			if metrics.Errors() != test.expectErrors {
+				t.Errorf(
+					"unexpected number of errors\n"+
+						"expected: [%d]\nactual:   [%d]",
+					test.expectErrors,
+					metrics.Errors(),
+				)
+			}
+		})
+	}
+}
+
+func TestEngineWithNoPolicies(t *testing.T) {
+	metrics := &collector.Authorization{}
+
+	var nilEngine *Engine
+	if err := nilEngine.Authorize(context.Background(), newTestRequest(1)); err != nil {
+		t.Errorf("unexpected error of nil engine: [%v]", err)
+	}
+
+	engine := NewEngine(metrics)
+	if err := engine.Authorize(context.Background(), newTestRequest(1)); err != nil {
+		t.Errorf("unexpected error of engine with no policies: [%v]", err)
+	}
+
+	if metrics.Checks() != 0 {
+		t.Errorf(
+			"unexpected number of checks\nexpected: [0]\nactual:   [%d]",
+			metrics.Checks(),
+		)
+	}
+}
+
+func TestRateLimitPolicy(t *testing.T) {
+	policy := NewRateLimitPolicy(2, time.Hour).(*rateLimitPolicy)
+
+	now := time.Now()
+	policy.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	if err := policy.Authorize(ctx, newTestRequest(1)); err != nil {
+		t.Fatalf("unexpected error for the first digest: [%v]", err)
+	}
+	if err := policy.Authorize(ctx, newTestRequest(2)); err != nil {
+		t.Fatalf("unexpected error for the second digest: [%v]", err)
+	}
+
+	// Retried signing of an already authorized digest is not counted.
+	if err := policy.Authorize(ctx, newTestRequest(1)); err != nil {
+		t.Fatalf("unexpected error for the retried digest: [%v]", err)
+	}
+
+	if err := policy.Authorize(ctx, newTestRequest(3)); err == nil {
+		t.Fatalf("expected the third digest to exceed the limit")
+	}
+
+	// Other keeps have their own limits.
+	otherKeepRequest := newTestRequest(3)
+	otherKeepRequest.KeepAddress = common.HexToAddress("0x2")
+	if err := policy.Authorize(ctx, otherKeepRequest); err != nil {
+		t.Fatalf("unexpected error for other keep: [%v]", err)
+	}
+
+	now = now.Add(time.Hour)
+
+	if err := policy.Authorize(ctx, newTestRequest(3)); err != nil {
+		t.Fatalf("unexpected error after the period: [%v]", err)
+	}
+}
+
+func TestApplicationAllowlistPolicy(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	allowedApplication := common.HexToAddress("0xA")
+	otherApplication := common.HexToAddress("0xB")
+
+	firstFactoryChain := local.Connect(ctx)
+	secondFactoryChain := local.Connect(ctx)
+
+	allowedKeep := common.HexToAddress("0x1")
+	otherKeep := common.HexToAddress("0x2")
+	secondFactoryKeep := common.HexToAddress("0x3")
+	unknownKeep := common.HexToAddress("0x4")
+
+	firstFactoryChain.OpenKeep(allowedKeep, local.RandomSigningGroup(3))
+	firstFactoryChain.OpenKeep(otherKeep, local.RandomSigningGroup(3))
+	secondFactoryChain.OpenKeep(secondFactoryKeep, local.RandomSigningGroup(3))
+
+	if err := firstFactoryChain.SetKeepApplication(
+		allowedKeep,
+		allowedApplication,
+	); err != nil {
+		t.Fatal(err)
+	}
+	if err := firstFactoryChain.SetKeepApplication(
+		otherKeep,
+		otherApplication,
+	); err != nil {
+		t.Fatal(err)
+	}
+	if err := secondFactoryChain.SetKeepApplication(
+		secondFactoryKeep,
+		allowedApplication,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	policy := NewApplicationAllowlistPolicy(
+		[]KeepApplicationSource{firstFactoryChain, secondFactoryChain},
+		[]common.Address{allowedApplication},
+	)
+
+	var tests = map[string]struct {
+		keepAddress      common.Address
+		expectAuthorized bool
+	}{
+		"keep of allowed application": {
+			keepAddress:      allowedKeep,
+			expectAuthorized: true,
+		},
+		"keep of other application": {
+			keepAddress:      otherKeep,
+			expectAuthorized: false,
+		},
+		"keep of allowed application from the second factory": {
+			keepAddress:      secondFactoryKeep,
+			expectAuthorized: true,
+		},
+		"unknown keep": {
+			keepAddress:      unknownKeep,
+			expectAuthorized: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			request := newTestRequest(1)
+			request.KeepAddress = test.keepAddress
+
+			err := policy.Authorize(ctx, request)
+			if (err == nil) != test.expectAuthorized {
+				t.Errorf(
+					"unexpected authorization result\n"+
+						"expected authorized: [%v]\nactual error: [%v]",
+					test.expectAuthorized,
+					err,
+				)
+			}
+		})
+	}
+}
+
+func newTestRequest(digestByte byte) *Request {
+	return &Request{
+		KeepAddress:  common.HexToAddress("0x1"),
+		Digest:       [32]byte{digestByte},
+		RequestBlock: 100,
+	}
+}
@@ -0,0 +1,82 @@
+package authorization
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	configtime "github.com/keep-network/keep-ecdsa/config/time"
+)
+
+const (
+	// The default period in which the number of signatures of a single keep
+	// is limited.
+	defaultRateLimitPeriod = 24 * time.Hour
+
+	// The default timeout of the approval hook request.
+	defaultApprovalHookTimeout = 30 * time.Second
+)
+
+// Config contains configuration of policies consulted before the digest is
+// signed. All policies are disabled by default.
+type Config struct {
+	// Maximum number of distinct digests signed for a single keep within
+	// the rate limit period. The rate limit is disabled if not set.
+	RateLimit       int
+	RateLimitPeriod configtime.Duration
+
+	// Applications allowed to request signatures from keeps they opened.
+	// The allowlist is disabled if no application is set.
+	ApplicationsStrings []string `toml:"Applications"`
+
+	// HTTP URL or `unix://` prefixed path to the Unix socket of the external
+	// service approving signing requests. The hook is disabled if not set.
+	ApprovalHook        string
+	ApprovalHookTimeout configtime.Duration
+
+	// Determines if digests signed by keeps backing tBTC deposits should be
+	// checked against redemption requests of the deposits. The check
+	// requires the tBTC extension to be configured.
+	TBTCRedemptionCheck bool
+}
+
+// GetRateLimitPeriod returns the period in which the number of signatures of
+// a single keep is limited. If a value is not set it returns a default value.
+func (c *Config) GetRateLimitPeriod() time.Duration {
+	period := c.RateLimitPeriod.ToDuration()
+	if period == 0 {
+		period = defaultRateLimitPeriod
+	}
+
+	return period
+}
+
+// GetApprovalHookTimeout returns the timeout of the approval hook request.
+// If a value is not set it returns a default value.
+func (c *Config) GetApprovalHookTimeout() time.Duration {
+	timeout := c.ApprovalHookTimeout.ToDuration()
+	if timeout == 0 {
+		timeout = defaultApprovalHookTimeout
+	}
+
+	return timeout
+}
+
+// Applications returns the list of allowed applications as a slice of
+// ethereum addresses.
+func (c *Config) Applications() ([]common.Address, error) {
+	applications := make([]common.Address, len(c.ApplicationsStrings))
+
+	for i, application := range c.ApplicationsStrings {
+		if !common.IsHexAddress(application) {
+			return nil, fmt.Errorf(
+				"application address [%v] is not valid hex address",
+				application,
+			)
+		}
+
+		applications[i] = common.HexToAddress(application)
+	}
+
+	return applications, nil
+}
@@ -0,0 +1,70 @@
+package authorization
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewRateLimitPolicy is a policy authorizing at most the given number of
+// distinct digests of a single keep within the period. The same digest is
+// authorized again within the period without being counted twice, so
+// the signing can be retried.
+func NewRateLimitPolicy(limit int, period time.Duration) Policy {
+	return &rateLimitPolicy{
+		limit:    limit,
+		period:   period,
+		now:      time.Now,
+		requests: make(map[common.Address]map[[32]byte]time.Time),
+	}
+}
+
+type rateLimitPolicy struct {
+	limit  int
+	period time.Duration
+	now    func() time.Time
+
+	mutex sync.Mutex
+	// requests holds times of authorized requests by keeps and digests.
+	requests map[common.Address]map[[32]byte]time.Time
+}
+
+func (rlp *rateLimitPolicy) Authorize(
+	ctx context.Context,
+	request *Request,
+) error {
+	rlp.mutex.Lock()
+	defer rlp.mutex.Unlock()
+
+	now := rlp.now()
+
+	keepRequests, ok := rlp.requests[request.KeepAddress]
+	if !ok {
+		keepRequests = make(map[[32]byte]time.Time)
+		rlp.requests[request.KeepAddress] = keepRequests
+	}
+
+	for digest, authorizedAt := range keepRequests {
+		if now.Sub(authorizedAt) >= rlp.period {
+			delete(keepRequests, digest)
+		}
+	}
+
+	if _, ok := keepRequests[request.Digest]; ok {
+		return nil
+	}
+
+	if len(keepRequests) >= rlp.limit {
+		return refuse(
+			"keep exceeded the limit of [%d] signatures per [%s]",
+			rlp.limit,
+			rlp.period,
+		)
+	}
+
+	keepRequests[request.Digest] = now
+
+	return nil
+}
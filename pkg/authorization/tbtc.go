@@ -0,0 +1,120 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
+)
+
+// NewTBTCRedemptionPolicy is a policy authorizing signing requests of keeps
+// backing tBTC deposits only if the digest is the sighash of a redemption
+// requested for the deposit. Signing requests of keeps not backing any
+// deposit are not restricted by this policy.
+func NewTBTCRedemptionPolicy(tbtcChain eth.TBTCHandle) Policy {
+	return &tbtcRedemptionPolicy{
+		chain:        tbtcChain,
+		keepsDeposit: make(map[common.Address]string),
+	}
+}
+
+type tbtcRedemptionPolicy struct {
+	chain eth.TBTCHandle
+
+	// The deposit backed by the keep never changes so it is resolved once.
+	// An empty deposit address means the keep does not back any deposit.
+	mutex        sync.Mutex
+	keepsDeposit map[common.Address]string
+}
+
+func (trp *tbtcRedemptionPolicy) Authorize(
+	ctx context.Context,
+	request *Request,
+) error {
+	depositAddress, err := trp.keepDeposit(request.KeepAddress)
+	if err != nil {
+		return err
+	}
+
+	if depositAddress == "" {
+		return nil
+	}
+
+	// The redemption is requested in the same transaction in which
+	// the signature is requested from the keep.
+	events, err := trp.chain.PastDepositRedemptionRequestedEvents(
+		request.RequestBlock,
+		depositAddress,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"could not get redemption requests of deposit [%s]: [%v]",
+			depositAddress,
+			err,
+		)
+	}
+
+	for _, event := range events {
+		if event.Digest == request.Digest {
+			return nil
+		}
+	}
+
+	return refuse(
+		"digest does not match any redemption request of deposit [%s]",
+		depositAddress,
+	)
+}
+
+func (trp *tbtcRedemptionPolicy) keepDeposit(
+	keepAddress common.Address,
+) (string, error) {
+	trp.mutex.Lock()
+	defer trp.mutex.Unlock()
+
+	if depositAddress, ok := trp.keepsDeposit[keepAddress]; ok {
+		return depositAddress, nil
+	}
+
+	// The deposit is created in the same transaction in which the keep is
+	// opened so the lookup starts from the block in which the keep has been
+	// opened.
+	openedTimestamp, err := trp.chain.GetOpenedTimestamp(keepAddress)
+	if err != nil {
+		return "", fmt.Errorf(
+			"could not get opened timestamp of the keep: [%v]",
+			err,
+		)
+	}
+
+	openedBlock, err := eth.FirstBlockAt(trp.chain, openedTimestamp)
+	if err != nil {
+		return "", fmt.Errorf(
+			"could not determine block in which the keep has been opened: [%v]",
+			err,
+		)
+	}
+
+	events, err := trp.chain.PastDepositCreatedEvents(
+		openedBlock,
+		[]string{keepAddress.Hex()},
+	)
+	if err != nil {
+		return "", fmt.Errorf(
+			"could not get deposit backed by the keep: [%v]",
+			err,
+		)
+	}
+
+	depositAddress := ""
+	if len(events) > 0 {
+		depositAddress = events[0].DepositAddress
+	}
+
+	trp.keepsDeposit[keepAddress] = depositAddress
+
+	return depositAddress, nil
+}
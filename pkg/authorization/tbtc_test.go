@@ -0,0 +1,89 @@
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/keep-network/keep-ecdsa/pkg/chain/local"
+)
+
+func TestTBTCRedemptionPolicy(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	tbtcChain := local.NewTBTCLocalChain(ctx)
+
+	depositAddress := "0xa5FA806723A7c7c8523F33c39686f20b52612877"
+	tbtcChain.CreateDeposit(depositAddress, local.RandomSigningGroup(3))
+
+	keepAddressHex, err := tbtcChain.KeepAddress(depositAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keepAddress := common.HexToAddress(keepAddressHex)
+
+	// The keep must have the public key to be requested for a signature.
+	if err := tbtcChain.SubmitKeepPublicKey(keepAddress, [64]byte{1}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tbtcChain.RedeemDeposit(depositAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	redemptionRequests, err := tbtcChain.PastDepositRedemptionRequestedEvents(
+		0,
+		depositAddress,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	redemptionDigest := redemptionRequests[0].Digest
+
+	otherKeepAddress := common.HexToAddress("0x1")
+	tbtcChain.OpenKeep(otherKeepAddress, local.RandomSigningGroup(3))
+
+	policy := NewTBTCRedemptionPolicy(tbtcChain)
+
+	var tests = map[string]struct {
+		keepAddress      common.Address
+		digest           [32]byte
+		expectAuthorized bool
+	}{
+		"redemption digest of deposit keep": {
+			keepAddress:      keepAddress,
+			digest:           redemptionDigest,
+			expectAuthorized: true,
+		},
+		"other digest of deposit keep": {
+			keepAddress:      keepAddress,
+			digest:           [32]byte{1},
+			expectAuthorized: false,
+		},
+		"digest of keep not backing any deposit": {
+			keepAddress:      otherKeepAddress,
+			digest:           [32]byte{1},
+			expectAuthorized: true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			err := policy.Authorize(ctx, &Request{
+				KeepAddress:  test.keepAddress,
+				Digest:       test.digest,
+				RequestBlock: 0,
+			})
+			if (err == nil) != test.expectAuthorized {
+				t.Errorf(
+					"unexpected authorization result\n"+
+						"expected authorized: [%v]\nactual error: [%v]",
+					test.expectAuthorized,
+					err,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,289 @@
+// Package backup contains encrypted backups of key shares. A backup archive
+// bundles signers of keeps, including their snapshots, encrypted either with
+// a key derived from a passphrase or to a backup public key. The archive
+// contains a plaintext manifest describing the signers so the backup can be
+// inspected without decrypting it.
+package backup
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/ecies"
+	"github.com/keep-network/keep-common/pkg/encryption"
+	"github.com/keep-network/keep-core/pkg/operator"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
+)
+
+// archiveVersion is the version of the archive format.
+const archiveVersion = 1
+
+// Methods of the archive encryption.
+const (
+	// PassphraseEncryption means the archive is encrypted with a key
+	// derived from a passphrase with scrypt.
+	PassphraseEncryption = "passphrase"
+	// PublicKeyEncryption means the archive is encrypted to a secp256k1
+	// public key with ECIES.
+	PublicKeyEncryption = "public-key"
+)
+
+// Entry is the signer of the keep included in the backup.
+type Entry struct {
+	KeepAddress common.Address
+	Signer      *tss.ThresholdSigner
+	// Snapshot is true if the signer is a snapshot, not the current signer
+	// of the keep.
+	Snapshot bool
+}
+
+// Validate checks if the entry can be imported by the operator with the given
+// public key. The signer must be internally consistent, it must belong to the
+// operator and the operator must be a member of the keep. The public key of
+// the signer must match the keep public key. Snapshots are taken before the
+// public key is submitted so the key of a snapshot is compared only if the
+// keep public key has already been submitted.
+func (e *Entry) Validate(
+	chain registry.SignerChain,
+	operatorPublicKey *operator.PublicKey,
+) error {
+	operatorMemberID := tss.MemberIDFromPublicKey(operatorPublicKey)
+	if !e.Signer.MemberID().Equal(operatorMemberID) {
+		return fmt.Errorf(
+			"signer member ID [%s] does not match operator member ID [%s]",
+			e.Signer.MemberID().String(),
+			operatorMemberID.String(),
+		)
+	}
+
+	operatorAddress := crypto.PubkeyToAddress(ecdsa.PublicKey(*operatorPublicKey))
+	if err := registry.ValidateSigner(
+		chain,
+		e.KeepAddress,
+		e.Signer,
+		operatorAddress,
+		e.Snapshot,
+	); err != nil {
+		return fmt.Errorf("invalid signer: [%v]", err)
+	}
+
+	return nil
+}
+
+// ManifestEntry describes the signer included in the backup without
+// revealing the key share.
+type ManifestEntry struct {
+	KeepAddress    string `json:"keep_address"`
+	MemberID       string `json:"member_id"`
+	GroupPublicKey string `json:"group_public_key"`
+	Snapshot       bool   `json:"snapshot"`
+}
+
+// Archive is the encrypted backup of key shares.
+type Archive struct {
+	Version    int              `json:"version"`
+	CreatedAt  time.Time        `json:"created_at"`
+	Manifest   []*ManifestEntry `json:"manifest"`
+	Encryption string           `json:"encryption"`
+	Salt       []byte           `json:"salt,omitempty"`
+	Payload    []byte           `json:"payload"`
+}
+
+type payloadEntry struct {
+	KeepAddress common.Address `json:"keep_address"`
+	Snapshot    bool           `json:"snapshot"`
+	Signer      []byte         `json:"signer"`
+}
+
+// Key is the key encrypting or decrypting the archive. Exactly one of
+// the passphrase and the public key should be set to encrypt the archive.
+// Exactly one of the passphrase and the private key should be set to decrypt
+// it.
+type Key struct {
+	Passphrase string
+	PublicKey  *ecdsa.PublicKey
+	PrivateKey *ecdsa.PrivateKey
+}
+
+// Export creates the archive with the given entries encrypted with the key.
+func Export(entries []*Entry, key *Key) (*Archive, error) {
+	archive := &Archive{
+		Version:   archiveVersion,
+		CreatedAt: time.Now().UTC(),
+		Manifest:  make([]*ManifestEntry, len(entries)),
+	}
+
+	payload := make([]*payloadEntry, len(entries))
+	for i, entry := range entries {
+		signerBytes, err := entry.Signer.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to marshal signer of keep [%s]: [%v]",
+				entry.KeepAddress.String(),
+				err,
+			)
+		}
+
+		payload[i] = &payloadEntry{
+			KeepAddress: entry.KeepAddress,
+			Snapshot:    entry.Snapshot,
+			Signer:      signerBytes,
+		}
+		archive.Manifest[i] = newManifestEntry(entry)
+	}
+
+	plaintext, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: [%v]", err)
+	}
+
+	switch {
+	case key.Passphrase != "" && key.PublicKey == nil:
+		archive.Encryption = PassphraseEncryption
+
+		archive.Salt = make([]byte, registry.SaltLength)
+		if _, err := rand.Read(archive.Salt); err != nil {
+			return nil, fmt.Errorf("failed to generate salt: [%v]", err)
+		}
+
+		box, err := passphraseBox(key.Passphrase, archive.Salt)
+		if err != nil {
+			return nil, err
+		}
+
+		archive.Payload, err = box.Encrypt(plaintext)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt payload: [%v]", err)
+		}
+	case key.PublicKey != nil && key.Passphrase == "":
+		archive.Encryption = PublicKeyEncryption
+
+		archive.Payload, err = ecies.Encrypt(
+			rand.Reader,
+			ecies.ImportECDSAPublic(key.PublicKey),
+			plaintext,
+			nil,
+			nil,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt payload: [%v]", err)
+		}
+	default:
+		return nil, fmt.Errorf(
+			"either passphrase or public key is required to encrypt the archive",
+		)
+	}
+
+	return archive, nil
+}
+
+func newManifestEntry(entry *Entry) *ManifestEntry {
+	return &ManifestEntry{
+		KeepAddress: entry.KeepAddress.Hex(),
+		MemberID:    entry.Signer.MemberID().String(),
+		GroupPublicKey: hex.EncodeToString(
+			entry.Signer.PublicKey().Marshal(),
+		),
+		Snapshot: entry.Snapshot,
+	}
+}
+
+// Decrypt decrypts entries of the archive with the key. Each signer is
+// validated by unmarshalling it and computing its public key. Decrypted
+// entries must match the manifest of the archive.
+func (a *Archive) Decrypt(key *Key) ([]*Entry, error) {
+	if a.Version != archiveVersion {
+		return nil, fmt.Errorf("unsupported archive version [%d]", a.Version)
+	}
+
+	var plaintext []byte
+	var err error
+
+	switch a.Encryption {
+	case PassphraseEncryption:
+		if key.Passphrase == "" {
+			return nil, fmt.Errorf("passphrase is required to decrypt the archive")
+		}
+
+		box, err := passphraseBox(key.Passphrase, a.Salt)
+		if err != nil {
+			return nil, err
+		}
+
+		plaintext, err = box.Decrypt(a.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt payload: [%v]", err)
+		}
+	case PublicKeyEncryption:
+		if key.PrivateKey == nil {
+			return nil, fmt.Errorf("private key is required to decrypt the archive")
+		}
+
+		plaintext, err = ecies.ImportECDSA(key.PrivateKey).Decrypt(
+			a.Payload,
+			nil,
+			nil,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt payload: [%v]", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported encryption [%s]", a.Encryption)
+	}
+
+	var payload []*payloadEntry
+	if err := json.Unmarshal(plaintext, &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal payload: [%v]", err)
+	}
+
+	if len(payload) != len(a.Manifest) {
+		return nil, fmt.Errorf(
+			"payload has [%d] entries but manifest has [%d]",
+			len(payload),
+			len(a.Manifest),
+		)
+	}
+
+	entries := make([]*Entry, len(payload))
+	for i, payloadEntry := range payload {
+		signer := &tss.ThresholdSigner{}
+		if err := signer.Unmarshal(payloadEntry.Signer); err != nil {
+			return nil, fmt.Errorf(
+				"failed to unmarshal signer of keep [%s]: [%v]",
+				payloadEntry.KeepAddress.String(),
+				err,
+			)
+		}
+
+		entries[i] = &Entry{
+			KeepAddress: payloadEntry.KeepAddress,
+			Signer:      signer,
+			Snapshot:    payloadEntry.Snapshot,
+		}
+
+		if *newManifestEntry(entries[i]) != *a.Manifest[i] {
+			return nil, fmt.Errorf(
+				"signer of keep [%s] does not match the manifest",
+				payloadEntry.KeepAddress.String(),
+			)
+		}
+	}
+
+	return entries, nil
+}
+
+func passphraseBox(passphrase string, salt []byte) (encryption.Box, error) {
+	key, err := registry.DeriveKey(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	return encryption.NewBox(key), nil
+}
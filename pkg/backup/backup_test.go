@@ -0,0 +1,261 @@
+package backup
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/keep-network/keep-core/pkg/operator"
+	"github.com/keep-network/keep-ecdsa/internal/testdata"
+	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss/gen/pb"
+)
+
+var (
+	keepAddress1 = common.HexToAddress("0x770a9E2F2Aa1eC2d3Ca916Fc3e6A55058A898632")
+	keepAddress2 = common.HexToAddress("0x8B3BccB3A3994681A1C1584DE4b4E8b23ed1Ed6d")
+)
+
+func TestExportDecrypt(t *testing.T) {
+	entries, err := testEntries()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = map[string]struct {
+		encryptionKey *Key
+		decryptionKey *Key
+	}{
+		"passphrase": {
+			encryptionKey: &Key{Passphrase: "passphrase"},
+			decryptionKey: &Key{Passphrase: "passphrase"},
+		},
+		"public key": {
+			encryptionKey: &Key{PublicKey: &privateKey.PublicKey},
+			decryptionKey: &Key{PrivateKey: privateKey},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			archive, err := Export(entries, test.encryptionKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(archive.Manifest) != 2 ||
+				archive.Manifest[0].KeepAddress != keepAddress1.Hex() ||
+				!archive.Manifest[1].Snapshot {
+				t.Errorf("unexpected manifest [%+v]", archive.Manifest)
+			}
+
+			decrypted, err := archive.Decrypt(test.decryptionKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(entries, decrypted) {
+				t.Errorf(
+					"unexpected entries\nexpected: [%+v]\nactual:   [%+v]",
+					entries,
+					decrypted,
+				)
+			}
+		})
+	}
+}
+
+func TestDecryptFailures(t *testing.T) {
+	entries, err := testEntries()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive, err := Export(entries, &Key{Passphrase: "passphrase"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := archive.Decrypt(&Key{Passphrase: "wrong"}); err == nil {
+		t.Errorf("expected error for a wrong passphrase")
+	}
+
+	archive.Manifest[0].KeepAddress = keepAddress2.Hex()
+	if _, err := archive.Decrypt(&Key{Passphrase: "passphrase"}); err == nil {
+		t.Errorf("expected error for a manifest not matching the payload")
+	}
+}
+
+func TestEntryValidate(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	operatorPublicKey := (*operator.PublicKey)(&privateKey.PublicKey)
+	operatorAddress := crypto.PubkeyToAddress(privateKey.PublicKey)
+	otherAddress := common.HexToAddress("0x65ea55c1f10491038425725dc00dffeab2a1e28a")
+
+	entries, err := testEntriesOfMember(tss.MemberIDFromPublicKey(operatorPublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, snapshot := entries[0], entries[1]
+
+	otherEntries, err := testEntries()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publicKey := func(entry *Entry) []byte {
+		serialized, err := eth.SerializePublicKey(entry.Signer.PublicKey())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return serialized[:]
+	}
+	otherPublicKey := bytes.Repeat([]byte{1}, 64)
+
+	var tests = map[string]struct {
+		entry         *Entry
+		members       []common.Address
+		keepPublicKey []byte
+		expectedError bool
+	}{
+		"valid signer": {
+			entry:         signer,
+			members:       []common.Address{otherAddress, operatorAddress},
+			keepPublicKey: publicKey(signer),
+		},
+		"signer of another member": {
+			entry:         otherEntries[0],
+			members:       []common.Address{otherAddress, operatorAddress},
+			keepPublicKey: publicKey(otherEntries[0]),
+			expectedError: true,
+		},
+		"operator is not a member": {
+			entry:         signer,
+			members:       []common.Address{otherAddress},
+			keepPublicKey: publicKey(signer),
+			expectedError: true,
+		},
+		"public key does not match": {
+			entry:         signer,
+			members:       []common.Address{operatorAddress},
+			keepPublicKey: otherPublicKey,
+			expectedError: true,
+		},
+		"public key not submitted": {
+			entry:         signer,
+			members:       []common.Address{operatorAddress},
+			keepPublicKey: make([]byte, 64),
+			expectedError: true,
+		},
+		"snapshot with public key not submitted": {
+			entry:         snapshot,
+			members:       []common.Address{operatorAddress},
+			keepPublicKey: make([]byte, 64),
+		},
+		"snapshot with public key not matching": {
+			entry:         snapshot,
+			members:       []common.Address{operatorAddress},
+			keepPublicKey: otherPublicKey,
+			expectedError: true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			chain := &testChain{
+				members:       test.members,
+				keepPublicKey: test.keepPublicKey,
+			}
+
+			err := test.entry.Validate(chain, operatorPublicKey)
+			if test.expectedError != (err != nil) {
+				t.Errorf(
+					"unexpected validation result\nexpected error: [%v]\nactual error:   [%v]",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
+
+type testChain struct {
+	members       []common.Address
+	keepPublicKey []byte
+}
+
+func (tc *testChain) GetMembers(
+	keepAddress common.Address,
+) ([]common.Address, error) {
+	return tc.members, nil
+}
+
+func (tc *testChain) GetPublicKey(keepAddress common.Address) ([]uint8, error) {
+	return tc.keepPublicKey, nil
+}
+
+func testEntries() ([]*Entry, error) {
+	return testEntriesOfMember(nil)
+}
+
+// testEntriesOfMember creates entries with signers of the given member. If
+// the member ID is nil, each signer gets a different member ID.
+func testEntriesOfMember(operatorMemberID tss.MemberID) ([]*Entry, error) {
+	testData, err := testdata.LoadKeygenTestFixtures(2)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load key gen test fixtures: [%v]", err)
+	}
+
+	entries := make([]*Entry, len(testData))
+	for i, keepAddress := range []common.Address{keepAddress1, keepAddress2} {
+		thresholdKey := tss.ThresholdKey(testData[i])
+		thresholdKeyBytes, err := thresholdKey.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal threshold key: [%v]", err)
+		}
+
+		memberID := operatorMemberID
+		if memberID == nil {
+			memberID = tss.MemberID([]byte{byte(i + 1)})
+		}
+		bytes, err := proto.Marshal(&pb.ThresholdSigner{
+			GroupInfo: &pb.ThresholdSigner_GroupInfo{
+				GroupID:            "test-group",
+				MemberID:           memberID,
+				GroupMemberIDs:     [][]byte{memberID},
+				DishonestThreshold: 0,
+			},
+			ThresholdKey: thresholdKeyBytes,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal signer: [%v]", err)
+		}
+
+		signer := &tss.ThresholdSigner{}
+		if err := signer.Unmarshal(bytes); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal signer: [%v]", err)
+		}
+
+		entries[i] = &Entry{
+			KeepAddress: keepAddress,
+			Signer:      signer,
+			Snapshot:    i == 1,
+		}
+	}
+
+	return entries, nil
+}
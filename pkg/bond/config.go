@@ -0,0 +1,66 @@
+package bond
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/keep-network/keep-common/pkg/chain/ethereum"
+	configtime "github.com/keep-network/keep-ecdsa/config/time"
+)
+
+const (
+	// The default interval of checking operator's unbonded value and bonds.
+	defaultMonitoringInterval = 10 * time.Minute
+
+	// The default margin in percent above the minimum bond of the factory
+	// at which an alert is raised that the operator's available unbonded value
+	// is close to the minimum.
+	defaultMinimumBondAlertMargin = 10
+)
+
+// Config contains configuration of the bond monitoring.
+type Config struct {
+	// Interval of checking operator's unbonded value and bonds.
+	Interval configtime.Duration
+
+	// Available unbonded value below which an alert is raised for an
+	// application. No alert is raised if the threshold is not set.
+	UnbondedValueAlertThreshold *ethereum.Wei
+
+	// Margin in percent above the factory's minimum bond at which an alert is
+	// raised that the available unbonded value is close to the minimum.
+	MinimumBondAlertMargin uint
+}
+
+// GetInterval returns the interval of checking operator's unbonded value and
+// bonds. If a value is not set it returns a default value.
+func (c *Config) GetInterval() time.Duration {
+	interval := c.Interval.ToDuration()
+	if interval == 0 {
+		interval = defaultMonitoringInterval
+	}
+
+	return interval
+}
+
+// GetUnbondedValueAlertThreshold returns the available unbonded value below
+// which an alert is raised. If a value is not set it returns nil meaning
+// the alert is disabled.
+func (c *Config) GetUnbondedValueAlertThreshold() *big.Int {
+	if c.UnbondedValueAlertThreshold == nil {
+		return nil
+	}
+
+	return c.UnbondedValueAlertThreshold.Int
+}
+
+// GetMinimumBondAlertMargin returns the margin in percent above the factory's
+// minimum bond at which an alert is raised. If a value is not set it returns
+// a default value.
+func (c *Config) GetMinimumBondAlertMargin() uint {
+	if c.MinimumBondAlertMargin == 0 {
+		return defaultMinimumBondAlertMargin
+	}
+
+	return c.MinimumBondAlertMargin
+}
@@ -0,0 +1,295 @@
+// Package bond contains the monitor of operator's unbonded value and bonds
+// held by keeps the operator is a member of.
+package bond
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ipfs/go-log"
+
+	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/utils"
+)
+
+var logger = log.Logger("keep-bond")
+
+// Factory is a keep factory which bonds are monitored. Chain is the chain
+// handle operating on the factory contract and Applications are applications
+// for which the operator is registered as a member candidate in that factory.
+type Factory struct {
+	Chain        eth.BondedECDSAKeepFactory
+	Applications []common.Address
+}
+
+// KeepsSource provides addresses of keeps the operator is a member of.
+type KeepsSource interface {
+	// GetKeepsAddresses returns addresses of active keeps.
+	GetKeepsAddresses() []common.Address
+}
+
+// ApplicationStatus is the bonding status of the operator for an application.
+type ApplicationStatus struct {
+	Application common.Address
+	// AvailableUnbondedValue is the operator's value available for bonding
+	// in new keeps of the application.
+	AvailableUnbondedValue *big.Int
+	// MinimumBond is the minimum available unbonded value the factory requires
+	// from operators to be eligible for new keeps.
+	MinimumBond *big.Int
+	// MinimumBondMargin is the difference between the available unbonded
+	// value and the minimum bond. Negative margin means the operator is not
+	// eligible for new keeps of the application.
+	MinimumBondMargin *big.Int
+	// Alert is set if the available unbonded value requires the operator's
+	// attention.
+	Alert bool
+}
+
+// Status is a snapshot of the operator's bonding status taken by the monitor.
+type Status struct {
+	Applications []*ApplicationStatus
+	// Bonds maps addresses of active keeps to the value of operator's bond
+	// held by the keep.
+	Bonds            map[common.Address]*big.Int
+	TotalBondedValue *big.Int
+	CheckedAt        time.Time
+}
+
+// AlertsCount returns the number of applications with raised alerts.
+func (s *Status) AlertsCount() int {
+	count := 0
+	for _, application := range s.Applications {
+		if application.Alert {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Monitor periodically checks the operator's available unbonded value for all
+// applications of the given factories and bonds held by active keeps.
+// It raises alerts in logs when the available unbonded value gets close to
+// or below the factory's minimum bond or below the configured threshold.
+type Monitor struct {
+	factories []*Factory
+	keeps     KeepsSource
+	config    *Config
+
+	statusMutex sync.RWMutex
+	status      *Status
+}
+
+// NewMonitor creates a new bond monitor for the given factories and keeps.
+func NewMonitor(
+	factories []*Factory,
+	keeps KeepsSource,
+	config *Config,
+) *Monitor {
+	return &Monitor{
+		factories: factories,
+		keeps:     keeps,
+		config:    config,
+		status: &Status{
+			Applications:     []*ApplicationStatus{},
+			Bonds:            make(map[common.Address]*big.Int),
+			TotalBondedValue: big.NewInt(0),
+		},
+	}
+}
+
+// Start checks the bonding status right away and then periodically with
+// the configured interval until the context is done. Checks with failed chain
+// calls are retried according to the provided retry policy.
+func (m *Monitor) Start(ctx context.Context, retryPolicy utils.BackoffPolicy) {
+	interval := m.config.GetInterval()
+
+	logger.Infof(
+		"starting bond monitoring with [%v] interval",
+		interval,
+	)
+
+	go func() {
+		backoff := utils.NewBackoff(retryPolicy)
+
+		for {
+			if err := m.Check(); err != nil {
+				logger.Warningf(
+					"bond monitoring check failed: [%v]; "+
+						"will retry after backoff",
+					err,
+				)
+				if err := backoff.Wait(ctx); err != nil {
+					return
+				}
+				continue
+			}
+
+			backoff.Reset()
+
+			select {
+			case <-time.After(interval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Check checks the operator's bonding status and raises alerts if needed.
+// The status is available with Status once the check completes. Values which
+// could not be read from the chain are missing in the status and the check
+// returns an error then.
+func (m *Monitor) Check() error {
+	failedCalls := 0
+
+	status := &Status{
+		Applications:     []*ApplicationStatus{},
+		Bonds:            make(map[common.Address]*big.Int),
+		TotalBondedValue: big.NewInt(0),
+	}
+
+	for _, factory := range m.factories {
+		if len(factory.Applications) == 0 {
+			continue
+		}
+
+		minimumBond, err := factory.Chain.MinimumBond()
+		if err != nil {
+			logger.Errorf("failed to get minimum bond: [%v]", err)
+			failedCalls++
+			continue
+		}
+
+		for _, application := range factory.Applications {
+			availableUnbondedValue, err := factory.Chain.AvailableUnbondedValue(
+				application,
+			)
+			if err != nil {
+				logger.Errorf(
+					"failed to get available unbonded value for "+
+						"application [%s]: [%v]",
+					application.String(),
+					err,
+				)
+				failedCalls++
+				continue
+			}
+
+			applicationStatus := &ApplicationStatus{
+				Application:            application,
+				AvailableUnbondedValue: availableUnbondedValue,
+				MinimumBond:            minimumBond,
+				MinimumBondMargin: new(big.Int).Sub(
+					availableUnbondedValue,
+					minimumBond,
+				),
+			}
+			applicationStatus.Alert = m.alert(applicationStatus)
+
+			status.Applications = append(status.Applications, applicationStatus)
+		}
+	}
+
+	for _, keepAddress := range m.keeps.GetKeepsAddresses() {
+		bondedValue := big.NewInt(0)
+
+		// Bonds of the keep are held in the bonding contract of the factory
+		// which created the keep so the bond is found in only one of them.
+		for _, factory := range m.factories {
+			bondAmount, err := factory.Chain.BondAmount(keepAddress)
+			if err != nil {
+				logger.Warningf(
+					"failed to get bond amount for keep [%s]: [%v]",
+					keepAddress.String(),
+					err,
+				)
+				failedCalls++
+				continue
+			}
+
+			bondedValue.Add(bondedValue, bondAmount)
+		}
+
+		status.Bonds[keepAddress] = bondedValue
+		status.TotalBondedValue.Add(status.TotalBondedValue, bondedValue)
+	}
+
+	status.CheckedAt = time.Now()
+
+	logger.Debugf(
+		"operator has [%v] wei bonded in [%v] active keeps",
+		status.TotalBondedValue,
+		len(status.Bonds),
+	)
+
+	m.statusMutex.Lock()
+	m.status = status
+	m.statusMutex.Unlock()
+
+	if failedCalls > 0 {
+		return fmt.Errorf("[%d] chain calls failed", failedCalls)
+	}
+
+	return nil
+}
+
+func (m *Monitor) alert(status *ApplicationStatus) bool {
+	if status.MinimumBondMargin.Sign() < 0 {
+		logger.Errorf(
+			"available unbonded value [%v] wei for application [%s] is "+
+				"below the minimum bond [%v] wei; operator is not eligible "+
+				"for new keeps; please deposit more ETH for bonding",
+			status.AvailableUnbondedValue,
+			status.Application.String(),
+			status.MinimumBond,
+		)
+		return true
+	}
+
+	alertMargin := new(big.Int).Div(
+		new(big.Int).Mul(
+			status.MinimumBond,
+			new(big.Int).SetUint64(uint64(m.config.GetMinimumBondAlertMargin())),
+		),
+		big.NewInt(100),
+	)
+	if status.MinimumBondMargin.Cmp(alertMargin) < 0 {
+		logger.Warningf(
+			"available unbonded value [%v] wei for application [%s] is "+
+				"close to the minimum bond [%v] wei; please consider "+
+				"depositing more ETH for bonding",
+			status.AvailableUnbondedValue,
+			status.Application.String(),
+			status.MinimumBond,
+		)
+		return true
+	}
+
+	threshold := m.config.GetUnbondedValueAlertThreshold()
+	if threshold != nil && status.AvailableUnbondedValue.Cmp(threshold) < 0 {
+		logger.Warningf(
+			"available unbonded value [%v] wei for application [%s] is "+
+				"below the alert threshold [%v] wei",
+			status.AvailableUnbondedValue,
+			status.Application.String(),
+			threshold,
+		)
+		return true
+	}
+
+	return false
+}
+
+// Status returns the operator's bonding status from the latest check.
+func (m *Monitor) Status() *Status {
+	m.statusMutex.RLock()
+	defer m.statusMutex.RUnlock()
+
+	return m.status
+}
@@ -0,0 +1,192 @@
+package bond
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-common/pkg/chain/ethereum"
+
+	"github.com/keep-network/keep-ecdsa/pkg/chain/local"
+)
+
+var ether = big.NewInt(1e18)
+
+type keepsSource []common.Address
+
+func (ks keepsSource) GetKeepsAddresses() []common.Address {
+	return ks
+}
+
+func TestCheckReportsApplicationsStatus(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	chain := local.Connect(ctx)
+
+	healthyApplication := common.HexToAddress("0x65ea55c1f10491038425725dc00dffeab2a1e28a")
+	closeToMinimumApplication := common.HexToAddress("0x2bc5Fb2b7A2D6C7A8ee4bb2ADb8b8AFe8E1A5e3b")
+	belowMinimumApplication := common.HexToAddress("0x1e4Fb3B6Cf0c6b2E7Ab8dE39a9F1E3f0A5a43C2d")
+
+	chain.SetAvailableUnbondedValue(healthyApplication, etherValue(25))
+	chain.SetAvailableUnbondedValue(closeToMinimumApplication, etherValue(21))
+	chain.SetAvailableUnbondedValue(belowMinimumApplication, etherValue(15))
+
+	monitor := NewMonitor(
+		[]*Factory{
+			{
+				Chain: chain,
+				Applications: []common.Address{
+					healthyApplication,
+					closeToMinimumApplication,
+					belowMinimumApplication,
+				},
+			},
+		},
+		keepsSource{},
+		&Config{},
+	)
+
+	if err := monitor.Check(); err != nil {
+		t.Fatal(err)
+	}
+
+	status := monitor.Status()
+
+	expectedApplications := []struct {
+		application common.Address
+		margin      *big.Int
+		alert       bool
+	}{
+		{healthyApplication, etherValue(5), false},
+		{closeToMinimumApplication, etherValue(1), true},
+		{belowMinimumApplication, etherValue(-5), true},
+	}
+
+	if len(status.Applications) != len(expectedApplications) {
+		t.Fatalf(
+			"unexpected number of applications\nexpected: [%v]\nactual:   [%v]",
+			len(expectedApplications),
+			len(status.Applications),
+		)
+	}
+
+	for i, expected := range expectedApplications {
+		actual := status.Applications[i]
+
+		if actual.Application != expected.application {
+			t.Errorf(
+				"unexpected application\nexpected: [%v]\nactual:   [%v]",
+				expected.application.String(),
+				actual.Application.String(),
+			)
+		}
+
+		if actual.MinimumBondMargin.Cmp(expected.margin) != 0 {
+			t.Errorf(
+				"unexpected minimum bond margin for application [%s]\n"+
+					"expected: [%v]\nactual:   [%v]",
+				expected.application.String(),
+				expected.margin,
+				actual.MinimumBondMargin,
+			)
+		}
+
+		if actual.Alert != expected.alert {
+			t.Errorf(
+				"unexpected alert for application [%s]\n"+
+					"expected: [%v]\nactual:   [%v]",
+				expected.application.String(),
+				expected.alert,
+				actual.Alert,
+			)
+		}
+	}
+
+	if status.AlertsCount() != 2 {
+		t.Errorf(
+			"unexpected alerts count\nexpected: [%v]\nactual:   [%v]",
+			2,
+			status.AlertsCount(),
+		)
+	}
+}
+
+func TestCheckRaisesAlertBelowThreshold(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	chain := local.Connect(ctx)
+
+	application := common.HexToAddress("0x65ea55c1f10491038425725dc00dffeab2a1e28a")
+	chain.SetAvailableUnbondedValue(application, etherValue(25))
+
+	monitor := NewMonitor(
+		[]*Factory{{Chain: chain, Applications: []common.Address{application}}},
+		keepsSource{},
+		&Config{
+			UnbondedValueAlertThreshold: &ethereum.Wei{Int: etherValue(30)},
+		},
+	)
+
+	if err := monitor.Check(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !monitor.Status().Applications[0].Alert {
+		t.Errorf("expected alert for value below the threshold")
+	}
+}
+
+func TestCheckReportsBonds(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	chain := local.Connect(ctx)
+
+	keep1 := common.HexToAddress("0x4e09cadc7037afa36603138d1c0b76fe2aa5039c")
+	keep2 := common.HexToAddress("0x7e09cadc7037afa36603138d1c0b76fe2aa5039d")
+
+	chain.OpenKeep(keep1, []common.Address{chain.Address()})
+	chain.OpenKeep(keep2, []common.Address{chain.Address()})
+
+	if err := chain.SetBondAmount(keep1, etherValue(3)); err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.SetBondAmount(keep2, etherValue(4)); err != nil {
+		t.Fatal(err)
+	}
+
+	monitor := NewMonitor(
+		[]*Factory{{Chain: chain}},
+		keepsSource{keep1, keep2},
+		&Config{},
+	)
+
+	if err := monitor.Check(); err != nil {
+		t.Fatal(err)
+	}
+
+	status := monitor.Status()
+
+	if status.Bonds[keep1].Cmp(etherValue(3)) != 0 {
+		t.Errorf(
+			"unexpected bond of keep 1\nexpected: [%v]\nactual:   [%v]",
+			etherValue(3),
+			status.Bonds[keep1],
+		)
+	}
+
+	if status.TotalBondedValue.Cmp(etherValue(7)) != 0 {
+		t.Errorf(
+			"unexpected total bonded value\nexpected: [%v]\nactual:   [%v]",
+			etherValue(7),
+			status.TotalBondedValue,
+		)
+	}
+}
+
+func etherValue(value int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(value), ether)
+}
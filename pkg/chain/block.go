@@ -0,0 +1,46 @@
+package eth
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// FirstBlockAt returns the number of the first block mined at or after the
+// given time. It can be used to determine the block in which a keep has been
+// opened from the keep's opened timestamp, so past events of the keep are not
+// looked up from the genesis block. Blocks are binary searched by their
+// timestamps; the current block is returned if no earlier block has been
+// mined at or after the given time.
+func FirstBlockAt(handle Handle, timestamp time.Time) (uint64, error) {
+	currentBlock, err := handle.BlockCounter().CurrentBlock()
+	if err != nil {
+		return 0, fmt.Errorf("could not get current block: [%v]", err)
+	}
+
+	expectedTimestamp := uint64(timestamp.Unix())
+
+	lowerBlock, upperBlock := uint64(0), currentBlock
+	for lowerBlock < upperBlock {
+		middleBlock := lowerBlock + (upperBlock-lowerBlock)/2
+
+		blockTimestamp, err := handle.BlockTimestamp(
+			new(big.Int).SetUint64(middleBlock),
+		)
+		if err != nil {
+			return 0, fmt.Errorf(
+				"could not get timestamp of block [%v]: [%v]",
+				middleBlock,
+				err,
+			)
+		}
+
+		if blockTimestamp >= expectedTimestamp {
+			upperBlock = middleBlock
+		} else {
+			lowerBlock = middleBlock + 1
+		}
+	}
+
+	return lowerBlock, nil
+}
@@ -0,0 +1,98 @@
+package eth
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/chain"
+)
+
+func TestFirstBlockAt(t *testing.T) {
+	// blocks 0-9 are mined every 10 seconds starting from 1000
+	handle := &testBlocksHandle{
+		currentBlock:     9,
+		blocksTimestamps: make(map[uint64]uint64),
+	}
+	for block := uint64(0); block <= 9; block++ {
+		handle.blocksTimestamps[block] = 1000 + block*10
+	}
+
+	var tests = map[string]struct {
+		timestamp     int64
+		expectedBlock uint64
+	}{
+		"before the genesis block": {
+			timestamp:     500,
+			expectedBlock: 0,
+		},
+		"at the genesis block": {
+			timestamp:     1000,
+			expectedBlock: 0,
+		},
+		"at a block": {
+			timestamp:     1040,
+			expectedBlock: 4,
+		},
+		"between blocks": {
+			timestamp:     1045,
+			expectedBlock: 5,
+		},
+		"at the current block": {
+			timestamp:     1090,
+			expectedBlock: 9,
+		},
+		"after the current block": {
+			timestamp:     2000,
+			expectedBlock: 9,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			block, err := FirstBlockAt(handle, time.Unix(test.timestamp, 0))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if block != test.expectedBlock {
+				t.Errorf(
+					"unexpected block\nexpected: [%v]\nactual:   [%v]",
+					test.expectedBlock,
+					block,
+				)
+			}
+		})
+	}
+}
+
+type testBlocksHandle struct {
+	Handle
+
+	currentBlock     uint64
+	blocksTimestamps map[uint64]uint64
+}
+
+func (tbh *testBlocksHandle) BlockCounter() chain.BlockCounter {
+	return &testBlockCounter{currentBlock: tbh.currentBlock}
+}
+
+func (tbh *testBlocksHandle) BlockTimestamp(blockNumber *big.Int) (uint64, error) {
+	timestamp, ok := tbh.blocksTimestamps[blockNumber.Uint64()]
+	if !ok {
+		return 0, fmt.Errorf("no timestamp for block [%v]", blockNumber)
+	}
+
+	return timestamp, nil
+}
+
+type testBlockCounter struct {
+	chain.BlockCounter
+
+	currentBlock uint64
+}
+
+func (tbc *testBlockCounter) CurrentBlock() (uint64, error) {
+	return tbc.currentBlock, nil
+}
@@ -29,6 +29,9 @@ type Handle interface {
 	// BlockTimestamp returns given block's timestamp.
 	// In case the block is not yet mined, an error should be returned.
 	BlockTimestamp(blockNumber *big.Int) (uint64, error)
+	// TransactionSender returns the address of the account which sent
+	// the transaction with the given hash.
+	TransactionSender(transactionHash common.Hash) (common.Address, error)
 
 	BondedECDSAKeepFactory
 	BondedECDSAKeep
@@ -72,6 +75,23 @@ type BondedECDSAKeepFactory interface {
 
 	// GetKeepAtIndex returns the address of the keep at the given index.
 	GetKeepAtIndex(keepIndex *big.Int) (common.Address, error)
+
+	// AvailableUnbondedValue returns the operator's value available for
+	// bonding in keeps of the given application.
+	AvailableUnbondedValue(application common.Address) (*big.Int, error)
+
+	// BondAmount returns the value of the operator's bond held by the given
+	// keep.
+	BondAmount(keepAddress common.Address) (*big.Int, error)
+
+	// MinimumBond returns the minimum value available for bonding the factory
+	// requires from operators to join sortition pools of applications.
+	MinimumBond() (*big.Int, error)
+
+	// KeepApplication returns the address of the application which opened
+	// the keep with the given address. It returns the zero address if
+	// the keep has not been created by the factory.
+	KeepApplication(keepAddress common.Address) (common.Address, error)
 }
 
 // BondedECDSAKeep is an interface that provides ability to interact with
@@ -153,6 +173,20 @@ type BondedECDSAKeep interface {
 	// GetOpenedTimestamp returns timestamp when the keep was created.
 	GetOpenedTimestamp(keepAddress common.Address) (time.Time, error)
 
+	// GetMemberETHBalance returns the ETH balance of the keep member available
+	// for withdrawal.
+	GetMemberETHBalance(
+		keepAddress common.Address,
+		member common.Address,
+	) (*big.Int, error)
+
+	// WithdrawMemberETHBalance withdraws the whole ETH balance of the keep
+	// member. The balance is transferred to the member's beneficiary.
+	WithdrawMemberETHBalance(
+		keepAddress common.Address,
+		member common.Address,
+	) error
+
 	// PastSignatureSubmittedEvents returns all signature submitted events
 	// for the given keep which occurred after the provided start block.
 	// All implementations should returns those events sorted by the
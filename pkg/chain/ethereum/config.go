@@ -2,5 +2,8 @@ package ethereum
 
 // Definitions of contract names.
 const (
-	BondedECDSAKeepFactoryContractName = "BondedECDSAKeepFactory"
+	BondedECDSAKeepFactoryContractName      = "BondedECDSAKeepFactory"
+	FullyBackedECDSAKeepFactoryContractName = "FullyBackedECDSAKeepFactory"
+	FullyBackedBondingContractName          = "FullyBackedBonding"
+	KeepBondingContractName                 = "KeepBonding"
 )
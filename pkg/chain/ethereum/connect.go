@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 
 	"github.com/keep-network/keep-common/pkg/chain/ethereum"
@@ -36,6 +37,8 @@ type EthereumChain struct {
 	accountKey                     *keystore.Key
 	client                         ethutil.EthereumClient
 	bondedECDSAKeepFactoryContract *contract.BondedECDSAKeepFactory
+	bondedECDSAKeepFactoryAddress  common.Address
+	keepBondingContract            *contract.KeepBonding
 	blockCounter                   *blockcounter.EthereumBlockCounter
 	miningWaiter                   *ethutil.MiningWaiter
 	nonceManager                   *ethutil.NonceManager
@@ -110,11 +113,32 @@ func Connect(accountKey *keystore.Key, config *ethereum.Config) (*EthereumChain,
 		return nil, err
 	}
 
+	// KeepBonding contract is used only to monitor operator's bonds so it is
+	// not required to be configured.
+	var keepBondingContract *contract.KeepBonding
+	keepBondingContractAddress, err := config.ContractAddress(KeepBondingContractName)
+	if err == nil {
+		keepBondingContract, err = contract.NewKeepBonding(
+			*keepBondingContractAddress,
+			accountKey,
+			wrappedClient,
+			nonceManager,
+			miningWaiter,
+			blockCounter,
+			transactionMutex,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &EthereumChain{
 		config:                         config,
 		accountKey:                     accountKey,
 		client:                         wrappedClient,
 		bondedECDSAKeepFactoryContract: bondedECDSAKeepFactoryContract,
+		bondedECDSAKeepFactoryAddress:  *bondedECDSAKeepFactoryContractAddress,
+		keepBondingContract:            keepBondingContract,
 		blockCounter:                   blockCounter,
 		nonceManager:                   nonceManager,
 		miningWaiter:                   miningWaiter,
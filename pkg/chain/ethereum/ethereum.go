@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/ipfs/go-log"
 
@@ -24,6 +25,11 @@ import (
 
 var logger = log.Logger("keep-chain-eth-ethereum")
 
+var errKeepBondingNotConfigured = fmt.Errorf(
+	"address of [%s] contract is not configured",
+	KeepBondingContractName,
+)
+
 // Address returns client's ethereum address.
 func (ec *EthereumChain) Address() common.Address {
 	return ec.accountKey.Address
@@ -419,6 +425,75 @@ func (ec *EthereumChain) GetKeepAtIndex(
 	return ec.bondedECDSAKeepFactoryContract.GetKeepAtIndex(keepIndex)
 }
 
+// AvailableUnbondedValue returns the operator's value available for bonding
+// in keeps of the given application.
+func (ec *EthereumChain) AvailableUnbondedValue(
+	application common.Address,
+) (*big.Int, error) {
+	if ec.keepBondingContract == nil {
+		return nil, errKeepBondingNotConfigured
+	}
+
+	sortitionPool, err := ec.bondedECDSAKeepFactoryContract.GetSortitionPool(
+		application,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sortition pool: [%v]", err)
+	}
+
+	return ec.keepBondingContract.AvailableUnbondedValue(
+		ec.Address(),
+		ec.bondedECDSAKeepFactoryAddress,
+		sortitionPool,
+	)
+}
+
+// BondAmount returns the value of the operator's bond held by the given keep.
+func (ec *EthereumChain) BondAmount(keepAddress common.Address) (*big.Int, error) {
+	if ec.keepBondingContract == nil {
+		return nil, errKeepBondingNotConfigured
+	}
+
+	// Factory creates bonds with the keep address as the reference ID.
+	return ec.keepBondingContract.BondAmount(
+		ec.Address(),
+		keepAddress,
+		new(big.Int).SetBytes(keepAddress.Bytes()),
+	)
+}
+
+// MinimumBond returns the minimum value available for bonding the factory
+// requires from operators to join sortition pools of applications.
+func (ec *EthereumChain) MinimumBond() (*big.Int, error) {
+	return ec.bondedECDSAKeepFactoryContract.MinimumBond()
+}
+
+// KeepApplication returns the address of the application which opened
+// the keep with the given address. It returns the zero address if the keep
+// has not been created by the factory.
+func (ec *EthereumChain) KeepApplication(
+	keepAddress common.Address,
+) (common.Address, error) {
+	// The keep could have been created long ago so the lookup starts from
+	// the genesis block. There is at most one event for the keep address.
+	events, err := ec.bondedECDSAKeepFactoryContract.PastBondedECDSAKeepCreatedEvents(
+		0,
+		nil,
+		[]common.Address{keepAddress},
+		nil,
+		nil,
+	)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	if len(events) == 0 {
+		return common.Address{}, nil
+	}
+
+	return events[0].Application, nil
+}
+
 // LatestDigest returns the latest digest requested to be signed.
 func (ec *EthereumChain) LatestDigest(keepAddress common.Address) ([32]byte, error) {
 	keepContract, err := ec.getKeepContract(keepAddress)
@@ -506,6 +581,44 @@ func (ec *EthereumChain) GetOpenedTimestamp(keepAddress common.Address) (time.Ti
 	return keepOpenTime, nil
 }
 
+// GetMemberETHBalance returns the ETH balance of the keep member available
+// for withdrawal.
+func (ec *EthereumChain) GetMemberETHBalance(
+	keepAddress common.Address,
+	member common.Address,
+) (*big.Int, error) {
+	keepContract, err := ec.getKeepContract(keepAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return keepContract.GetMemberETHBalance(member)
+}
+
+// WithdrawMemberETHBalance withdraws the whole ETH balance of the keep member
+// to the member's beneficiary.
+func (ec *EthereumChain) WithdrawMemberETHBalance(
+	keepAddress common.Address,
+	member common.Address,
+) error {
+	keepContract, err := ec.getKeepContract(keepAddress)
+	if err != nil {
+		return err
+	}
+
+	transaction, err := keepContract.Withdraw(member)
+	if err != nil {
+		return err
+	}
+
+	logger.Debugf(
+		"submitted Withdraw transaction with hash: [%x]",
+		transaction.Hash(),
+	)
+
+	return nil
+}
+
 // PastSignatureSubmittedEvents returns all signature submitted events
 // for the given keep which occurred after the provided start block.
 // Returned events are sorted by the block number in the ascending order.
@@ -537,8 +650,9 @@ func (ec *EthereumChain) PastSignatureSubmittedEvents(
 			Digest:      event.Digest,
 			R:           event.R,
 			S:           event.S,
-			RecoveryID:  event.RecoveryID,
-			BlockNumber: event.Raw.BlockNumber,
+			RecoveryID:      event.RecoveryID,
+			BlockNumber:     event.Raw.BlockNumber,
+			TransactionHash: event.Raw.TxHash,
 		})
 	}
 
@@ -563,6 +677,27 @@ func (ec *EthereumChain) BlockTimestamp(blockNumber *big.Int) (uint64, error) {
 	return header.Time, nil
 }
 
+// TransactionSender returns the address of the account which sent
+// the transaction with the given hash.
+func (ec *EthereumChain) TransactionSender(
+	transactionHash common.Hash,
+) (common.Address, error) {
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancelCtx()
+
+	transaction, _, err := ec.client.TransactionByHash(ctx, transactionHash)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	var signer types.Signer = types.HomesteadSigner{}
+	if transaction.Protected() {
+		signer = types.NewEIP155Signer(transaction.ChainId())
+	}
+
+	return types.Sender(signer, transaction)
+}
+
 //WeiBalanceOf returns the wei balance of the given address from the latest known block.
 func (ec *EthereumChain) WeiBalanceOf(address common.Address) (*big.Int, error) {
 	ctx, cancelCtx := context.WithTimeout(context.Background(), 1*time.Minute)
@@ -0,0 +1,329 @@
+package ethereum
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+	"github.com/keep-network/keep-common/pkg/subscription"
+	"github.com/keep-network/keep-core/pkg/chain"
+	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/contract"
+)
+
+// FullyBackedEthereumChain represents an Ethereum chain handle operating on
+// keeps created by FullyBackedECDSAKeepFactory. Keeps of this factory are
+// backed only by ETH bonds so the operator's eligibility is determined based
+// on the value deposited in FullyBackedBonding instead of the KEEP stake.
+//
+// Keep-level functions are inherited from the underlying chain handle as both
+// flavours of keeps share the same contract interface.
+type FullyBackedEthereumChain struct {
+	*EthereumChain
+
+	fullyBackedECDSAKeepFactoryAddress  common.Address
+	fullyBackedECDSAKeepFactoryContract *contract.FullyBackedECDSAKeepFactory
+	fullyBackedBondingContract          *contract.FullyBackedBonding
+}
+
+// WithFullyBackedECDSAKeepFactory extends the Ethereum chain handle with
+// the ability to operate on keeps created by FullyBackedECDSAKeepFactory.
+// Addresses of FullyBackedECDSAKeepFactory and FullyBackedBonding contracts
+// are read from the chain config.
+func WithFullyBackedECDSAKeepFactory(
+	ethereumChain *EthereumChain,
+) (*FullyBackedEthereumChain, error) {
+	factoryAddress, err := ethereumChain.config.ContractAddress(
+		FullyBackedECDSAKeepFactoryContractName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	factoryContract, err := contract.NewFullyBackedECDSAKeepFactory(
+		*factoryAddress,
+		ethereumChain.accountKey,
+		ethereumChain.client,
+		ethereumChain.nonceManager,
+		ethereumChain.miningWaiter,
+		ethereumChain.blockCounter,
+		ethereumChain.transactionMutex,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	bondingAddress, err := ethereumChain.config.ContractAddress(
+		FullyBackedBondingContractName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	bondingContract, err := contract.NewFullyBackedBonding(
+		*bondingAddress,
+		ethereumChain.accountKey,
+		ethereumChain.client,
+		ethereumChain.nonceManager,
+		ethereumChain.miningWaiter,
+		ethereumChain.blockCounter,
+		ethereumChain.transactionMutex,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FullyBackedEthereumChain{
+		EthereumChain:                       ethereumChain,
+		fullyBackedECDSAKeepFactoryAddress:  *factoryAddress,
+		fullyBackedECDSAKeepFactoryContract: factoryContract,
+		fullyBackedBondingContract:          bondingContract,
+	}, nil
+}
+
+// RegisterAsMemberCandidate registers client as a candidate to be selected
+// to a fully-backed keep.
+func (fbc *FullyBackedEthereumChain) RegisterAsMemberCandidate(
+	application common.Address,
+) error {
+	gasEstimate, err := fbc.fullyBackedECDSAKeepFactoryContract.RegisterMemberCandidateGasEstimate(
+		application,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to estimate gas [%v]", err)
+	}
+
+	// The same safety margin as for the bonded ECDSA keep factory is applied;
+	// see EthereumChain.RegisterAsMemberCandidate.
+	gasEstimateWithMargin := float64(gasEstimate) * float64(1.2)
+	transaction, err := fbc.fullyBackedECDSAKeepFactoryContract.RegisterMemberCandidate(
+		application,
+		ethutil.TransactionOptions{
+			GasLimit: uint64(gasEstimateWithMargin),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	logger.Debugf(
+		"submitted RegisterMemberCandidate transaction "+
+			"to fully-backed factory with hash: [%x]",
+		transaction.Hash(),
+	)
+
+	return nil
+}
+
+// OnBondedECDSAKeepCreated installs a callback that is invoked when an on-chain
+// notification of a new fully-backed ECDSA keep creation is seen.
+func (fbc *FullyBackedEthereumChain) OnBondedECDSAKeepCreated(
+	handler func(event *eth.BondedECDSAKeepCreatedEvent),
+) subscription.EventSubscription {
+	onEvent := func(
+		KeepAddress common.Address,
+		Members []common.Address,
+		Owner common.Address,
+		Application common.Address,
+		HonestThreshold *big.Int,
+		blockNumber uint64,
+	) {
+		handler(&eth.BondedECDSAKeepCreatedEvent{
+			KeepAddress:     KeepAddress,
+			Members:         Members,
+			HonestThreshold: HonestThreshold.Uint64(),
+			BlockNumber:     blockNumber,
+		})
+	}
+
+	return fbc.fullyBackedECDSAKeepFactoryContract.FullyBackedECDSAKeepCreated(
+		nil,
+		nil,
+		nil,
+		nil,
+	).OnEvent(onEvent)
+}
+
+// HasMinimumStake returns true if the specified operator has passed
+// the initialization period in the bonding contract and has at least
+// the factory's default minimum bond of unbonded value deposited. There is no
+// KEEP stake for fully-backed keeps so the ETH bond serves as the stake.
+func (fbc *FullyBackedEthereumChain) HasMinimumStake(
+	address common.Address,
+) (bool, error) {
+	isInitialized, err := fbc.fullyBackedBondingContract.IsInitialized(
+		address,
+		fbc.fullyBackedECDSAKeepFactoryAddress,
+	)
+	if err != nil {
+		return false, fmt.Errorf(
+			"failed to check if operator is initialized: [%v]",
+			err,
+		)
+	}
+
+	if !isInitialized {
+		return false, nil
+	}
+
+	minimumBond, err := fbc.fullyBackedECDSAKeepFactoryContract.DefaultMinimumBond()
+	if err != nil {
+		return false, fmt.Errorf("failed to get minimum bond: [%v]", err)
+	}
+
+	unbondedValue, err := fbc.BalanceOf(address)
+	if err != nil {
+		return false, fmt.Errorf("failed to get unbonded value: [%v]", err)
+	}
+
+	return unbondedValue.Cmp(minimumBond) >= 0, nil
+}
+
+// BalanceOf returns the value available for bonding of the specified address.
+func (fbc *FullyBackedEthereumChain) BalanceOf(
+	address common.Address,
+) (*big.Int, error) {
+	return fbc.fullyBackedBondingContract.UnbondedValue(address)
+}
+
+// StakeMonitor returns a stake monitor based on the ETH bond of operators.
+func (fbc *FullyBackedEthereumChain) StakeMonitor() (chain.StakeMonitor, error) {
+	return &ethereumStakeMonitor{fbc}, nil
+}
+
+// IsRegisteredForApplication checks if the operator is registered
+// as a signer candidate in the fully-backed factory for the given application.
+func (fbc *FullyBackedEthereumChain) IsRegisteredForApplication(
+	application common.Address,
+) (bool, error) {
+	return fbc.fullyBackedECDSAKeepFactoryContract.IsOperatorRegistered(
+		fbc.Address(),
+		application,
+	)
+}
+
+// IsEligibleForApplication checks if the operator is eligible to register
+// as a signer candidate for the given application.
+func (fbc *FullyBackedEthereumChain) IsEligibleForApplication(
+	application common.Address,
+) (bool, error) {
+	return fbc.fullyBackedECDSAKeepFactoryContract.IsOperatorEligible(
+		fbc.Address(),
+		application,
+	)
+}
+
+// IsStatusUpToDateForApplication checks if the operator's status
+// is up to date in the signers' pool of the given application.
+func (fbc *FullyBackedEthereumChain) IsStatusUpToDateForApplication(
+	application common.Address,
+) (bool, error) {
+	return fbc.fullyBackedECDSAKeepFactoryContract.IsOperatorUpToDate(
+		fbc.Address(),
+		application,
+	)
+}
+
+// UpdateStatusForApplication updates the operator's status in the signers'
+// pool for the given application.
+func (fbc *FullyBackedEthereumChain) UpdateStatusForApplication(
+	application common.Address,
+) error {
+	transaction, err := fbc.fullyBackedECDSAKeepFactoryContract.UpdateOperatorStatus(
+		fbc.Address(),
+		application,
+	)
+	if err != nil {
+		return err
+	}
+
+	logger.Debugf(
+		"submitted UpdateOperatorStatus transaction "+
+			"to fully-backed factory with hash: [%x]",
+		transaction.Hash(),
+	)
+
+	return nil
+}
+
+// IsOperatorAuthorized checks if the fully-backed factory has the
+// authorization to operate on the bond of the provided operator.
+func (fbc *FullyBackedEthereumChain) IsOperatorAuthorized(
+	operator common.Address,
+) (bool, error) {
+	return fbc.fullyBackedECDSAKeepFactoryContract.IsOperatorAuthorized(operator)
+}
+
+// GetKeepCount returns number of keeps created by the fully-backed factory.
+func (fbc *FullyBackedEthereumChain) GetKeepCount() (*big.Int, error) {
+	return fbc.fullyBackedECDSAKeepFactoryContract.GetKeepCount()
+}
+
+// GetKeepAtIndex returns the address of the keep at the given index.
+func (fbc *FullyBackedEthereumChain) GetKeepAtIndex(
+	keepIndex *big.Int,
+) (common.Address, error) {
+	return fbc.fullyBackedECDSAKeepFactoryContract.GetKeepAtIndex(keepIndex)
+}
+
+// AvailableUnbondedValue returns the operator's value available for bonding
+// in fully-backed keeps of the given application.
+func (fbc *FullyBackedEthereumChain) AvailableUnbondedValue(
+	application common.Address,
+) (*big.Int, error) {
+	sortitionPool, err := fbc.fullyBackedECDSAKeepFactoryContract.GetSortitionPool(
+		application,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sortition pool: [%v]", err)
+	}
+
+	return fbc.fullyBackedBondingContract.AvailableUnbondedValue(
+		fbc.Address(),
+		fbc.fullyBackedECDSAKeepFactoryAddress,
+		sortitionPool,
+	)
+}
+
+// BondAmount returns the value of the operator's bond held by the given
+// fully-backed keep.
+func (fbc *FullyBackedEthereumChain) BondAmount(
+	keepAddress common.Address,
+) (*big.Int, error) {
+	return fbc.fullyBackedBondingContract.BondAmount(
+		fbc.Address(),
+		keepAddress,
+		new(big.Int).SetBytes(keepAddress.Bytes()),
+	)
+}
+
+// MinimumBond returns the default minimum bond of the fully-backed factory.
+func (fbc *FullyBackedEthereumChain) MinimumBond() (*big.Int, error) {
+	return fbc.fullyBackedECDSAKeepFactoryContract.DefaultMinimumBond()
+}
+
+// KeepApplication returns the address of the application which opened
+// the fully-backed keep with the given address. It returns the zero address
+// if the keep has not been created by the fully-backed factory.
+func (fbc *FullyBackedEthereumChain) KeepApplication(
+	keepAddress common.Address,
+) (common.Address, error) {
+	events, err := fbc.fullyBackedECDSAKeepFactoryContract.PastFullyBackedECDSAKeepCreatedEvents(
+		0,
+		nil,
+		[]common.Address{keepAddress},
+		nil,
+		nil,
+	)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	if len(events) == 0 {
+		return common.Address{}, nil
+	}
+
+	return events[0].Application, nil
+}
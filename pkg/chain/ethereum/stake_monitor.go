@@ -9,8 +9,15 @@ import (
 	"github.com/keep-network/keep-core/pkg/chain"
 )
 
+// staking is the source of stake information used by the stake monitor. It is
+// implemented by both KEEP-staked and fully-backed chain handles.
+type staking interface {
+	HasMinimumStake(address common.Address) (bool, error)
+	BalanceOf(address common.Address) (*big.Int, error)
+}
+
 type ethereumStakeMonitor struct {
-	ethereum *EthereumChain
+	ethereum staking
 }
 
 func (esm *ethereumStakeMonitor) HasMinimumStake(address string) (bool, error) {
@@ -38,7 +45,7 @@ func (ec *EthereumChain) StakeMonitor() (chain.StakeMonitor, error) {
 
 type ethereumStaker struct {
 	address  string
-	ethereum *EthereumChain
+	ethereum staking
 }
 
 func (es *ethereumStaker) Address() relaychain.StakerAddress {
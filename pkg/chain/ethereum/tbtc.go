@@ -158,6 +158,49 @@ func (tec *TBTCEthereumChain) OnDepositRedeemed(
 	).OnEvent(onEvent)
 }
 
+// PastDepositCreatedEvents returns all deposit created events for the given
+// keeps which occurred after the provided start block. Returned events are
+// sorted by the block number in the ascending order.
+func (tec *TBTCEthereumChain) PastDepositCreatedEvents(
+	startBlock uint64,
+	keepAddresses []string,
+) ([]*chain.DepositCreatedEvent, error) {
+	keepAddressFilter := make([]common.Address, len(keepAddresses))
+	for i, keepAddress := range keepAddresses {
+		if !common.IsHexAddress(keepAddress) {
+			return nil, fmt.Errorf("incorrect keep address [%v]", keepAddress)
+		}
+		keepAddressFilter[i] = common.HexToAddress(keepAddress)
+	}
+
+	events, err := tec.tbtcSystemContract.PastCreatedEvents(
+		startBlock,
+		nil,
+		nil,
+		keepAddressFilter,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*chain.DepositCreatedEvent, 0)
+
+	for _, event := range events {
+		result = append(result, &chain.DepositCreatedEvent{
+			DepositAddress: event.DepositContractAddress.Hex(),
+			KeepAddress:    event.KeepAddress.Hex(),
+			BlockNumber:    event.Raw.BlockNumber,
+		})
+	}
+
+	// Make sure events are sorted by block number in ascending order.
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].BlockNumber < result[j].BlockNumber
+	})
+
+	return result, nil
+}
+
 // PastDepositRedemptionRequestedEvents returns all redemption requested
 // events for the given deposit which occurred after the provided start block.
 // Returned events are sorted by the block number in the ascending order.
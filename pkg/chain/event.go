@@ -52,6 +52,9 @@ type SignatureSubmittedEvent struct {
 	S           [32]byte
 	RecoveryID  uint8
 	BlockNumber uint64
+	// TransactionHash is the hash of the transaction which submitted
+	// the signature.
+	TransactionHash common.Hash
 }
 
 // IsMember checks if list of members contains the given address.
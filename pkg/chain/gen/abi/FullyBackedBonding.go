@@ -0,0 +1,2092 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package abi
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = abi.U256
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// FullyBackedBondingABI is the input ABI used to generate the binding from.
+const FullyBackedBondingABI = "[{\"type\":\"event\",\"name\":\"BondCreated\",\"anonymous\":false,\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true},{\"name\":\"holder\",\"type\":\"address\",\"indexed\":true},{\"name\":\"sortitionPool\",\"type\":\"address\",\"indexed\":true},{\"name\":\"referenceID\",\"type\":\"uint256\",\"indexed\":false},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}]},{\"type\":\"event\",\"name\":\"BondReassigned\",\"anonymous\":false,\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true},{\"name\":\"referenceID\",\"type\":\"uint256\",\"indexed\":true},{\"name\":\"newHolder\",\"type\":\"address\",\"indexed\":false},{\"name\":\"newReferenceID\",\"type\":\"uint256\",\"indexed\":false}]},{\"type\":\"event\",\"name\":\"BondReleased\",\"anonymous\":false,\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true},{\"name\":\"referenceID\",\"type\":\"uint256\",\"indexed\":true}]},{\"type\":\"event\",\"name\":\"BondSeized\",\"anonymous\":false,\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true},{\"name\":\"referenceID\",\"type\":\"uint256\",\"indexed\":true},{\"name\":\"destination\",\"type\":\"address\",\"indexed\":false},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}]},{\"type\":\"event\",\"name\":\"Delegated\",\"anonymous\":false,\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true},{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true}]},{\"type\":\"event\",\"name\":\"OperatorDelegated\",\"anonymous\":false,\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true},{\"name\":\"beneficiary\",\"type\":\"address\",\"indexed\":true},{\"name\":\"authorizer\",\"type\":\"address\",\"indexed\":true},{\"name\":\"value\",\"type\":\"uint256\",\"indexed\":false}]},{\"type\":\"event\",\"name\":\"OperatorToppedUp\",\"anonymous\":false,\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true},{\"name\":\"value\",\"type\":\"uint256\",\"indexed\":false}]},{\"type\":\"event\",\"name\":\"UnbondedValueDeposited\",\"anonymous\":false,\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true},{\"name\":\"beneficiary\",\"type\":\"address\",\"indexed\":true},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}]},{\"type\":\"event\",\"name\":\"UnbondedValueWithdrawn\",\"anonymous\":false,\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true},{\"name\":\"beneficiary\",\"type\":\"address\",\"indexed\":true},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}]},{\"type\":\"function\",\"name\":\"DELEGATION_LOCK_PERIOD\",\"constant\":true,\"payable\":false,\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"MINIMUM_DELEGATION_DEPOSIT\",\"constant\":true,\"payable\":false,\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"authorizeSortitionPoolContract\",\"constant\":false,\"payable\":false,\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"_operator\",\"type\":\"address\"},{\"name\":\"_poolAddress\",\"type\":\"address\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"authorizerOf\",\"constant\":true,\"payable\":false,\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"_operator\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\"}]},{\"type\":\"function\",\"name\":\"availableUnbondedValue\",\"constant\":true,\"payable\":false,\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\"},{\"name\":\"bondCreator\",\"type\":\"address\"},{\"name\":\"authorizedSortitionPool\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"beneficiaryOf\",\"constant\":true,\"payable\":false,\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"_operator\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\"}]},{\"type\":\"function\",\"name\":\"bondAmount\",\"constant\":true,\"payable\":false,\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\"},{\"name\":\"holder\",\"type\":\"address\"},{\"name\":\"referenceID\",\"type\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"createBond\",\"constant\":false,\"payable\":false,\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\"},{\"name\":\"holder\",\"type\":\"address\"},{\"name\":\"referenceID\",\"type\":\"uint256\"},{\"name\":\"amount\",\"type\":\"uint256\"},{\"name\":\"authorizedSortitionPool\",\"type\":\"address\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"deauthorizeSortitionPoolContract\",\"constant\":false,\"payable\":false,\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"_operator\",\"type\":\"address\"},{\"name\":\"_poolAddress\",\"type\":\"address\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"delegate\",\"constant\":false,\"payable\":true,\"stateMutability\":\"payable\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\"},{\"name\":\"beneficiary\",\"type\":\"address\"},{\"name\":\"authorizer\",\"type\":\"address\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"deposit\",\"constant\":false,\"payable\":true,\"stateMutability\":\"payable\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"freeBond\",\"constant\":false,\"payable\":false,\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\"},{\"name\":\"referenceID\",\"type\":\"uint256\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"getDelegationInfo\",\"constant\":true,\"payable\":false,\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"createdAt\",\"type\":\"uint256\"},{\"name\":\"undelegatedAt\",\"type\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"hasSecondaryAuthorization\",\"constant\":true,\"payable\":false,\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"_operator\",\"type\":\"address\"},{\"name\":\"_poolAddress\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}]},{\"type\":\"function\",\"name\":\"initializationPeriod\",\"constant\":true,\"payable\":false,\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"isAuthorizedForOperator\",\"constant\":true,\"payable\":false,\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"_operator\",\"type\":\"address\"},{\"name\":\"_operatorContract\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}]},{\"type\":\"function\",\"name\":\"isInitialized\",\"constant\":true,\"payable\":false,\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\"},{\"name\":\"bondCreator\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}]},{\"type\":\"function\",\"name\":\"reassignBond\",\"constant\":false,\"payable\":false,\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\"},{\"name\":\"referenceID\",\"type\":\"uint256\"},{\"name\":\"newHolder\",\"type\":\"address\"},{\"name\":\"newReferenceID\",\"type\":\"uint256\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"seizeBond\",\"constant\":false,\"payable\":false,\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\"},{\"name\":\"referenceID\",\"type\":\"uint256\"},{\"name\":\"amount\",\"type\":\"uint256\"},{\"name\":\"destination\",\"type\":\"address\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"topUp\",\"constant\":false,\"payable\":true,\"stateMutability\":\"payable\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"unbondedValue\",\"constant\":true,\"payable\":false,\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"withdraw\",\"constant\":false,\"payable\":false,\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"amount\",\"type\":\"uint256\"},{\"name\":\"operator\",\"type\":\"address\"}],\"outputs\":[]}]"
+
+// FullyBackedBonding is an auto generated Go binding around an Ethereum contract.
+type FullyBackedBonding struct {
+	FullyBackedBondingCaller     // Read-only binding to the contract
+	FullyBackedBondingTransactor // Write-only binding to the contract
+	FullyBackedBondingFilterer   // Log filterer for contract events
+}
+
+// FullyBackedBondingCaller is an auto generated read-only Go binding around an Ethereum contract.
+type FullyBackedBondingCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FullyBackedBondingTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type FullyBackedBondingTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FullyBackedBondingFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type FullyBackedBondingFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FullyBackedBondingSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type FullyBackedBondingSession struct {
+	Contract     *FullyBackedBonding // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts       // Call options to use throughout this session
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// FullyBackedBondingCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type FullyBackedBondingCallerSession struct {
+	Contract *FullyBackedBondingCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts             // Call options to use throughout this session
+}
+
+// FullyBackedBondingTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type FullyBackedBondingTransactorSession struct {
+	Contract     *FullyBackedBondingTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts             // Transaction auth options to use throughout this session
+}
+
+// FullyBackedBondingRaw is an auto generated low-level Go binding around an Ethereum contract.
+type FullyBackedBondingRaw struct {
+	Contract *FullyBackedBonding // Generic contract binding to access the raw methods on
+}
+
+// FullyBackedBondingCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type FullyBackedBondingCallerRaw struct {
+	Contract *FullyBackedBondingCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// FullyBackedBondingTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type FullyBackedBondingTransactorRaw struct {
+	Contract *FullyBackedBondingTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewFullyBackedBonding creates a new instance of FullyBackedBonding, bound to a specific deployed contract.
+func NewFullyBackedBonding(address common.Address, backend bind.ContractBackend) (*FullyBackedBonding, error) {
+	contract, err := bindFullyBackedBonding(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedBonding{FullyBackedBondingCaller: FullyBackedBondingCaller{contract: contract}, FullyBackedBondingTransactor: FullyBackedBondingTransactor{contract: contract}, FullyBackedBondingFilterer: FullyBackedBondingFilterer{contract: contract}}, nil
+}
+
+// NewFullyBackedBondingCaller creates a new read-only instance of FullyBackedBonding, bound to a specific deployed contract.
+func NewFullyBackedBondingCaller(address common.Address, caller bind.ContractCaller) (*FullyBackedBondingCaller, error) {
+	contract, err := bindFullyBackedBonding(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedBondingCaller{contract: contract}, nil
+}
+
+// NewFullyBackedBondingTransactor creates a new write-only instance of FullyBackedBonding, bound to a specific deployed contract.
+func NewFullyBackedBondingTransactor(address common.Address, transactor bind.ContractTransactor) (*FullyBackedBondingTransactor, error) {
+	contract, err := bindFullyBackedBonding(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedBondingTransactor{contract: contract}, nil
+}
+
+// NewFullyBackedBondingFilterer creates a new log filterer instance of FullyBackedBonding, bound to a specific deployed contract.
+func NewFullyBackedBondingFilterer(address common.Address, filterer bind.ContractFilterer) (*FullyBackedBondingFilterer, error) {
+	contract, err := bindFullyBackedBonding(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedBondingFilterer{contract: contract}, nil
+}
+
+// bindFullyBackedBonding binds a generic wrapper to an already deployed contract.
+func bindFullyBackedBonding(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(FullyBackedBondingABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_FullyBackedBonding *FullyBackedBondingRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _FullyBackedBonding.Contract.FullyBackedBondingCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_FullyBackedBonding *FullyBackedBondingRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.FullyBackedBondingTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_FullyBackedBonding *FullyBackedBondingRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.FullyBackedBondingTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_FullyBackedBonding *FullyBackedBondingCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _FullyBackedBonding.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_FullyBackedBonding *FullyBackedBondingTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_FullyBackedBonding *FullyBackedBondingTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.contract.Transact(opts, method, params...)
+}
+
+// DELEGATIONLOCKPERIOD is a free data retrieval call binding the contract method 0x6258b75d.
+//
+// Solidity: function DELEGATION_LOCK_PERIOD() constant returns(uint256)
+func (_FullyBackedBonding *FullyBackedBondingCaller) DELEGATIONLOCKPERIOD(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _FullyBackedBonding.contract.Call(opts, out, "DELEGATION_LOCK_PERIOD")
+	return *ret0, err
+}
+
+// DELEGATIONLOCKPERIOD is a free data retrieval call binding the contract method 0x6258b75d.
+//
+// Solidity: function DELEGATION_LOCK_PERIOD() constant returns(uint256)
+func (_FullyBackedBonding *FullyBackedBondingSession) DELEGATIONLOCKPERIOD() (*big.Int, error) {
+	return _FullyBackedBonding.Contract.DELEGATIONLOCKPERIOD(&_FullyBackedBonding.CallOpts)
+}
+
+// DELEGATIONLOCKPERIOD is a free data retrieval call binding the contract method 0x6258b75d.
+//
+// Solidity: function DELEGATION_LOCK_PERIOD() constant returns(uint256)
+func (_FullyBackedBonding *FullyBackedBondingCallerSession) DELEGATIONLOCKPERIOD() (*big.Int, error) {
+	return _FullyBackedBonding.Contract.DELEGATIONLOCKPERIOD(&_FullyBackedBonding.CallOpts)
+}
+
+// MINIMUMDELEGATIONDEPOSIT is a free data retrieval call binding the contract method 0x063cb844.
+//
+// Solidity: function MINIMUM_DELEGATION_DEPOSIT() constant returns(uint256)
+func (_FullyBackedBonding *FullyBackedBondingCaller) MINIMUMDELEGATIONDEPOSIT(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _FullyBackedBonding.contract.Call(opts, out, "MINIMUM_DELEGATION_DEPOSIT")
+	return *ret0, err
+}
+
+// MINIMUMDELEGATIONDEPOSIT is a free data retrieval call binding the contract method 0x063cb844.
+//
+// Solidity: function MINIMUM_DELEGATION_DEPOSIT() constant returns(uint256)
+func (_FullyBackedBonding *FullyBackedBondingSession) MINIMUMDELEGATIONDEPOSIT() (*big.Int, error) {
+	return _FullyBackedBonding.Contract.MINIMUMDELEGATIONDEPOSIT(&_FullyBackedBonding.CallOpts)
+}
+
+// MINIMUMDELEGATIONDEPOSIT is a free data retrieval call binding the contract method 0x063cb844.
+//
+// Solidity: function MINIMUM_DELEGATION_DEPOSIT() constant returns(uint256)
+func (_FullyBackedBonding *FullyBackedBondingCallerSession) MINIMUMDELEGATIONDEPOSIT() (*big.Int, error) {
+	return _FullyBackedBonding.Contract.MINIMUMDELEGATIONDEPOSIT(&_FullyBackedBonding.CallOpts)
+}
+
+// AuthorizerOf is a free data retrieval call binding the contract method 0xfb1677b1.
+//
+// Solidity: function authorizerOf(address _operator) constant returns(address)
+func (_FullyBackedBonding *FullyBackedBondingCaller) AuthorizerOf(opts *bind.CallOpts, _operator common.Address) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _FullyBackedBonding.contract.Call(opts, out, "authorizerOf", _operator)
+	return *ret0, err
+}
+
+// AuthorizerOf is a free data retrieval call binding the contract method 0xfb1677b1.
+//
+// Solidity: function authorizerOf(address _operator) constant returns(address)
+func (_FullyBackedBonding *FullyBackedBondingSession) AuthorizerOf(_operator common.Address) (common.Address, error) {
+	return _FullyBackedBonding.Contract.AuthorizerOf(&_FullyBackedBonding.CallOpts, _operator)
+}
+
+// AuthorizerOf is a free data retrieval call binding the contract method 0xfb1677b1.
+//
+// Solidity: function authorizerOf(address _operator) constant returns(address)
+func (_FullyBackedBonding *FullyBackedBondingCallerSession) AuthorizerOf(_operator common.Address) (common.Address, error) {
+	return _FullyBackedBonding.Contract.AuthorizerOf(&_FullyBackedBonding.CallOpts, _operator)
+}
+
+// AvailableUnbondedValue is a free data retrieval call binding the contract method 0x42bcb965.
+//
+// Solidity: function availableUnbondedValue(address operator, address bondCreator, address authorizedSortitionPool) constant returns(uint256)
+func (_FullyBackedBonding *FullyBackedBondingCaller) AvailableUnbondedValue(opts *bind.CallOpts, operator common.Address, bondCreator common.Address, authorizedSortitionPool common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _FullyBackedBonding.contract.Call(opts, out, "availableUnbondedValue", operator, bondCreator, authorizedSortitionPool)
+	return *ret0, err
+}
+
+// AvailableUnbondedValue is a free data retrieval call binding the contract method 0x42bcb965.
+//
+// Solidity: function availableUnbondedValue(address operator, address bondCreator, address authorizedSortitionPool) constant returns(uint256)
+func (_FullyBackedBonding *FullyBackedBondingSession) AvailableUnbondedValue(operator common.Address, bondCreator common.Address, authorizedSortitionPool common.Address) (*big.Int, error) {
+	return _FullyBackedBonding.Contract.AvailableUnbondedValue(&_FullyBackedBonding.CallOpts, operator, bondCreator, authorizedSortitionPool)
+}
+
+// AvailableUnbondedValue is a free data retrieval call binding the contract method 0x42bcb965.
+//
+// Solidity: function availableUnbondedValue(address operator, address bondCreator, address authorizedSortitionPool) constant returns(uint256)
+func (_FullyBackedBonding *FullyBackedBondingCallerSession) AvailableUnbondedValue(operator common.Address, bondCreator common.Address, authorizedSortitionPool common.Address) (*big.Int, error) {
+	return _FullyBackedBonding.Contract.AvailableUnbondedValue(&_FullyBackedBonding.CallOpts, operator, bondCreator, authorizedSortitionPool)
+}
+
+// BeneficiaryOf is a free data retrieval call binding the contract method 0xba7bffd3.
+//
+// Solidity: function beneficiaryOf(address _operator) constant returns(address)
+func (_FullyBackedBonding *FullyBackedBondingCaller) BeneficiaryOf(opts *bind.CallOpts, _operator common.Address) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _FullyBackedBonding.contract.Call(opts, out, "beneficiaryOf", _operator)
+	return *ret0, err
+}
+
+// BeneficiaryOf is a free data retrieval call binding the contract method 0xba7bffd3.
+//
+// Solidity: function beneficiaryOf(address _operator) constant returns(address)
+func (_FullyBackedBonding *FullyBackedBondingSession) BeneficiaryOf(_operator common.Address) (common.Address, error) {
+	return _FullyBackedBonding.Contract.BeneficiaryOf(&_FullyBackedBonding.CallOpts, _operator)
+}
+
+// BeneficiaryOf is a free data retrieval call binding the contract method 0xba7bffd3.
+//
+// Solidity: function beneficiaryOf(address _operator) constant returns(address)
+func (_FullyBackedBonding *FullyBackedBondingCallerSession) BeneficiaryOf(_operator common.Address) (common.Address, error) {
+	return _FullyBackedBonding.Contract.BeneficiaryOf(&_FullyBackedBonding.CallOpts, _operator)
+}
+
+// BondAmount is a free data retrieval call binding the contract method 0x446f0f9e.
+//
+// Solidity: function bondAmount(address operator, address holder, uint256 referenceID) constant returns(uint256)
+func (_FullyBackedBonding *FullyBackedBondingCaller) BondAmount(opts *bind.CallOpts, operator common.Address, holder common.Address, referenceID *big.Int) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _FullyBackedBonding.contract.Call(opts, out, "bondAmount", operator, holder, referenceID)
+	return *ret0, err
+}
+
+// BondAmount is a free data retrieval call binding the contract method 0x446f0f9e.
+//
+// Solidity: function bondAmount(address operator, address holder, uint256 referenceID) constant returns(uint256)
+func (_FullyBackedBonding *FullyBackedBondingSession) BondAmount(operator common.Address, holder common.Address, referenceID *big.Int) (*big.Int, error) {
+	return _FullyBackedBonding.Contract.BondAmount(&_FullyBackedBonding.CallOpts, operator, holder, referenceID)
+}
+
+// BondAmount is a free data retrieval call binding the contract method 0x446f0f9e.
+//
+// Solidity: function bondAmount(address operator, address holder, uint256 referenceID) constant returns(uint256)
+func (_FullyBackedBonding *FullyBackedBondingCallerSession) BondAmount(operator common.Address, holder common.Address, referenceID *big.Int) (*big.Int, error) {
+	return _FullyBackedBonding.Contract.BondAmount(&_FullyBackedBonding.CallOpts, operator, holder, referenceID)
+}
+
+// GetDelegationInfo is a free data retrieval call binding the contract method 0xfab46d66.
+//
+// Solidity: function getDelegationInfo(address operator) constant returns(uint256 createdAt, uint256 undelegatedAt)
+func (_FullyBackedBonding *FullyBackedBondingCaller) GetDelegationInfo(opts *bind.CallOpts, operator common.Address) (struct {
+	CreatedAt     *big.Int
+	UndelegatedAt *big.Int
+}, error) {
+	ret := new(struct {
+		CreatedAt     *big.Int
+		UndelegatedAt *big.Int
+	})
+	out := ret
+	err := _FullyBackedBonding.contract.Call(opts, out, "getDelegationInfo", operator)
+	return *ret, err
+}
+
+// GetDelegationInfo is a free data retrieval call binding the contract method 0xfab46d66.
+//
+// Solidity: function getDelegationInfo(address operator) constant returns(uint256 createdAt, uint256 undelegatedAt)
+func (_FullyBackedBonding *FullyBackedBondingSession) GetDelegationInfo(operator common.Address) (struct {
+	CreatedAt     *big.Int
+	UndelegatedAt *big.Int
+}, error) {
+	return _FullyBackedBonding.Contract.GetDelegationInfo(&_FullyBackedBonding.CallOpts, operator)
+}
+
+// GetDelegationInfo is a free data retrieval call binding the contract method 0xfab46d66.
+//
+// Solidity: function getDelegationInfo(address operator) constant returns(uint256 createdAt, uint256 undelegatedAt)
+func (_FullyBackedBonding *FullyBackedBondingCallerSession) GetDelegationInfo(operator common.Address) (struct {
+	CreatedAt     *big.Int
+	UndelegatedAt *big.Int
+}, error) {
+	return _FullyBackedBonding.Contract.GetDelegationInfo(&_FullyBackedBonding.CallOpts, operator)
+}
+
+// HasSecondaryAuthorization is a free data retrieval call binding the contract method 0x78f011c1.
+//
+// Solidity: function hasSecondaryAuthorization(address _operator, address _poolAddress) constant returns(bool)
+func (_FullyBackedBonding *FullyBackedBondingCaller) HasSecondaryAuthorization(opts *bind.CallOpts, _operator common.Address, _poolAddress common.Address) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _FullyBackedBonding.contract.Call(opts, out, "hasSecondaryAuthorization", _operator, _poolAddress)
+	return *ret0, err
+}
+
+// HasSecondaryAuthorization is a free data retrieval call binding the contract method 0x78f011c1.
+//
+// Solidity: function hasSecondaryAuthorization(address _operator, address _poolAddress) constant returns(bool)
+func (_FullyBackedBonding *FullyBackedBondingSession) HasSecondaryAuthorization(_operator common.Address, _poolAddress common.Address) (bool, error) {
+	return _FullyBackedBonding.Contract.HasSecondaryAuthorization(&_FullyBackedBonding.CallOpts, _operator, _poolAddress)
+}
+
+// HasSecondaryAuthorization is a free data retrieval call binding the contract method 0x78f011c1.
+//
+// Solidity: function hasSecondaryAuthorization(address _operator, address _poolAddress) constant returns(bool)
+func (_FullyBackedBonding *FullyBackedBondingCallerSession) HasSecondaryAuthorization(_operator common.Address, _poolAddress common.Address) (bool, error) {
+	return _FullyBackedBonding.Contract.HasSecondaryAuthorization(&_FullyBackedBonding.CallOpts, _operator, _poolAddress)
+}
+
+// InitializationPeriod is a free data retrieval call binding the contract method 0xaed1ec72.
+//
+// Solidity: function initializationPeriod() constant returns(uint256)
+func (_FullyBackedBonding *FullyBackedBondingCaller) InitializationPeriod(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _FullyBackedBonding.contract.Call(opts, out, "initializationPeriod")
+	return *ret0, err
+}
+
+// InitializationPeriod is a free data retrieval call binding the contract method 0xaed1ec72.
+//
+// Solidity: function initializationPeriod() constant returns(uint256)
+func (_FullyBackedBonding *FullyBackedBondingSession) InitializationPeriod() (*big.Int, error) {
+	return _FullyBackedBonding.Contract.InitializationPeriod(&_FullyBackedBonding.CallOpts)
+}
+
+// InitializationPeriod is a free data retrieval call binding the contract method 0xaed1ec72.
+//
+// Solidity: function initializationPeriod() constant returns(uint256)
+func (_FullyBackedBonding *FullyBackedBondingCallerSession) InitializationPeriod() (*big.Int, error) {
+	return _FullyBackedBonding.Contract.InitializationPeriod(&_FullyBackedBonding.CallOpts)
+}
+
+// IsAuthorizedForOperator is a free data retrieval call binding the contract method 0xef1f9661.
+//
+// Solidity: function isAuthorizedForOperator(address _operator, address _operatorContract) constant returns(bool)
+func (_FullyBackedBonding *FullyBackedBondingCaller) IsAuthorizedForOperator(opts *bind.CallOpts, _operator common.Address, _operatorContract common.Address) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _FullyBackedBonding.contract.Call(opts, out, "isAuthorizedForOperator", _operator, _operatorContract)
+	return *ret0, err
+}
+
+// IsAuthorizedForOperator is a free data retrieval call binding the contract method 0xef1f9661.
+//
+// Solidity: function isAuthorizedForOperator(address _operator, address _operatorContract) constant returns(bool)
+func (_FullyBackedBonding *FullyBackedBondingSession) IsAuthorizedForOperator(_operator common.Address, _operatorContract common.Address) (bool, error) {
+	return _FullyBackedBonding.Contract.IsAuthorizedForOperator(&_FullyBackedBonding.CallOpts, _operator, _operatorContract)
+}
+
+// IsAuthorizedForOperator is a free data retrieval call binding the contract method 0xef1f9661.
+//
+// Solidity: function isAuthorizedForOperator(address _operator, address _operatorContract) constant returns(bool)
+func (_FullyBackedBonding *FullyBackedBondingCallerSession) IsAuthorizedForOperator(_operator common.Address, _operatorContract common.Address) (bool, error) {
+	return _FullyBackedBonding.Contract.IsAuthorizedForOperator(&_FullyBackedBonding.CallOpts, _operator, _operatorContract)
+}
+
+// IsInitialized is a free data retrieval call binding the contract method 0x30315f62.
+//
+// Solidity: function isInitialized(address operator, address bondCreator) constant returns(bool)
+func (_FullyBackedBonding *FullyBackedBondingCaller) IsInitialized(opts *bind.CallOpts, operator common.Address, bondCreator common.Address) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _FullyBackedBonding.contract.Call(opts, out, "isInitialized", operator, bondCreator)
+	return *ret0, err
+}
+
+// IsInitialized is a free data retrieval call binding the contract method 0x30315f62.
+//
+// Solidity: function isInitialized(address operator, address bondCreator) constant returns(bool)
+func (_FullyBackedBonding *FullyBackedBondingSession) IsInitialized(operator common.Address, bondCreator common.Address) (bool, error) {
+	return _FullyBackedBonding.Contract.IsInitialized(&_FullyBackedBonding.CallOpts, operator, bondCreator)
+}
+
+// IsInitialized is a free data retrieval call binding the contract method 0x30315f62.
+//
+// Solidity: function isInitialized(address operator, address bondCreator) constant returns(bool)
+func (_FullyBackedBonding *FullyBackedBondingCallerSession) IsInitialized(operator common.Address, bondCreator common.Address) (bool, error) {
+	return _FullyBackedBonding.Contract.IsInitialized(&_FullyBackedBonding.CallOpts, operator, bondCreator)
+}
+
+// UnbondedValue is a free data retrieval call binding the contract method 0x5823cfad.
+//
+// Solidity: function unbondedValue(address ) constant returns(uint256)
+func (_FullyBackedBonding *FullyBackedBondingCaller) UnbondedValue(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _FullyBackedBonding.contract.Call(opts, out, "unbondedValue", arg0)
+	return *ret0, err
+}
+
+// UnbondedValue is a free data retrieval call binding the contract method 0x5823cfad.
+//
+// Solidity: function unbondedValue(address ) constant returns(uint256)
+func (_FullyBackedBonding *FullyBackedBondingSession) UnbondedValue(arg0 common.Address) (*big.Int, error) {
+	return _FullyBackedBonding.Contract.UnbondedValue(&_FullyBackedBonding.CallOpts, arg0)
+}
+
+// UnbondedValue is a free data retrieval call binding the contract method 0x5823cfad.
+//
+// Solidity: function unbondedValue(address ) constant returns(uint256)
+func (_FullyBackedBonding *FullyBackedBondingCallerSession) UnbondedValue(arg0 common.Address) (*big.Int, error) {
+	return _FullyBackedBonding.Contract.UnbondedValue(&_FullyBackedBonding.CallOpts, arg0)
+}
+
+// AuthorizeSortitionPoolContract is a paid mutator transaction binding the contract method 0xc5786174.
+//
+// Solidity: function authorizeSortitionPoolContract(address _operator, address _poolAddress) returns()
+func (_FullyBackedBonding *FullyBackedBondingTransactor) AuthorizeSortitionPoolContract(opts *bind.TransactOpts, _operator common.Address, _poolAddress common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.contract.Transact(opts, "authorizeSortitionPoolContract", _operator, _poolAddress)
+}
+
+// AuthorizeSortitionPoolContract is a paid mutator transaction binding the contract method 0xc5786174.
+//
+// Solidity: function authorizeSortitionPoolContract(address _operator, address _poolAddress) returns()
+func (_FullyBackedBonding *FullyBackedBondingSession) AuthorizeSortitionPoolContract(_operator common.Address, _poolAddress common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.AuthorizeSortitionPoolContract(&_FullyBackedBonding.TransactOpts, _operator, _poolAddress)
+}
+
+// AuthorizeSortitionPoolContract is a paid mutator transaction binding the contract method 0xc5786174.
+//
+// Solidity: function authorizeSortitionPoolContract(address _operator, address _poolAddress) returns()
+func (_FullyBackedBonding *FullyBackedBondingTransactorSession) AuthorizeSortitionPoolContract(_operator common.Address, _poolAddress common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.AuthorizeSortitionPoolContract(&_FullyBackedBonding.TransactOpts, _operator, _poolAddress)
+}
+
+// CreateBond is a paid mutator transaction binding the contract method 0xd20a62fc.
+//
+// Solidity: function createBond(address operator, address holder, uint256 referenceID, uint256 amount, address authorizedSortitionPool) returns()
+func (_FullyBackedBonding *FullyBackedBondingTransactor) CreateBond(opts *bind.TransactOpts, operator common.Address, holder common.Address, referenceID *big.Int, amount *big.Int, authorizedSortitionPool common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.contract.Transact(opts, "createBond", operator, holder, referenceID, amount, authorizedSortitionPool)
+}
+
+// CreateBond is a paid mutator transaction binding the contract method 0xd20a62fc.
+//
+// Solidity: function createBond(address operator, address holder, uint256 referenceID, uint256 amount, address authorizedSortitionPool) returns()
+func (_FullyBackedBonding *FullyBackedBondingSession) CreateBond(operator common.Address, holder common.Address, referenceID *big.Int, amount *big.Int, authorizedSortitionPool common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.CreateBond(&_FullyBackedBonding.TransactOpts, operator, holder, referenceID, amount, authorizedSortitionPool)
+}
+
+// CreateBond is a paid mutator transaction binding the contract method 0xd20a62fc.
+//
+// Solidity: function createBond(address operator, address holder, uint256 referenceID, uint256 amount, address authorizedSortitionPool) returns()
+func (_FullyBackedBonding *FullyBackedBondingTransactorSession) CreateBond(operator common.Address, holder common.Address, referenceID *big.Int, amount *big.Int, authorizedSortitionPool common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.CreateBond(&_FullyBackedBonding.TransactOpts, operator, holder, referenceID, amount, authorizedSortitionPool)
+}
+
+// DeauthorizeSortitionPoolContract is a paid mutator transaction binding the contract method 0x0b102471.
+//
+// Solidity: function deauthorizeSortitionPoolContract(address _operator, address _poolAddress) returns()
+func (_FullyBackedBonding *FullyBackedBondingTransactor) DeauthorizeSortitionPoolContract(opts *bind.TransactOpts, _operator common.Address, _poolAddress common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.contract.Transact(opts, "deauthorizeSortitionPoolContract", _operator, _poolAddress)
+}
+
+// DeauthorizeSortitionPoolContract is a paid mutator transaction binding the contract method 0x0b102471.
+//
+// Solidity: function deauthorizeSortitionPoolContract(address _operator, address _poolAddress) returns()
+func (_FullyBackedBonding *FullyBackedBondingSession) DeauthorizeSortitionPoolContract(_operator common.Address, _poolAddress common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.DeauthorizeSortitionPoolContract(&_FullyBackedBonding.TransactOpts, _operator, _poolAddress)
+}
+
+// DeauthorizeSortitionPoolContract is a paid mutator transaction binding the contract method 0x0b102471.
+//
+// Solidity: function deauthorizeSortitionPoolContract(address _operator, address _poolAddress) returns()
+func (_FullyBackedBonding *FullyBackedBondingTransactorSession) DeauthorizeSortitionPoolContract(_operator common.Address, _poolAddress common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.DeauthorizeSortitionPoolContract(&_FullyBackedBonding.TransactOpts, _operator, _poolAddress)
+}
+
+// Delegate is a paid mutator transaction binding the contract method 0x2e341ce0.
+//
+// Solidity: function delegate(address operator, address beneficiary, address authorizer) returns()
+func (_FullyBackedBonding *FullyBackedBondingTransactor) Delegate(opts *bind.TransactOpts, operator common.Address, beneficiary common.Address, authorizer common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.contract.Transact(opts, "delegate", operator, beneficiary, authorizer)
+}
+
+// Delegate is a paid mutator transaction binding the contract method 0x2e341ce0.
+//
+// Solidity: function delegate(address operator, address beneficiary, address authorizer) returns()
+func (_FullyBackedBonding *FullyBackedBondingSession) Delegate(operator common.Address, beneficiary common.Address, authorizer common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.Delegate(&_FullyBackedBonding.TransactOpts, operator, beneficiary, authorizer)
+}
+
+// Delegate is a paid mutator transaction binding the contract method 0x2e341ce0.
+//
+// Solidity: function delegate(address operator, address beneficiary, address authorizer) returns()
+func (_FullyBackedBonding *FullyBackedBondingTransactorSession) Delegate(operator common.Address, beneficiary common.Address, authorizer common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.Delegate(&_FullyBackedBonding.TransactOpts, operator, beneficiary, authorizer)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xf340fa01.
+//
+// Solidity: function deposit(address operator) returns()
+func (_FullyBackedBonding *FullyBackedBondingTransactor) Deposit(opts *bind.TransactOpts, operator common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.contract.Transact(opts, "deposit", operator)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xf340fa01.
+//
+// Solidity: function deposit(address operator) returns()
+func (_FullyBackedBonding *FullyBackedBondingSession) Deposit(operator common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.Deposit(&_FullyBackedBonding.TransactOpts, operator)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xf340fa01.
+//
+// Solidity: function deposit(address operator) returns()
+func (_FullyBackedBonding *FullyBackedBondingTransactorSession) Deposit(operator common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.Deposit(&_FullyBackedBonding.TransactOpts, operator)
+}
+
+// FreeBond is a paid mutator transaction binding the contract method 0x7ab3cf93.
+//
+// Solidity: function freeBond(address operator, uint256 referenceID) returns()
+func (_FullyBackedBonding *FullyBackedBondingTransactor) FreeBond(opts *bind.TransactOpts, operator common.Address, referenceID *big.Int) (*types.Transaction, error) {
+	return _FullyBackedBonding.contract.Transact(opts, "freeBond", operator, referenceID)
+}
+
+// FreeBond is a paid mutator transaction binding the contract method 0x7ab3cf93.
+//
+// Solidity: function freeBond(address operator, uint256 referenceID) returns()
+func (_FullyBackedBonding *FullyBackedBondingSession) FreeBond(operator common.Address, referenceID *big.Int) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.FreeBond(&_FullyBackedBonding.TransactOpts, operator, referenceID)
+}
+
+// FreeBond is a paid mutator transaction binding the contract method 0x7ab3cf93.
+//
+// Solidity: function freeBond(address operator, uint256 referenceID) returns()
+func (_FullyBackedBonding *FullyBackedBondingTransactorSession) FreeBond(operator common.Address, referenceID *big.Int) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.FreeBond(&_FullyBackedBonding.TransactOpts, operator, referenceID)
+}
+
+// ReassignBond is a paid mutator transaction binding the contract method 0x972f2457.
+//
+// Solidity: function reassignBond(address operator, uint256 referenceID, address newHolder, uint256 newReferenceID) returns()
+func (_FullyBackedBonding *FullyBackedBondingTransactor) ReassignBond(opts *bind.TransactOpts, operator common.Address, referenceID *big.Int, newHolder common.Address, newReferenceID *big.Int) (*types.Transaction, error) {
+	return _FullyBackedBonding.contract.Transact(opts, "reassignBond", operator, referenceID, newHolder, newReferenceID)
+}
+
+// ReassignBond is a paid mutator transaction binding the contract method 0x972f2457.
+//
+// Solidity: function reassignBond(address operator, uint256 referenceID, address newHolder, uint256 newReferenceID) returns()
+func (_FullyBackedBonding *FullyBackedBondingSession) ReassignBond(operator common.Address, referenceID *big.Int, newHolder common.Address, newReferenceID *big.Int) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.ReassignBond(&_FullyBackedBonding.TransactOpts, operator, referenceID, newHolder, newReferenceID)
+}
+
+// ReassignBond is a paid mutator transaction binding the contract method 0x972f2457.
+//
+// Solidity: function reassignBond(address operator, uint256 referenceID, address newHolder, uint256 newReferenceID) returns()
+func (_FullyBackedBonding *FullyBackedBondingTransactorSession) ReassignBond(operator common.Address, referenceID *big.Int, newHolder common.Address, newReferenceID *big.Int) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.ReassignBond(&_FullyBackedBonding.TransactOpts, operator, referenceID, newHolder, newReferenceID)
+}
+
+// SeizeBond is a paid mutator transaction binding the contract method 0x0cb0a677.
+//
+// Solidity: function seizeBond(address operator, uint256 referenceID, uint256 amount, address destination) returns()
+func (_FullyBackedBonding *FullyBackedBondingTransactor) SeizeBond(opts *bind.TransactOpts, operator common.Address, referenceID *big.Int, amount *big.Int, destination common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.contract.Transact(opts, "seizeBond", operator, referenceID, amount, destination)
+}
+
+// SeizeBond is a paid mutator transaction binding the contract method 0x0cb0a677.
+//
+// Solidity: function seizeBond(address operator, uint256 referenceID, uint256 amount, address destination) returns()
+func (_FullyBackedBonding *FullyBackedBondingSession) SeizeBond(operator common.Address, referenceID *big.Int, amount *big.Int, destination common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.SeizeBond(&_FullyBackedBonding.TransactOpts, operator, referenceID, amount, destination)
+}
+
+// SeizeBond is a paid mutator transaction binding the contract method 0x0cb0a677.
+//
+// Solidity: function seizeBond(address operator, uint256 referenceID, uint256 amount, address destination) returns()
+func (_FullyBackedBonding *FullyBackedBondingTransactorSession) SeizeBond(operator common.Address, referenceID *big.Int, amount *big.Int, destination common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.SeizeBond(&_FullyBackedBonding.TransactOpts, operator, referenceID, amount, destination)
+}
+
+// TopUp is a paid mutator transaction binding the contract method 0x1bbed321.
+//
+// Solidity: function topUp(address operator) returns()
+func (_FullyBackedBonding *FullyBackedBondingTransactor) TopUp(opts *bind.TransactOpts, operator common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.contract.Transact(opts, "topUp", operator)
+}
+
+// TopUp is a paid mutator transaction binding the contract method 0x1bbed321.
+//
+// Solidity: function topUp(address operator) returns()
+func (_FullyBackedBonding *FullyBackedBondingSession) TopUp(operator common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.TopUp(&_FullyBackedBonding.TransactOpts, operator)
+}
+
+// TopUp is a paid mutator transaction binding the contract method 0x1bbed321.
+//
+// Solidity: function topUp(address operator) returns()
+func (_FullyBackedBonding *FullyBackedBondingTransactorSession) TopUp(operator common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.TopUp(&_FullyBackedBonding.TransactOpts, operator)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x00f714ce.
+//
+// Solidity: function withdraw(uint256 amount, address operator) returns()
+func (_FullyBackedBonding *FullyBackedBondingTransactor) Withdraw(opts *bind.TransactOpts, amount *big.Int, operator common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.contract.Transact(opts, "withdraw", amount, operator)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x00f714ce.
+//
+// Solidity: function withdraw(uint256 amount, address operator) returns()
+func (_FullyBackedBonding *FullyBackedBondingSession) Withdraw(amount *big.Int, operator common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.Withdraw(&_FullyBackedBonding.TransactOpts, amount, operator)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x00f714ce.
+//
+// Solidity: function withdraw(uint256 amount, address operator) returns()
+func (_FullyBackedBonding *FullyBackedBondingTransactorSession) Withdraw(amount *big.Int, operator common.Address) (*types.Transaction, error) {
+	return _FullyBackedBonding.Contract.Withdraw(&_FullyBackedBonding.TransactOpts, amount, operator)
+}
+
+// FullyBackedBondingBondCreatedIterator is returned from FilterBondCreated and is used to iterate over the raw logs and unpacked data for BondCreated events raised by the FullyBackedBonding contract.
+type FullyBackedBondingBondCreatedIterator struct {
+	Event *FullyBackedBondingBondCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FullyBackedBondingBondCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FullyBackedBondingBondCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FullyBackedBondingBondCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FullyBackedBondingBondCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FullyBackedBondingBondCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FullyBackedBondingBondCreated represents a BondCreated event raised by the FullyBackedBonding contract.
+type FullyBackedBondingBondCreated struct {
+	Operator      common.Address
+	Holder        common.Address
+	SortitionPool common.Address
+	ReferenceID   *big.Int
+	Amount        *big.Int
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterBondCreated is a free log retrieval operation binding the contract event 0xa5543d8e139d9ab4342d5c4f6ec1bff5a97f9a52d71f7ffe9845b94f1449fc91.
+//
+// Solidity: event BondCreated(address indexed operator, address indexed holder, address indexed sortitionPool, uint256 referenceID, uint256 amount)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) FilterBondCreated(opts *bind.FilterOpts, operator []common.Address, holder []common.Address, sortitionPool []common.Address) (*FullyBackedBondingBondCreatedIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var holderRule []interface{}
+	for _, holderItem := range holder {
+		holderRule = append(holderRule, holderItem)
+	}
+	var sortitionPoolRule []interface{}
+	for _, sortitionPoolItem := range sortitionPool {
+		sortitionPoolRule = append(sortitionPoolRule, sortitionPoolItem)
+	}
+
+	logs, sub, err := _FullyBackedBonding.contract.FilterLogs(opts, "BondCreated", operatorRule, holderRule, sortitionPoolRule)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedBondingBondCreatedIterator{contract: _FullyBackedBonding.contract, event: "BondCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchBondCreated is a free log subscription operation binding the contract event 0xa5543d8e139d9ab4342d5c4f6ec1bff5a97f9a52d71f7ffe9845b94f1449fc91.
+//
+// Solidity: event BondCreated(address indexed operator, address indexed holder, address indexed sortitionPool, uint256 referenceID, uint256 amount)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) WatchBondCreated(opts *bind.WatchOpts, sink chan<- *FullyBackedBondingBondCreated, operator []common.Address, holder []common.Address, sortitionPool []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var holderRule []interface{}
+	for _, holderItem := range holder {
+		holderRule = append(holderRule, holderItem)
+	}
+	var sortitionPoolRule []interface{}
+	for _, sortitionPoolItem := range sortitionPool {
+		sortitionPoolRule = append(sortitionPoolRule, sortitionPoolItem)
+	}
+
+	logs, sub, err := _FullyBackedBonding.contract.WatchLogs(opts, "BondCreated", operatorRule, holderRule, sortitionPoolRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FullyBackedBondingBondCreated)
+				if err := _FullyBackedBonding.contract.UnpackLog(event, "BondCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBondCreated is a log parse operation binding the contract event 0xa5543d8e139d9ab4342d5c4f6ec1bff5a97f9a52d71f7ffe9845b94f1449fc91.
+//
+// Solidity: event BondCreated(address indexed operator, address indexed holder, address indexed sortitionPool, uint256 referenceID, uint256 amount)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) ParseBondCreated(log types.Log) (*FullyBackedBondingBondCreated, error) {
+	event := new(FullyBackedBondingBondCreated)
+	if err := _FullyBackedBonding.contract.UnpackLog(event, "BondCreated", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// FullyBackedBondingBondReassignedIterator is returned from FilterBondReassigned and is used to iterate over the raw logs and unpacked data for BondReassigned events raised by the FullyBackedBonding contract.
+type FullyBackedBondingBondReassignedIterator struct {
+	Event *FullyBackedBondingBondReassigned // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FullyBackedBondingBondReassignedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FullyBackedBondingBondReassigned)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FullyBackedBondingBondReassigned)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FullyBackedBondingBondReassignedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FullyBackedBondingBondReassignedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FullyBackedBondingBondReassigned represents a BondReassigned event raised by the FullyBackedBonding contract.
+type FullyBackedBondingBondReassigned struct {
+	Operator       common.Address
+	ReferenceID    *big.Int
+	NewHolder      common.Address
+	NewReferenceID *big.Int
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterBondReassigned is a free log retrieval operation binding the contract event 0xb1d917176802bfbc813f2d82e745526029a4ccf0ea98d14e7a09a08703595b1e.
+//
+// Solidity: event BondReassigned(address indexed operator, uint256 indexed referenceID, address newHolder, uint256 newReferenceID)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) FilterBondReassigned(opts *bind.FilterOpts, operator []common.Address, referenceID []*big.Int) (*FullyBackedBondingBondReassignedIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var referenceIDRule []interface{}
+	for _, referenceIDItem := range referenceID {
+		referenceIDRule = append(referenceIDRule, referenceIDItem)
+	}
+
+	logs, sub, err := _FullyBackedBonding.contract.FilterLogs(opts, "BondReassigned", operatorRule, referenceIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedBondingBondReassignedIterator{contract: _FullyBackedBonding.contract, event: "BondReassigned", logs: logs, sub: sub}, nil
+}
+
+// WatchBondReassigned is a free log subscription operation binding the contract event 0xb1d917176802bfbc813f2d82e745526029a4ccf0ea98d14e7a09a08703595b1e.
+//
+// Solidity: event BondReassigned(address indexed operator, uint256 indexed referenceID, address newHolder, uint256 newReferenceID)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) WatchBondReassigned(opts *bind.WatchOpts, sink chan<- *FullyBackedBondingBondReassigned, operator []common.Address, referenceID []*big.Int) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var referenceIDRule []interface{}
+	for _, referenceIDItem := range referenceID {
+		referenceIDRule = append(referenceIDRule, referenceIDItem)
+	}
+
+	logs, sub, err := _FullyBackedBonding.contract.WatchLogs(opts, "BondReassigned", operatorRule, referenceIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FullyBackedBondingBondReassigned)
+				if err := _FullyBackedBonding.contract.UnpackLog(event, "BondReassigned", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBondReassigned is a log parse operation binding the contract event 0xb1d917176802bfbc813f2d82e745526029a4ccf0ea98d14e7a09a08703595b1e.
+//
+// Solidity: event BondReassigned(address indexed operator, uint256 indexed referenceID, address newHolder, uint256 newReferenceID)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) ParseBondReassigned(log types.Log) (*FullyBackedBondingBondReassigned, error) {
+	event := new(FullyBackedBondingBondReassigned)
+	if err := _FullyBackedBonding.contract.UnpackLog(event, "BondReassigned", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// FullyBackedBondingBondReleasedIterator is returned from FilterBondReleased and is used to iterate over the raw logs and unpacked data for BondReleased events raised by the FullyBackedBonding contract.
+type FullyBackedBondingBondReleasedIterator struct {
+	Event *FullyBackedBondingBondReleased // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FullyBackedBondingBondReleasedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FullyBackedBondingBondReleased)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FullyBackedBondingBondReleased)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FullyBackedBondingBondReleasedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FullyBackedBondingBondReleasedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FullyBackedBondingBondReleased represents a BondReleased event raised by the FullyBackedBonding contract.
+type FullyBackedBondingBondReleased struct {
+	Operator    common.Address
+	ReferenceID *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBondReleased is a free log retrieval operation binding the contract event 0x60b8ef4216791426b3d7acfb0b6d11a400872350afd70a3ce5ebf62bea7cb0d4.
+//
+// Solidity: event BondReleased(address indexed operator, uint256 indexed referenceID)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) FilterBondReleased(opts *bind.FilterOpts, operator []common.Address, referenceID []*big.Int) (*FullyBackedBondingBondReleasedIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var referenceIDRule []interface{}
+	for _, referenceIDItem := range referenceID {
+		referenceIDRule = append(referenceIDRule, referenceIDItem)
+	}
+
+	logs, sub, err := _FullyBackedBonding.contract.FilterLogs(opts, "BondReleased", operatorRule, referenceIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedBondingBondReleasedIterator{contract: _FullyBackedBonding.contract, event: "BondReleased", logs: logs, sub: sub}, nil
+}
+
+// WatchBondReleased is a free log subscription operation binding the contract event 0x60b8ef4216791426b3d7acfb0b6d11a400872350afd70a3ce5ebf62bea7cb0d4.
+//
+// Solidity: event BondReleased(address indexed operator, uint256 indexed referenceID)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) WatchBondReleased(opts *bind.WatchOpts, sink chan<- *FullyBackedBondingBondReleased, operator []common.Address, referenceID []*big.Int) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var referenceIDRule []interface{}
+	for _, referenceIDItem := range referenceID {
+		referenceIDRule = append(referenceIDRule, referenceIDItem)
+	}
+
+	logs, sub, err := _FullyBackedBonding.contract.WatchLogs(opts, "BondReleased", operatorRule, referenceIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FullyBackedBondingBondReleased)
+				if err := _FullyBackedBonding.contract.UnpackLog(event, "BondReleased", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBondReleased is a log parse operation binding the contract event 0x60b8ef4216791426b3d7acfb0b6d11a400872350afd70a3ce5ebf62bea7cb0d4.
+//
+// Solidity: event BondReleased(address indexed operator, uint256 indexed referenceID)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) ParseBondReleased(log types.Log) (*FullyBackedBondingBondReleased, error) {
+	event := new(FullyBackedBondingBondReleased)
+	if err := _FullyBackedBonding.contract.UnpackLog(event, "BondReleased", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// FullyBackedBondingBondSeizedIterator is returned from FilterBondSeized and is used to iterate over the raw logs and unpacked data for BondSeized events raised by the FullyBackedBonding contract.
+type FullyBackedBondingBondSeizedIterator struct {
+	Event *FullyBackedBondingBondSeized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FullyBackedBondingBondSeizedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FullyBackedBondingBondSeized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FullyBackedBondingBondSeized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FullyBackedBondingBondSeizedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FullyBackedBondingBondSeizedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FullyBackedBondingBondSeized represents a BondSeized event raised by the FullyBackedBonding contract.
+type FullyBackedBondingBondSeized struct {
+	Operator    common.Address
+	ReferenceID *big.Int
+	Destination common.Address
+	Amount      *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBondSeized is a free log retrieval operation binding the contract event 0xf8e947b47b515d01aa96426822ddcf23a08f42d8c2dbfd65e674ba824f551382.
+//
+// Solidity: event BondSeized(address indexed operator, uint256 indexed referenceID, address destination, uint256 amount)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) FilterBondSeized(opts *bind.FilterOpts, operator []common.Address, referenceID []*big.Int) (*FullyBackedBondingBondSeizedIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var referenceIDRule []interface{}
+	for _, referenceIDItem := range referenceID {
+		referenceIDRule = append(referenceIDRule, referenceIDItem)
+	}
+
+	logs, sub, err := _FullyBackedBonding.contract.FilterLogs(opts, "BondSeized", operatorRule, referenceIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedBondingBondSeizedIterator{contract: _FullyBackedBonding.contract, event: "BondSeized", logs: logs, sub: sub}, nil
+}
+
+// WatchBondSeized is a free log subscription operation binding the contract event 0xf8e947b47b515d01aa96426822ddcf23a08f42d8c2dbfd65e674ba824f551382.
+//
+// Solidity: event BondSeized(address indexed operator, uint256 indexed referenceID, address destination, uint256 amount)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) WatchBondSeized(opts *bind.WatchOpts, sink chan<- *FullyBackedBondingBondSeized, operator []common.Address, referenceID []*big.Int) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var referenceIDRule []interface{}
+	for _, referenceIDItem := range referenceID {
+		referenceIDRule = append(referenceIDRule, referenceIDItem)
+	}
+
+	logs, sub, err := _FullyBackedBonding.contract.WatchLogs(opts, "BondSeized", operatorRule, referenceIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FullyBackedBondingBondSeized)
+				if err := _FullyBackedBonding.contract.UnpackLog(event, "BondSeized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBondSeized is a log parse operation binding the contract event 0xf8e947b47b515d01aa96426822ddcf23a08f42d8c2dbfd65e674ba824f551382.
+//
+// Solidity: event BondSeized(address indexed operator, uint256 indexed referenceID, address destination, uint256 amount)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) ParseBondSeized(log types.Log) (*FullyBackedBondingBondSeized, error) {
+	event := new(FullyBackedBondingBondSeized)
+	if err := _FullyBackedBonding.contract.UnpackLog(event, "BondSeized", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// FullyBackedBondingDelegatedIterator is returned from FilterDelegated and is used to iterate over the raw logs and unpacked data for Delegated events raised by the FullyBackedBonding contract.
+type FullyBackedBondingDelegatedIterator struct {
+	Event *FullyBackedBondingDelegated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FullyBackedBondingDelegatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FullyBackedBondingDelegated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FullyBackedBondingDelegated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FullyBackedBondingDelegatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FullyBackedBondingDelegatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FullyBackedBondingDelegated represents a Delegated event raised by the FullyBackedBonding contract.
+type FullyBackedBondingDelegated struct {
+	Owner    common.Address
+	Operator common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterDelegated is a free log retrieval operation binding the contract event 0x4bc154dd35d6a5cb9206482ecb473cdbf2473006d6bce728b9cc0741bcc59ea2.
+//
+// Solidity: event Delegated(address indexed owner, address indexed operator)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) FilterDelegated(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*FullyBackedBondingDelegatedIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _FullyBackedBonding.contract.FilterLogs(opts, "Delegated", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedBondingDelegatedIterator{contract: _FullyBackedBonding.contract, event: "Delegated", logs: logs, sub: sub}, nil
+}
+
+// WatchDelegated is a free log subscription operation binding the contract event 0x4bc154dd35d6a5cb9206482ecb473cdbf2473006d6bce728b9cc0741bcc59ea2.
+//
+// Solidity: event Delegated(address indexed owner, address indexed operator)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) WatchDelegated(opts *bind.WatchOpts, sink chan<- *FullyBackedBondingDelegated, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _FullyBackedBonding.contract.WatchLogs(opts, "Delegated", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FullyBackedBondingDelegated)
+				if err := _FullyBackedBonding.contract.UnpackLog(event, "Delegated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDelegated is a log parse operation binding the contract event 0x4bc154dd35d6a5cb9206482ecb473cdbf2473006d6bce728b9cc0741bcc59ea2.
+//
+// Solidity: event Delegated(address indexed owner, address indexed operator)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) ParseDelegated(log types.Log) (*FullyBackedBondingDelegated, error) {
+	event := new(FullyBackedBondingDelegated)
+	if err := _FullyBackedBonding.contract.UnpackLog(event, "Delegated", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// FullyBackedBondingOperatorDelegatedIterator is returned from FilterOperatorDelegated and is used to iterate over the raw logs and unpacked data for OperatorDelegated events raised by the FullyBackedBonding contract.
+type FullyBackedBondingOperatorDelegatedIterator struct {
+	Event *FullyBackedBondingOperatorDelegated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FullyBackedBondingOperatorDelegatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FullyBackedBondingOperatorDelegated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FullyBackedBondingOperatorDelegated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FullyBackedBondingOperatorDelegatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FullyBackedBondingOperatorDelegatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FullyBackedBondingOperatorDelegated represents a OperatorDelegated event raised by the FullyBackedBonding contract.
+type FullyBackedBondingOperatorDelegated struct {
+	Operator    common.Address
+	Beneficiary common.Address
+	Authorizer  common.Address
+	Value       *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterOperatorDelegated is a free log retrieval operation binding the contract event 0xa39bf252411ec873a14985aaddc5fc000c26cfa8001460a09b618e2e03c8f304.
+//
+// Solidity: event OperatorDelegated(address indexed operator, address indexed beneficiary, address indexed authorizer, uint256 value)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) FilterOperatorDelegated(opts *bind.FilterOpts, operator []common.Address, beneficiary []common.Address, authorizer []common.Address) (*FullyBackedBondingOperatorDelegatedIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var beneficiaryRule []interface{}
+	for _, beneficiaryItem := range beneficiary {
+		beneficiaryRule = append(beneficiaryRule, beneficiaryItem)
+	}
+	var authorizerRule []interface{}
+	for _, authorizerItem := range authorizer {
+		authorizerRule = append(authorizerRule, authorizerItem)
+	}
+
+	logs, sub, err := _FullyBackedBonding.contract.FilterLogs(opts, "OperatorDelegated", operatorRule, beneficiaryRule, authorizerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedBondingOperatorDelegatedIterator{contract: _FullyBackedBonding.contract, event: "OperatorDelegated", logs: logs, sub: sub}, nil
+}
+
+// WatchOperatorDelegated is a free log subscription operation binding the contract event 0xa39bf252411ec873a14985aaddc5fc000c26cfa8001460a09b618e2e03c8f304.
+//
+// Solidity: event OperatorDelegated(address indexed operator, address indexed beneficiary, address indexed authorizer, uint256 value)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) WatchOperatorDelegated(opts *bind.WatchOpts, sink chan<- *FullyBackedBondingOperatorDelegated, operator []common.Address, beneficiary []common.Address, authorizer []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var beneficiaryRule []interface{}
+	for _, beneficiaryItem := range beneficiary {
+		beneficiaryRule = append(beneficiaryRule, beneficiaryItem)
+	}
+	var authorizerRule []interface{}
+	for _, authorizerItem := range authorizer {
+		authorizerRule = append(authorizerRule, authorizerItem)
+	}
+
+	logs, sub, err := _FullyBackedBonding.contract.WatchLogs(opts, "OperatorDelegated", operatorRule, beneficiaryRule, authorizerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FullyBackedBondingOperatorDelegated)
+				if err := _FullyBackedBonding.contract.UnpackLog(event, "OperatorDelegated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOperatorDelegated is a log parse operation binding the contract event 0xa39bf252411ec873a14985aaddc5fc000c26cfa8001460a09b618e2e03c8f304.
+//
+// Solidity: event OperatorDelegated(address indexed operator, address indexed beneficiary, address indexed authorizer, uint256 value)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) ParseOperatorDelegated(log types.Log) (*FullyBackedBondingOperatorDelegated, error) {
+	event := new(FullyBackedBondingOperatorDelegated)
+	if err := _FullyBackedBonding.contract.UnpackLog(event, "OperatorDelegated", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// FullyBackedBondingOperatorToppedUpIterator is returned from FilterOperatorToppedUp and is used to iterate over the raw logs and unpacked data for OperatorToppedUp events raised by the FullyBackedBonding contract.
+type FullyBackedBondingOperatorToppedUpIterator struct {
+	Event *FullyBackedBondingOperatorToppedUp // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FullyBackedBondingOperatorToppedUpIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FullyBackedBondingOperatorToppedUp)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FullyBackedBondingOperatorToppedUp)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FullyBackedBondingOperatorToppedUpIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FullyBackedBondingOperatorToppedUpIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FullyBackedBondingOperatorToppedUp represents a OperatorToppedUp event raised by the FullyBackedBonding contract.
+type FullyBackedBondingOperatorToppedUp struct {
+	Operator common.Address
+	Value    *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterOperatorToppedUp is a free log retrieval operation binding the contract event 0xee1e07016afd2b0494337cfa45092b70aaeadd1c5ec9c3a3d1a763761a1df49a.
+//
+// Solidity: event OperatorToppedUp(address indexed operator, uint256 value)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) FilterOperatorToppedUp(opts *bind.FilterOpts, operator []common.Address) (*FullyBackedBondingOperatorToppedUpIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _FullyBackedBonding.contract.FilterLogs(opts, "OperatorToppedUp", operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedBondingOperatorToppedUpIterator{contract: _FullyBackedBonding.contract, event: "OperatorToppedUp", logs: logs, sub: sub}, nil
+}
+
+// WatchOperatorToppedUp is a free log subscription operation binding the contract event 0xee1e07016afd2b0494337cfa45092b70aaeadd1c5ec9c3a3d1a763761a1df49a.
+//
+// Solidity: event OperatorToppedUp(address indexed operator, uint256 value)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) WatchOperatorToppedUp(opts *bind.WatchOpts, sink chan<- *FullyBackedBondingOperatorToppedUp, operator []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _FullyBackedBonding.contract.WatchLogs(opts, "OperatorToppedUp", operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FullyBackedBondingOperatorToppedUp)
+				if err := _FullyBackedBonding.contract.UnpackLog(event, "OperatorToppedUp", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOperatorToppedUp is a log parse operation binding the contract event 0xee1e07016afd2b0494337cfa45092b70aaeadd1c5ec9c3a3d1a763761a1df49a.
+//
+// Solidity: event OperatorToppedUp(address indexed operator, uint256 value)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) ParseOperatorToppedUp(log types.Log) (*FullyBackedBondingOperatorToppedUp, error) {
+	event := new(FullyBackedBondingOperatorToppedUp)
+	if err := _FullyBackedBonding.contract.UnpackLog(event, "OperatorToppedUp", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// FullyBackedBondingUnbondedValueDepositedIterator is returned from FilterUnbondedValueDeposited and is used to iterate over the raw logs and unpacked data for UnbondedValueDeposited events raised by the FullyBackedBonding contract.
+type FullyBackedBondingUnbondedValueDepositedIterator struct {
+	Event *FullyBackedBondingUnbondedValueDeposited // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FullyBackedBondingUnbondedValueDepositedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FullyBackedBondingUnbondedValueDeposited)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FullyBackedBondingUnbondedValueDeposited)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FullyBackedBondingUnbondedValueDepositedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FullyBackedBondingUnbondedValueDepositedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FullyBackedBondingUnbondedValueDeposited represents a UnbondedValueDeposited event raised by the FullyBackedBonding contract.
+type FullyBackedBondingUnbondedValueDeposited struct {
+	Operator    common.Address
+	Beneficiary common.Address
+	Amount      *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnbondedValueDeposited is a free log retrieval operation binding the contract event 0xfd586a32ad24d585b1f7b36ee48e66304ad7627b48b39a0ab1d8a3e84741ea2a.
+//
+// Solidity: event UnbondedValueDeposited(address indexed operator, address indexed beneficiary, uint256 amount)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) FilterUnbondedValueDeposited(opts *bind.FilterOpts, operator []common.Address, beneficiary []common.Address) (*FullyBackedBondingUnbondedValueDepositedIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var beneficiaryRule []interface{}
+	for _, beneficiaryItem := range beneficiary {
+		beneficiaryRule = append(beneficiaryRule, beneficiaryItem)
+	}
+
+	logs, sub, err := _FullyBackedBonding.contract.FilterLogs(opts, "UnbondedValueDeposited", operatorRule, beneficiaryRule)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedBondingUnbondedValueDepositedIterator{contract: _FullyBackedBonding.contract, event: "UnbondedValueDeposited", logs: logs, sub: sub}, nil
+}
+
+// WatchUnbondedValueDeposited is a free log subscription operation binding the contract event 0xfd586a32ad24d585b1f7b36ee48e66304ad7627b48b39a0ab1d8a3e84741ea2a.
+//
+// Solidity: event UnbondedValueDeposited(address indexed operator, address indexed beneficiary, uint256 amount)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) WatchUnbondedValueDeposited(opts *bind.WatchOpts, sink chan<- *FullyBackedBondingUnbondedValueDeposited, operator []common.Address, beneficiary []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var beneficiaryRule []interface{}
+	for _, beneficiaryItem := range beneficiary {
+		beneficiaryRule = append(beneficiaryRule, beneficiaryItem)
+	}
+
+	logs, sub, err := _FullyBackedBonding.contract.WatchLogs(opts, "UnbondedValueDeposited", operatorRule, beneficiaryRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FullyBackedBondingUnbondedValueDeposited)
+				if err := _FullyBackedBonding.contract.UnpackLog(event, "UnbondedValueDeposited", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnbondedValueDeposited is a log parse operation binding the contract event 0xfd586a32ad24d585b1f7b36ee48e66304ad7627b48b39a0ab1d8a3e84741ea2a.
+//
+// Solidity: event UnbondedValueDeposited(address indexed operator, address indexed beneficiary, uint256 amount)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) ParseUnbondedValueDeposited(log types.Log) (*FullyBackedBondingUnbondedValueDeposited, error) {
+	event := new(FullyBackedBondingUnbondedValueDeposited)
+	if err := _FullyBackedBonding.contract.UnpackLog(event, "UnbondedValueDeposited", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// FullyBackedBondingUnbondedValueWithdrawnIterator is returned from FilterUnbondedValueWithdrawn and is used to iterate over the raw logs and unpacked data for UnbondedValueWithdrawn events raised by the FullyBackedBonding contract.
+type FullyBackedBondingUnbondedValueWithdrawnIterator struct {
+	Event *FullyBackedBondingUnbondedValueWithdrawn // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FullyBackedBondingUnbondedValueWithdrawnIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FullyBackedBondingUnbondedValueWithdrawn)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FullyBackedBondingUnbondedValueWithdrawn)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FullyBackedBondingUnbondedValueWithdrawnIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FullyBackedBondingUnbondedValueWithdrawnIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FullyBackedBondingUnbondedValueWithdrawn represents a UnbondedValueWithdrawn event raised by the FullyBackedBonding contract.
+type FullyBackedBondingUnbondedValueWithdrawn struct {
+	Operator    common.Address
+	Beneficiary common.Address
+	Amount      *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnbondedValueWithdrawn is a free log retrieval operation binding the contract event 0x5ebf1d16423ab39117c0ca9327215b5bcd423aaf7042044c87248a4423d252d9.
+//
+// Solidity: event UnbondedValueWithdrawn(address indexed operator, address indexed beneficiary, uint256 amount)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) FilterUnbondedValueWithdrawn(opts *bind.FilterOpts, operator []common.Address, beneficiary []common.Address) (*FullyBackedBondingUnbondedValueWithdrawnIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var beneficiaryRule []interface{}
+	for _, beneficiaryItem := range beneficiary {
+		beneficiaryRule = append(beneficiaryRule, beneficiaryItem)
+	}
+
+	logs, sub, err := _FullyBackedBonding.contract.FilterLogs(opts, "UnbondedValueWithdrawn", operatorRule, beneficiaryRule)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedBondingUnbondedValueWithdrawnIterator{contract: _FullyBackedBonding.contract, event: "UnbondedValueWithdrawn", logs: logs, sub: sub}, nil
+}
+
+// WatchUnbondedValueWithdrawn is a free log subscription operation binding the contract event 0x5ebf1d16423ab39117c0ca9327215b5bcd423aaf7042044c87248a4423d252d9.
+//
+// Solidity: event UnbondedValueWithdrawn(address indexed operator, address indexed beneficiary, uint256 amount)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) WatchUnbondedValueWithdrawn(opts *bind.WatchOpts, sink chan<- *FullyBackedBondingUnbondedValueWithdrawn, operator []common.Address, beneficiary []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var beneficiaryRule []interface{}
+	for _, beneficiaryItem := range beneficiary {
+		beneficiaryRule = append(beneficiaryRule, beneficiaryItem)
+	}
+
+	logs, sub, err := _FullyBackedBonding.contract.WatchLogs(opts, "UnbondedValueWithdrawn", operatorRule, beneficiaryRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FullyBackedBondingUnbondedValueWithdrawn)
+				if err := _FullyBackedBonding.contract.UnpackLog(event, "UnbondedValueWithdrawn", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnbondedValueWithdrawn is a log parse operation binding the contract event 0x5ebf1d16423ab39117c0ca9327215b5bcd423aaf7042044c87248a4423d252d9.
+//
+// Solidity: event UnbondedValueWithdrawn(address indexed operator, address indexed beneficiary, uint256 amount)
+func (_FullyBackedBonding *FullyBackedBondingFilterer) ParseUnbondedValueWithdrawn(log types.Log) (*FullyBackedBondingUnbondedValueWithdrawn, error) {
+	event := new(FullyBackedBondingUnbondedValueWithdrawn)
+	if err := _FullyBackedBonding.contract.UnpackLog(event, "UnbondedValueWithdrawn", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
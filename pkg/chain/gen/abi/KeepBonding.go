@@ -0,0 +1,544 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package abi
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = abi.U256
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// KeepBondingABI is the input ABI used to generate the binding from.
+const KeepBondingABI = "[{\"type\":\"function\",\"name\":\"authorizeSortitionPoolContract\",\"constant\":false,\"payable\":false,\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"_operator\",\"type\":\"address\"},{\"name\":\"_poolAddress\",\"type\":\"address\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"authorizerOf\",\"constant\":true,\"payable\":false,\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"_operator\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\"}]},{\"type\":\"function\",\"name\":\"availableUnbondedValue\",\"constant\":true,\"payable\":false,\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\"},{\"name\":\"bondCreator\",\"type\":\"address\"},{\"name\":\"authorizedSortitionPool\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"beneficiaryOf\",\"constant\":true,\"payable\":false,\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"_operator\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\"}]},{\"type\":\"function\",\"name\":\"bondAmount\",\"constant\":true,\"payable\":false,\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\"},{\"name\":\"holder\",\"type\":\"address\"},{\"name\":\"referenceID\",\"type\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"createBond\",\"constant\":false,\"payable\":false,\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\"},{\"name\":\"holder\",\"type\":\"address\"},{\"name\":\"referenceID\",\"type\":\"uint256\"},{\"name\":\"amount\",\"type\":\"uint256\"},{\"name\":\"authorizedSortitionPool\",\"type\":\"address\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"deauthorizeSortitionPoolContract\",\"constant\":false,\"payable\":false,\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"_operator\",\"type\":\"address\"},{\"name\":\"_poolAddress\",\"type\":\"address\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"deposit\",\"constant\":false,\"payable\":true,\"stateMutability\":\"payable\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"freeBond\",\"constant\":false,\"payable\":false,\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\"},{\"name\":\"referenceID\",\"type\":\"uint256\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"hasSecondaryAuthorization\",\"constant\":true,\"payable\":false,\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"_operator\",\"type\":\"address\"},{\"name\":\"_poolAddress\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}]},{\"type\":\"function\",\"name\":\"isAuthorizedForOperator\",\"constant\":true,\"payable\":false,\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"_operator\",\"type\":\"address\"},{\"name\":\"_operatorContract\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}]},{\"type\":\"function\",\"name\":\"reassignBond\",\"constant\":false,\"payable\":false,\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\"},{\"name\":\"referenceID\",\"type\":\"uint256\"},{\"name\":\"newHolder\",\"type\":\"address\"},{\"name\":\"newReferenceID\",\"type\":\"uint256\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"seizeBond\",\"constant\":false,\"payable\":false,\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\"},{\"name\":\"referenceID\",\"type\":\"uint256\"},{\"name\":\"amount\",\"type\":\"uint256\"},{\"name\":\"destination\",\"type\":\"address\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"unbondedValue\",\"constant\":true,\"payable\":false,\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"withdraw\",\"constant\":false,\"payable\":false,\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"amount\",\"type\":\"uint256\"},{\"name\":\"operator\",\"type\":\"address\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"withdrawAsManagedGrantee\",\"constant\":false,\"payable\":false,\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"amount\",\"type\":\"uint256\"},{\"name\":\"operator\",\"type\":\"address\"},{\"name\":\"managedGrant\",\"type\":\"address\"}],\"outputs\":[]}]"
+
+// KeepBonding is an auto generated Go binding around an Ethereum contract.
+type KeepBonding struct {
+	KeepBondingCaller     // Read-only binding to the contract
+	KeepBondingTransactor // Write-only binding to the contract
+	KeepBondingFilterer   // Log filterer for contract events
+}
+
+// KeepBondingCaller is an auto generated read-only Go binding around an Ethereum contract.
+type KeepBondingCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// KeepBondingTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type KeepBondingTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// KeepBondingFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type KeepBondingFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// KeepBondingSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type KeepBondingSession struct {
+	Contract     *KeepBonding      // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// KeepBondingCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type KeepBondingCallerSession struct {
+	Contract *KeepBondingCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts      // Call options to use throughout this session
+}
+
+// KeepBondingTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type KeepBondingTransactorSession struct {
+	Contract     *KeepBondingTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// KeepBondingRaw is an auto generated low-level Go binding around an Ethereum contract.
+type KeepBondingRaw struct {
+	Contract *KeepBonding // Generic contract binding to access the raw methods on
+}
+
+// KeepBondingCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type KeepBondingCallerRaw struct {
+	Contract *KeepBondingCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// KeepBondingTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type KeepBondingTransactorRaw struct {
+	Contract *KeepBondingTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewKeepBonding creates a new instance of KeepBonding, bound to a specific deployed contract.
+func NewKeepBonding(address common.Address, backend bind.ContractBackend) (*KeepBonding, error) {
+	contract, err := bindKeepBonding(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &KeepBonding{KeepBondingCaller: KeepBondingCaller{contract: contract}, KeepBondingTransactor: KeepBondingTransactor{contract: contract}, KeepBondingFilterer: KeepBondingFilterer{contract: contract}}, nil
+}
+
+// NewKeepBondingCaller creates a new read-only instance of KeepBonding, bound to a specific deployed contract.
+func NewKeepBondingCaller(address common.Address, caller bind.ContractCaller) (*KeepBondingCaller, error) {
+	contract, err := bindKeepBonding(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &KeepBondingCaller{contract: contract}, nil
+}
+
+// NewKeepBondingTransactor creates a new write-only instance of KeepBonding, bound to a specific deployed contract.
+func NewKeepBondingTransactor(address common.Address, transactor bind.ContractTransactor) (*KeepBondingTransactor, error) {
+	contract, err := bindKeepBonding(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &KeepBondingTransactor{contract: contract}, nil
+}
+
+// NewKeepBondingFilterer creates a new log filterer instance of KeepBonding, bound to a specific deployed contract.
+func NewKeepBondingFilterer(address common.Address, filterer bind.ContractFilterer) (*KeepBondingFilterer, error) {
+	contract, err := bindKeepBonding(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &KeepBondingFilterer{contract: contract}, nil
+}
+
+// bindKeepBonding binds a generic wrapper to an already deployed contract.
+func bindKeepBonding(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(KeepBondingABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_KeepBonding *KeepBondingRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _KeepBonding.Contract.KeepBondingCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_KeepBonding *KeepBondingRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _KeepBonding.Contract.KeepBondingTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_KeepBonding *KeepBondingRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _KeepBonding.Contract.KeepBondingTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_KeepBonding *KeepBondingCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _KeepBonding.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_KeepBonding *KeepBondingTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _KeepBonding.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_KeepBonding *KeepBondingTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _KeepBonding.Contract.contract.Transact(opts, method, params...)
+}
+
+// AuthorizerOf is a free data retrieval call binding the contract method 0xfb1677b1.
+//
+// Solidity: function authorizerOf(address _operator) constant returns(address)
+func (_KeepBonding *KeepBondingCaller) AuthorizerOf(opts *bind.CallOpts, _operator common.Address) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _KeepBonding.contract.Call(opts, out, "authorizerOf", _operator)
+	return *ret0, err
+}
+
+// AuthorizerOf is a free data retrieval call binding the contract method 0xfb1677b1.
+//
+// Solidity: function authorizerOf(address _operator) constant returns(address)
+func (_KeepBonding *KeepBondingSession) AuthorizerOf(_operator common.Address) (common.Address, error) {
+	return _KeepBonding.Contract.AuthorizerOf(&_KeepBonding.CallOpts, _operator)
+}
+
+// AuthorizerOf is a free data retrieval call binding the contract method 0xfb1677b1.
+//
+// Solidity: function authorizerOf(address _operator) constant returns(address)
+func (_KeepBonding *KeepBondingCallerSession) AuthorizerOf(_operator common.Address) (common.Address, error) {
+	return _KeepBonding.Contract.AuthorizerOf(&_KeepBonding.CallOpts, _operator)
+}
+
+// AvailableUnbondedValue is a free data retrieval call binding the contract method 0x42bcb965.
+//
+// Solidity: function availableUnbondedValue(address operator, address bondCreator, address authorizedSortitionPool) constant returns(uint256)
+func (_KeepBonding *KeepBondingCaller) AvailableUnbondedValue(opts *bind.CallOpts, operator common.Address, bondCreator common.Address, authorizedSortitionPool common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _KeepBonding.contract.Call(opts, out, "availableUnbondedValue", operator, bondCreator, authorizedSortitionPool)
+	return *ret0, err
+}
+
+// AvailableUnbondedValue is a free data retrieval call binding the contract method 0x42bcb965.
+//
+// Solidity: function availableUnbondedValue(address operator, address bondCreator, address authorizedSortitionPool) constant returns(uint256)
+func (_KeepBonding *KeepBondingSession) AvailableUnbondedValue(operator common.Address, bondCreator common.Address, authorizedSortitionPool common.Address) (*big.Int, error) {
+	return _KeepBonding.Contract.AvailableUnbondedValue(&_KeepBonding.CallOpts, operator, bondCreator, authorizedSortitionPool)
+}
+
+// AvailableUnbondedValue is a free data retrieval call binding the contract method 0x42bcb965.
+//
+// Solidity: function availableUnbondedValue(address operator, address bondCreator, address authorizedSortitionPool) constant returns(uint256)
+func (_KeepBonding *KeepBondingCallerSession) AvailableUnbondedValue(operator common.Address, bondCreator common.Address, authorizedSortitionPool common.Address) (*big.Int, error) {
+	return _KeepBonding.Contract.AvailableUnbondedValue(&_KeepBonding.CallOpts, operator, bondCreator, authorizedSortitionPool)
+}
+
+// BeneficiaryOf is a free data retrieval call binding the contract method 0xba7bffd3.
+//
+// Solidity: function beneficiaryOf(address _operator) constant returns(address)
+func (_KeepBonding *KeepBondingCaller) BeneficiaryOf(opts *bind.CallOpts, _operator common.Address) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _KeepBonding.contract.Call(opts, out, "beneficiaryOf", _operator)
+	return *ret0, err
+}
+
+// BeneficiaryOf is a free data retrieval call binding the contract method 0xba7bffd3.
+//
+// Solidity: function beneficiaryOf(address _operator) constant returns(address)
+func (_KeepBonding *KeepBondingSession) BeneficiaryOf(_operator common.Address) (common.Address, error) {
+	return _KeepBonding.Contract.BeneficiaryOf(&_KeepBonding.CallOpts, _operator)
+}
+
+// BeneficiaryOf is a free data retrieval call binding the contract method 0xba7bffd3.
+//
+// Solidity: function beneficiaryOf(address _operator) constant returns(address)
+func (_KeepBonding *KeepBondingCallerSession) BeneficiaryOf(_operator common.Address) (common.Address, error) {
+	return _KeepBonding.Contract.BeneficiaryOf(&_KeepBonding.CallOpts, _operator)
+}
+
+// BondAmount is a free data retrieval call binding the contract method 0x446f0f9e.
+//
+// Solidity: function bondAmount(address operator, address holder, uint256 referenceID) constant returns(uint256)
+func (_KeepBonding *KeepBondingCaller) BondAmount(opts *bind.CallOpts, operator common.Address, holder common.Address, referenceID *big.Int) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _KeepBonding.contract.Call(opts, out, "bondAmount", operator, holder, referenceID)
+	return *ret0, err
+}
+
+// BondAmount is a free data retrieval call binding the contract method 0x446f0f9e.
+//
+// Solidity: function bondAmount(address operator, address holder, uint256 referenceID) constant returns(uint256)
+func (_KeepBonding *KeepBondingSession) BondAmount(operator common.Address, holder common.Address, referenceID *big.Int) (*big.Int, error) {
+	return _KeepBonding.Contract.BondAmount(&_KeepBonding.CallOpts, operator, holder, referenceID)
+}
+
+// BondAmount is a free data retrieval call binding the contract method 0x446f0f9e.
+//
+// Solidity: function bondAmount(address operator, address holder, uint256 referenceID) constant returns(uint256)
+func (_KeepBonding *KeepBondingCallerSession) BondAmount(operator common.Address, holder common.Address, referenceID *big.Int) (*big.Int, error) {
+	return _KeepBonding.Contract.BondAmount(&_KeepBonding.CallOpts, operator, holder, referenceID)
+}
+
+// HasSecondaryAuthorization is a free data retrieval call binding the contract method 0x78f011c1.
+//
+// Solidity: function hasSecondaryAuthorization(address _operator, address _poolAddress) constant returns(bool)
+func (_KeepBonding *KeepBondingCaller) HasSecondaryAuthorization(opts *bind.CallOpts, _operator common.Address, _poolAddress common.Address) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _KeepBonding.contract.Call(opts, out, "hasSecondaryAuthorization", _operator, _poolAddress)
+	return *ret0, err
+}
+
+// HasSecondaryAuthorization is a free data retrieval call binding the contract method 0x78f011c1.
+//
+// Solidity: function hasSecondaryAuthorization(address _operator, address _poolAddress) constant returns(bool)
+func (_KeepBonding *KeepBondingSession) HasSecondaryAuthorization(_operator common.Address, _poolAddress common.Address) (bool, error) {
+	return _KeepBonding.Contract.HasSecondaryAuthorization(&_KeepBonding.CallOpts, _operator, _poolAddress)
+}
+
+// HasSecondaryAuthorization is a free data retrieval call binding the contract method 0x78f011c1.
+//
+// Solidity: function hasSecondaryAuthorization(address _operator, address _poolAddress) constant returns(bool)
+func (_KeepBonding *KeepBondingCallerSession) HasSecondaryAuthorization(_operator common.Address, _poolAddress common.Address) (bool, error) {
+	return _KeepBonding.Contract.HasSecondaryAuthorization(&_KeepBonding.CallOpts, _operator, _poolAddress)
+}
+
+// IsAuthorizedForOperator is a free data retrieval call binding the contract method 0xef1f9661.
+//
+// Solidity: function isAuthorizedForOperator(address _operator, address _operatorContract) constant returns(bool)
+func (_KeepBonding *KeepBondingCaller) IsAuthorizedForOperator(opts *bind.CallOpts, _operator common.Address, _operatorContract common.Address) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _KeepBonding.contract.Call(opts, out, "isAuthorizedForOperator", _operator, _operatorContract)
+	return *ret0, err
+}
+
+// IsAuthorizedForOperator is a free data retrieval call binding the contract method 0xef1f9661.
+//
+// Solidity: function isAuthorizedForOperator(address _operator, address _operatorContract) constant returns(bool)
+func (_KeepBonding *KeepBondingSession) IsAuthorizedForOperator(_operator common.Address, _operatorContract common.Address) (bool, error) {
+	return _KeepBonding.Contract.IsAuthorizedForOperator(&_KeepBonding.CallOpts, _operator, _operatorContract)
+}
+
+// IsAuthorizedForOperator is a free data retrieval call binding the contract method 0xef1f9661.
+//
+// Solidity: function isAuthorizedForOperator(address _operator, address _operatorContract) constant returns(bool)
+func (_KeepBonding *KeepBondingCallerSession) IsAuthorizedForOperator(_operator common.Address, _operatorContract common.Address) (bool, error) {
+	return _KeepBonding.Contract.IsAuthorizedForOperator(&_KeepBonding.CallOpts, _operator, _operatorContract)
+}
+
+// UnbondedValue is a free data retrieval call binding the contract method 0x5823cfad.
+//
+// Solidity: function unbondedValue(address ) constant returns(uint256)
+func (_KeepBonding *KeepBondingCaller) UnbondedValue(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _KeepBonding.contract.Call(opts, out, "unbondedValue", arg0)
+	return *ret0, err
+}
+
+// UnbondedValue is a free data retrieval call binding the contract method 0x5823cfad.
+//
+// Solidity: function unbondedValue(address ) constant returns(uint256)
+func (_KeepBonding *KeepBondingSession) UnbondedValue(arg0 common.Address) (*big.Int, error) {
+	return _KeepBonding.Contract.UnbondedValue(&_KeepBonding.CallOpts, arg0)
+}
+
+// UnbondedValue is a free data retrieval call binding the contract method 0x5823cfad.
+//
+// Solidity: function unbondedValue(address ) constant returns(uint256)
+func (_KeepBonding *KeepBondingCallerSession) UnbondedValue(arg0 common.Address) (*big.Int, error) {
+	return _KeepBonding.Contract.UnbondedValue(&_KeepBonding.CallOpts, arg0)
+}
+
+// AuthorizeSortitionPoolContract is a paid mutator transaction binding the contract method 0xc5786174.
+//
+// Solidity: function authorizeSortitionPoolContract(address _operator, address _poolAddress) returns()
+func (_KeepBonding *KeepBondingTransactor) AuthorizeSortitionPoolContract(opts *bind.TransactOpts, _operator common.Address, _poolAddress common.Address) (*types.Transaction, error) {
+	return _KeepBonding.contract.Transact(opts, "authorizeSortitionPoolContract", _operator, _poolAddress)
+}
+
+// AuthorizeSortitionPoolContract is a paid mutator transaction binding the contract method 0xc5786174.
+//
+// Solidity: function authorizeSortitionPoolContract(address _operator, address _poolAddress) returns()
+func (_KeepBonding *KeepBondingSession) AuthorizeSortitionPoolContract(_operator common.Address, _poolAddress common.Address) (*types.Transaction, error) {
+	return _KeepBonding.Contract.AuthorizeSortitionPoolContract(&_KeepBonding.TransactOpts, _operator, _poolAddress)
+}
+
+// AuthorizeSortitionPoolContract is a paid mutator transaction binding the contract method 0xc5786174.
+//
+// Solidity: function authorizeSortitionPoolContract(address _operator, address _poolAddress) returns()
+func (_KeepBonding *KeepBondingTransactorSession) AuthorizeSortitionPoolContract(_operator common.Address, _poolAddress common.Address) (*types.Transaction, error) {
+	return _KeepBonding.Contract.AuthorizeSortitionPoolContract(&_KeepBonding.TransactOpts, _operator, _poolAddress)
+}
+
+// CreateBond is a paid mutator transaction binding the contract method 0xd20a62fc.
+//
+// Solidity: function createBond(address operator, address holder, uint256 referenceID, uint256 amount, address authorizedSortitionPool) returns()
+func (_KeepBonding *KeepBondingTransactor) CreateBond(opts *bind.TransactOpts, operator common.Address, holder common.Address, referenceID *big.Int, amount *big.Int, authorizedSortitionPool common.Address) (*types.Transaction, error) {
+	return _KeepBonding.contract.Transact(opts, "createBond", operator, holder, referenceID, amount, authorizedSortitionPool)
+}
+
+// CreateBond is a paid mutator transaction binding the contract method 0xd20a62fc.
+//
+// Solidity: function createBond(address operator, address holder, uint256 referenceID, uint256 amount, address authorizedSortitionPool) returns()
+func (_KeepBonding *KeepBondingSession) CreateBond(operator common.Address, holder common.Address, referenceID *big.Int, amount *big.Int, authorizedSortitionPool common.Address) (*types.Transaction, error) {
+	return _KeepBonding.Contract.CreateBond(&_KeepBonding.TransactOpts, operator, holder, referenceID, amount, authorizedSortitionPool)
+}
+
+// CreateBond is a paid mutator transaction binding the contract method 0xd20a62fc.
+//
+// Solidity: function createBond(address operator, address holder, uint256 referenceID, uint256 amount, address authorizedSortitionPool) returns()
+func (_KeepBonding *KeepBondingTransactorSession) CreateBond(operator common.Address, holder common.Address, referenceID *big.Int, amount *big.Int, authorizedSortitionPool common.Address) (*types.Transaction, error) {
+	return _KeepBonding.Contract.CreateBond(&_KeepBonding.TransactOpts, operator, holder, referenceID, amount, authorizedSortitionPool)
+}
+
+// DeauthorizeSortitionPoolContract is a paid mutator transaction binding the contract method 0x0b102471.
+//
+// Solidity: function deauthorizeSortitionPoolContract(address _operator, address _poolAddress) returns()
+func (_KeepBonding *KeepBondingTransactor) DeauthorizeSortitionPoolContract(opts *bind.TransactOpts, _operator common.Address, _poolAddress common.Address) (*types.Transaction, error) {
+	return _KeepBonding.contract.Transact(opts, "deauthorizeSortitionPoolContract", _operator, _poolAddress)
+}
+
+// DeauthorizeSortitionPoolContract is a paid mutator transaction binding the contract method 0x0b102471.
+//
+// Solidity: function deauthorizeSortitionPoolContract(address _operator, address _poolAddress) returns()
+func (_KeepBonding *KeepBondingSession) DeauthorizeSortitionPoolContract(_operator common.Address, _poolAddress common.Address) (*types.Transaction, error) {
+	return _KeepBonding.Contract.DeauthorizeSortitionPoolContract(&_KeepBonding.TransactOpts, _operator, _poolAddress)
+}
+
+// DeauthorizeSortitionPoolContract is a paid mutator transaction binding the contract method 0x0b102471.
+//
+// Solidity: function deauthorizeSortitionPoolContract(address _operator, address _poolAddress) returns()
+func (_KeepBonding *KeepBondingTransactorSession) DeauthorizeSortitionPoolContract(_operator common.Address, _poolAddress common.Address) (*types.Transaction, error) {
+	return _KeepBonding.Contract.DeauthorizeSortitionPoolContract(&_KeepBonding.TransactOpts, _operator, _poolAddress)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xf340fa01.
+//
+// Solidity: function deposit(address operator) returns()
+func (_KeepBonding *KeepBondingTransactor) Deposit(opts *bind.TransactOpts, operator common.Address) (*types.Transaction, error) {
+	return _KeepBonding.contract.Transact(opts, "deposit", operator)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xf340fa01.
+//
+// Solidity: function deposit(address operator) returns()
+func (_KeepBonding *KeepBondingSession) Deposit(operator common.Address) (*types.Transaction, error) {
+	return _KeepBonding.Contract.Deposit(&_KeepBonding.TransactOpts, operator)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xf340fa01.
+//
+// Solidity: function deposit(address operator) returns()
+func (_KeepBonding *KeepBondingTransactorSession) Deposit(operator common.Address) (*types.Transaction, error) {
+	return _KeepBonding.Contract.Deposit(&_KeepBonding.TransactOpts, operator)
+}
+
+// FreeBond is a paid mutator transaction binding the contract method 0x7ab3cf93.
+//
+// Solidity: function freeBond(address operator, uint256 referenceID) returns()
+func (_KeepBonding *KeepBondingTransactor) FreeBond(opts *bind.TransactOpts, operator common.Address, referenceID *big.Int) (*types.Transaction, error) {
+	return _KeepBonding.contract.Transact(opts, "freeBond", operator, referenceID)
+}
+
+// FreeBond is a paid mutator transaction binding the contract method 0x7ab3cf93.
+//
+// Solidity: function freeBond(address operator, uint256 referenceID) returns()
+func (_KeepBonding *KeepBondingSession) FreeBond(operator common.Address, referenceID *big.Int) (*types.Transaction, error) {
+	return _KeepBonding.Contract.FreeBond(&_KeepBonding.TransactOpts, operator, referenceID)
+}
+
+// FreeBond is a paid mutator transaction binding the contract method 0x7ab3cf93.
+//
+// Solidity: function freeBond(address operator, uint256 referenceID) returns()
+func (_KeepBonding *KeepBondingTransactorSession) FreeBond(operator common.Address, referenceID *big.Int) (*types.Transaction, error) {
+	return _KeepBonding.Contract.FreeBond(&_KeepBonding.TransactOpts, operator, referenceID)
+}
+
+// ReassignBond is a paid mutator transaction binding the contract method 0x972f2457.
+//
+// Solidity: function reassignBond(address operator, uint256 referenceID, address newHolder, uint256 newReferenceID) returns()
+func (_KeepBonding *KeepBondingTransactor) ReassignBond(opts *bind.TransactOpts, operator common.Address, referenceID *big.Int, newHolder common.Address, newReferenceID *big.Int) (*types.Transaction, error) {
+	return _KeepBonding.contract.Transact(opts, "reassignBond", operator, referenceID, newHolder, newReferenceID)
+}
+
+// ReassignBond is a paid mutator transaction binding the contract method 0x972f2457.
+//
+// Solidity: function reassignBond(address operator, uint256 referenceID, address newHolder, uint256 newReferenceID) returns()
+func (_KeepBonding *KeepBondingSession) ReassignBond(operator common.Address, referenceID *big.Int, newHolder common.Address, newReferenceID *big.Int) (*types.Transaction, error) {
+	return _KeepBonding.Contract.ReassignBond(&_KeepBonding.TransactOpts, operator, referenceID, newHolder, newReferenceID)
+}
+
+// ReassignBond is a paid mutator transaction binding the contract method 0x972f2457.
+//
+// Solidity: function reassignBond(address operator, uint256 referenceID, address newHolder, uint256 newReferenceID) returns()
+func (_KeepBonding *KeepBondingTransactorSession) ReassignBond(operator common.Address, referenceID *big.Int, newHolder common.Address, newReferenceID *big.Int) (*types.Transaction, error) {
+	return _KeepBonding.Contract.ReassignBond(&_KeepBonding.TransactOpts, operator, referenceID, newHolder, newReferenceID)
+}
+
+// SeizeBond is a paid mutator transaction binding the contract method 0x0cb0a677.
+//
+// Solidity: function seizeBond(address operator, uint256 referenceID, uint256 amount, address destination) returns()
+func (_KeepBonding *KeepBondingTransactor) SeizeBond(opts *bind.TransactOpts, operator common.Address, referenceID *big.Int, amount *big.Int, destination common.Address) (*types.Transaction, error) {
+	return _KeepBonding.contract.Transact(opts, "seizeBond", operator, referenceID, amount, destination)
+}
+
+// SeizeBond is a paid mutator transaction binding the contract method 0x0cb0a677.
+//
+// Solidity: function seizeBond(address operator, uint256 referenceID, uint256 amount, address destination) returns()
+func (_KeepBonding *KeepBondingSession) SeizeBond(operator common.Address, referenceID *big.Int, amount *big.Int, destination common.Address) (*types.Transaction, error) {
+	return _KeepBonding.Contract.SeizeBond(&_KeepBonding.TransactOpts, operator, referenceID, amount, destination)
+}
+
+// SeizeBond is a paid mutator transaction binding the contract method 0x0cb0a677.
+//
+// Solidity: function seizeBond(address operator, uint256 referenceID, uint256 amount, address destination) returns()
+func (_KeepBonding *KeepBondingTransactorSession) SeizeBond(operator common.Address, referenceID *big.Int, amount *big.Int, destination common.Address) (*types.Transaction, error) {
+	return _KeepBonding.Contract.SeizeBond(&_KeepBonding.TransactOpts, operator, referenceID, amount, destination)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x00f714ce.
+//
+// Solidity: function withdraw(uint256 amount, address operator) returns()
+func (_KeepBonding *KeepBondingTransactor) Withdraw(opts *bind.TransactOpts, amount *big.Int, operator common.Address) (*types.Transaction, error) {
+	return _KeepBonding.contract.Transact(opts, "withdraw", amount, operator)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x00f714ce.
+//
+// Solidity: function withdraw(uint256 amount, address operator) returns()
+func (_KeepBonding *KeepBondingSession) Withdraw(amount *big.Int, operator common.Address) (*types.Transaction, error) {
+	return _KeepBonding.Contract.Withdraw(&_KeepBonding.TransactOpts, amount, operator)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x00f714ce.
+//
+// Solidity: function withdraw(uint256 amount, address operator) returns()
+func (_KeepBonding *KeepBondingTransactorSession) Withdraw(amount *big.Int, operator common.Address) (*types.Transaction, error) {
+	return _KeepBonding.Contract.Withdraw(&_KeepBonding.TransactOpts, amount, operator)
+}
+
+// WithdrawAsManagedGrantee is a paid mutator transaction binding the contract method 0x5fcac8ff.
+//
+// Solidity: function withdrawAsManagedGrantee(uint256 amount, address operator, address managedGrant) returns()
+func (_KeepBonding *KeepBondingTransactor) WithdrawAsManagedGrantee(opts *bind.TransactOpts, amount *big.Int, operator common.Address, managedGrant common.Address) (*types.Transaction, error) {
+	return _KeepBonding.contract.Transact(opts, "withdrawAsManagedGrantee", amount, operator, managedGrant)
+}
+
+// WithdrawAsManagedGrantee is a paid mutator transaction binding the contract method 0x5fcac8ff.
+//
+// Solidity: function withdrawAsManagedGrantee(uint256 amount, address operator, address managedGrant) returns()
+func (_KeepBonding *KeepBondingSession) WithdrawAsManagedGrantee(amount *big.Int, operator common.Address, managedGrant common.Address) (*types.Transaction, error) {
+	return _KeepBonding.Contract.WithdrawAsManagedGrantee(&_KeepBonding.TransactOpts, amount, operator, managedGrant)
+}
+
+// WithdrawAsManagedGrantee is a paid mutator transaction binding the contract method 0x5fcac8ff.
+//
+// Solidity: function withdrawAsManagedGrantee(uint256 amount, address operator, address managedGrant) returns()
+func (_KeepBonding *KeepBondingTransactorSession) WithdrawAsManagedGrantee(amount *big.Int, operator common.Address, managedGrant common.Address) (*types.Transaction, error) {
+	return _KeepBonding.Contract.WithdrawAsManagedGrantee(&_KeepBonding.TransactOpts, amount, operator, managedGrant)
+}
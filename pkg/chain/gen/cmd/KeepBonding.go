@@ -0,0 +1,1035 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated command and any manual changes will be lost.
+
+package cmd
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/blockcounter"
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+	"github.com/keep-network/keep-common/pkg/cmd"
+	"github.com/keep-network/keep-ecdsa/config"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/contract"
+
+	"github.com/urfave/cli"
+)
+
+var KeepBondingCommand cli.Command
+
+var keepBondingDescription = `The keep-bonding command allows calling the KeepBonding contract on an
+	Ethereum network. It has subcommands corresponding to each contract method,
+	which respectively each take parameters based on the contract method's
+	parameters.
+
+	Subcommands will submit a non-mutating call to the network and output the
+	result.
+
+	All subcommands can be called against a specific block by passing the
+	-b/--block flag.
+
+	All subcommands can be used to investigate the result of a previous
+	transaction that called that same method by passing the -t/--transaction
+	flag with the transaction hash.
+
+	Subcommands for mutating methods may be submitted as a mutating transaction
+	by passing the -s/--submit flag. In this mode, this command will terminate
+	successfully once the transaction has been submitted, but will not wait for
+	the transaction to be included in a block. They return the transaction hash.
+
+	Calls that require ether to be paid will get 0 ether by default, which can
+	be changed by passing the -v/--value flag.`
+
+func init() {
+	AvailableCommands = append(AvailableCommands, cli.Command{
+		Name:        "keep-bonding",
+		Usage:       `Provides access to the KeepBonding contract.`,
+		Description: keepBondingDescription,
+		Subcommands: []cli.Command{{
+			Name:      "available-unbonded-value",
+			Usage:     "Calls the constant method availableUnbondedValue on the KeepBonding contract.",
+			ArgsUsage: "[operator] [bondCreator] [authorizedSortitionPool] ",
+			Action:    kbAvailableUnbondedValue,
+			Before:    cmd.ArgCountChecker(3),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "bond-amount",
+			Usage:     "Calls the constant method bondAmount on the KeepBonding contract.",
+			ArgsUsage: "[operator] [holder] [referenceID] ",
+			Action:    kbBondAmount,
+			Before:    cmd.ArgCountChecker(3),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "has-secondary-authorization",
+			Usage:     "Calls the constant method hasSecondaryAuthorization on the KeepBonding contract.",
+			ArgsUsage: "[_operator] [_poolAddress] ",
+			Action:    kbHasSecondaryAuthorization,
+			Before:    cmd.ArgCountChecker(2),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "authorizer-of",
+			Usage:     "Calls the constant method authorizerOf on the KeepBonding contract.",
+			ArgsUsage: "[_operator] ",
+			Action:    kbAuthorizerOf,
+			Before:    cmd.ArgCountChecker(1),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "unbonded-value",
+			Usage:     "Calls the constant method unbondedValue on the KeepBonding contract.",
+			ArgsUsage: "[arg0] ",
+			Action:    kbUnbondedValue,
+			Before:    cmd.ArgCountChecker(1),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "beneficiary-of",
+			Usage:     "Calls the constant method beneficiaryOf on the KeepBonding contract.",
+			ArgsUsage: "[_operator] ",
+			Action:    kbBeneficiaryOf,
+			Before:    cmd.ArgCountChecker(1),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "is-authorized-for-operator",
+			Usage:     "Calls the constant method isAuthorizedForOperator on the KeepBonding contract.",
+			ArgsUsage: "[_operator] [_operatorContract] ",
+			Action:    kbIsAuthorizedForOperator,
+			Before:    cmd.ArgCountChecker(2),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "reassign-bond",
+			Usage:     "Calls the method reassignBond on the KeepBonding contract.",
+			ArgsUsage: "[operator] [referenceID] [newHolder] [newReferenceID] ",
+			Action:    kbReassignBond,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(4))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "withdraw",
+			Usage:     "Calls the method withdraw on the KeepBonding contract.",
+			ArgsUsage: "[amount] [operator] ",
+			Action:    kbWithdraw,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(2))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "authorize-sortition-pool-contract",
+			Usage:     "Calls the method authorizeSortitionPoolContract on the KeepBonding contract.",
+			ArgsUsage: "[_operator] [_poolAddress] ",
+			Action:    kbAuthorizeSortitionPoolContract,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(2))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "create-bond",
+			Usage:     "Calls the method createBond on the KeepBonding contract.",
+			ArgsUsage: "[operator] [holder] [referenceID] [amount] [authorizedSortitionPool] ",
+			Action:    kbCreateBond,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(5))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "deauthorize-sortition-pool-contract",
+			Usage:     "Calls the method deauthorizeSortitionPoolContract on the KeepBonding contract.",
+			ArgsUsage: "[_operator] [_poolAddress] ",
+			Action:    kbDeauthorizeSortitionPoolContract,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(2))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "deposit",
+			Usage:     "Calls the payable method deposit on the KeepBonding contract.",
+			ArgsUsage: "[operator] ",
+			Action:    kbDeposit,
+			Before:    cli.BeforeFunc(cmd.PayableArgsChecker.AndThen(cmd.ArgCountChecker(1))),
+			Flags:     cmd.PayableFlags,
+		}, {
+			Name:      "seize-bond",
+			Usage:     "Calls the method seizeBond on the KeepBonding contract.",
+			ArgsUsage: "[operator] [referenceID] [amount] [destination] ",
+			Action:    kbSeizeBond,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(4))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "withdraw-as-managed-grantee",
+			Usage:     "Calls the method withdrawAsManagedGrantee on the KeepBonding contract.",
+			ArgsUsage: "[amount] [operator] [managedGrant] ",
+			Action:    kbWithdrawAsManagedGrantee,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(3))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "free-bond",
+			Usage:     "Calls the method freeBond on the KeepBonding contract.",
+			ArgsUsage: "[operator] [referenceID] ",
+			Action:    kbFreeBond,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(2))),
+			Flags:     cmd.NonConstFlags,
+		}},
+	})
+}
+
+/// ------------------- Const methods -------------------
+
+func kbAvailableUnbondedValue(c *cli.Context) error {
+	contract, err := initializeKeepBonding(c)
+	if err != nil {
+		return err
+	}
+	operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	bondCreator, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter bondCreator, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	authorizedSortitionPool, err := ethutil.AddressFromHex(c.Args()[2])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter authorizedSortitionPool, a address, from passed value %v",
+			c.Args()[2],
+		)
+	}
+
+	result, err := contract.AvailableUnbondedValueAtBlock(
+		operator,
+		bondCreator,
+		authorizedSortitionPool,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func kbBondAmount(c *cli.Context) error {
+	contract, err := initializeKeepBonding(c)
+	if err != nil {
+		return err
+	}
+	operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	holder, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter holder, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	referenceID, err := hexutil.DecodeBig(c.Args()[2])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter referenceID, a uint256, from passed value %v",
+			c.Args()[2],
+		)
+	}
+
+	result, err := contract.BondAmountAtBlock(
+		operator,
+		holder,
+		referenceID,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func kbHasSecondaryAuthorization(c *cli.Context) error {
+	contract, err := initializeKeepBonding(c)
+	if err != nil {
+		return err
+	}
+	_operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	_poolAddress, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _poolAddress, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	result, err := contract.HasSecondaryAuthorizationAtBlock(
+		_operator,
+		_poolAddress,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func kbAuthorizerOf(c *cli.Context) error {
+	contract, err := initializeKeepBonding(c)
+	if err != nil {
+		return err
+	}
+	_operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	result, err := contract.AuthorizerOfAtBlock(
+		_operator,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func kbUnbondedValue(c *cli.Context) error {
+	contract, err := initializeKeepBonding(c)
+	if err != nil {
+		return err
+	}
+	arg0, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter arg0, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	result, err := contract.UnbondedValueAtBlock(
+		arg0,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func kbBeneficiaryOf(c *cli.Context) error {
+	contract, err := initializeKeepBonding(c)
+	if err != nil {
+		return err
+	}
+	_operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	result, err := contract.BeneficiaryOfAtBlock(
+		_operator,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func kbIsAuthorizedForOperator(c *cli.Context) error {
+	contract, err := initializeKeepBonding(c)
+	if err != nil {
+		return err
+	}
+	_operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	_operatorContract, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _operatorContract, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	result, err := contract.IsAuthorizedForOperatorAtBlock(
+		_operator,
+		_operatorContract,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+/// ------------------- Non-const methods -------------------
+
+func kbReassignBond(c *cli.Context) error {
+	contract, err := initializeKeepBonding(c)
+	if err != nil {
+		return err
+	}
+
+	operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	referenceID, err := hexutil.DecodeBig(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter referenceID, a uint256, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	newHolder, err := ethutil.AddressFromHex(c.Args()[2])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter newHolder, a address, from passed value %v",
+			c.Args()[2],
+		)
+	}
+
+	newReferenceID, err := hexutil.DecodeBig(c.Args()[3])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter newReferenceID, a uint256, from passed value %v",
+			c.Args()[3],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.ReassignBond(
+			operator,
+			referenceID,
+			newHolder,
+			newReferenceID,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallReassignBond(
+			operator,
+			referenceID,
+			newHolder,
+			newReferenceID,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func kbWithdraw(c *cli.Context) error {
+	contract, err := initializeKeepBonding(c)
+	if err != nil {
+		return err
+	}
+
+	amount, err := hexutil.DecodeBig(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter amount, a uint256, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	operator, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.Withdraw(
+			amount,
+			operator,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallWithdraw(
+			amount,
+			operator,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func kbAuthorizeSortitionPoolContract(c *cli.Context) error {
+	contract, err := initializeKeepBonding(c)
+	if err != nil {
+		return err
+	}
+
+	_operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	_poolAddress, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _poolAddress, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.AuthorizeSortitionPoolContract(
+			_operator,
+			_poolAddress,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallAuthorizeSortitionPoolContract(
+			_operator,
+			_poolAddress,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func kbCreateBond(c *cli.Context) error {
+	contract, err := initializeKeepBonding(c)
+	if err != nil {
+		return err
+	}
+
+	operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	holder, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter holder, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	referenceID, err := hexutil.DecodeBig(c.Args()[2])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter referenceID, a uint256, from passed value %v",
+			c.Args()[2],
+		)
+	}
+
+	amount, err := hexutil.DecodeBig(c.Args()[3])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter amount, a uint256, from passed value %v",
+			c.Args()[3],
+		)
+	}
+
+	authorizedSortitionPool, err := ethutil.AddressFromHex(c.Args()[4])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter authorizedSortitionPool, a address, from passed value %v",
+			c.Args()[4],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.CreateBond(
+			operator,
+			holder,
+			referenceID,
+			amount,
+			authorizedSortitionPool,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallCreateBond(
+			operator,
+			holder,
+			referenceID,
+			amount,
+			authorizedSortitionPool,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func kbDeauthorizeSortitionPoolContract(c *cli.Context) error {
+	contract, err := initializeKeepBonding(c)
+	if err != nil {
+		return err
+	}
+
+	_operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	_poolAddress, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _poolAddress, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.DeauthorizeSortitionPoolContract(
+			_operator,
+			_poolAddress,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallDeauthorizeSortitionPoolContract(
+			_operator,
+			_poolAddress,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func kbDeposit(c *cli.Context) error {
+	contract, err := initializeKeepBonding(c)
+	if err != nil {
+		return err
+	}
+
+	operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.Deposit(
+			operator,
+			cmd.ValueFlagValue.Uint)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallDeposit(
+			operator,
+			cmd.ValueFlagValue.Uint, cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func kbSeizeBond(c *cli.Context) error {
+	contract, err := initializeKeepBonding(c)
+	if err != nil {
+		return err
+	}
+
+	operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	referenceID, err := hexutil.DecodeBig(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter referenceID, a uint256, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	amount, err := hexutil.DecodeBig(c.Args()[2])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter amount, a uint256, from passed value %v",
+			c.Args()[2],
+		)
+	}
+
+	destination, err := ethutil.AddressFromHex(c.Args()[3])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter destination, a address, from passed value %v",
+			c.Args()[3],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.SeizeBond(
+			operator,
+			referenceID,
+			amount,
+			destination,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallSeizeBond(
+			operator,
+			referenceID,
+			amount,
+			destination,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func kbWithdrawAsManagedGrantee(c *cli.Context) error {
+	contract, err := initializeKeepBonding(c)
+	if err != nil {
+		return err
+	}
+
+	amount, err := hexutil.DecodeBig(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter amount, a uint256, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	operator, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	managedGrant, err := ethutil.AddressFromHex(c.Args()[2])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter managedGrant, a address, from passed value %v",
+			c.Args()[2],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.WithdrawAsManagedGrantee(
+			amount,
+			operator,
+			managedGrant,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallWithdrawAsManagedGrantee(
+			amount,
+			operator,
+			managedGrant,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func kbFreeBond(c *cli.Context) error {
+	contract, err := initializeKeepBonding(c)
+	if err != nil {
+		return err
+	}
+
+	operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	referenceID, err := hexutil.DecodeBig(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter referenceID, a uint256, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.FreeBond(
+			operator,
+			referenceID,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallFreeBond(
+			operator,
+			referenceID,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+/// ------------------- Initialization -------------------
+
+func initializeKeepBonding(c *cli.Context) (*contract.KeepBonding, error) {
+	config, err := config.ReadEthereumConfig(c.GlobalString("config"))
+	if err != nil {
+		return nil, fmt.Errorf("error reading Ethereum config from file: [%v]", err)
+	}
+
+	client, _, _, err := ethutil.ConnectClients(config.URL, config.URLRPC)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to Ethereum node: [%v]", err)
+	}
+
+	key, err := ethutil.DecryptKeyFile(
+		config.Account.KeyFile,
+		config.Account.KeyFilePassword,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to read KeyFile: %s: [%v]",
+			config.Account.KeyFile,
+			err,
+		)
+	}
+
+	checkInterval := cmd.DefaultMiningCheckInterval
+	maxGasPrice := cmd.DefaultMaxGasPrice
+	if config.MiningCheckInterval != 0 {
+		checkInterval = time.Duration(config.MiningCheckInterval) * time.Second
+	}
+	if config.MaxGasPrice != nil {
+		maxGasPrice = config.MaxGasPrice.Int
+	}
+
+	miningWaiter := ethutil.NewMiningWaiter(client, checkInterval, maxGasPrice)
+
+	blockCounter, err := blockcounter.CreateBlockCounter(client)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to create Ethereum blockcounter: [%v]",
+			err,
+		)
+	}
+
+	address := common.HexToAddress(config.ContractAddresses["KeepBonding"])
+
+	return contract.NewKeepBonding(
+		address,
+		key,
+		client,
+		ethutil.NewNonceManager(key.Address, client),
+		miningWaiter,
+		blockCounter,
+		&sync.Mutex{},
+	)
+}
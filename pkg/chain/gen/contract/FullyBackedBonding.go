@@ -0,0 +1,3951 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contract
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	ethereumabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/ipfs/go-log"
+
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/blockcounter"
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+	"github.com/keep-network/keep-common/pkg/subscription"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/abi"
+)
+
+// Create a package-level logger for this contract. The logger exists at
+// package level so that the logger is registered at startup and can be
+// included or excluded from logging at startup by name.
+var fbbLogger = log.Logger("keep-contract-FullyBackedBonding")
+
+type FullyBackedBonding struct {
+	contract          *abi.FullyBackedBonding
+	contractAddress   common.Address
+	contractABI       *ethereumabi.ABI
+	caller            bind.ContractCaller
+	transactor        bind.ContractTransactor
+	callerOptions     *bind.CallOpts
+	transactorOptions *bind.TransactOpts
+	errorResolver     *ethutil.ErrorResolver
+	nonceManager      *ethutil.NonceManager
+	miningWaiter      *ethutil.MiningWaiter
+	blockCounter      *blockcounter.EthereumBlockCounter
+
+	transactionMutex *sync.Mutex
+}
+
+func NewFullyBackedBonding(
+	contractAddress common.Address,
+	accountKey *keystore.Key,
+	backend bind.ContractBackend,
+	nonceManager *ethutil.NonceManager,
+	miningWaiter *ethutil.MiningWaiter,
+	blockCounter *blockcounter.EthereumBlockCounter,
+	transactionMutex *sync.Mutex,
+) (*FullyBackedBonding, error) {
+	callerOptions := &bind.CallOpts{
+		From: accountKey.Address,
+	}
+
+	transactorOptions := bind.NewKeyedTransactor(
+		accountKey.PrivateKey,
+	)
+
+	randomBeaconContract, err := abi.NewFullyBackedBonding(
+		contractAddress,
+		backend,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to instantiate contract at address: %s [%v]",
+			contractAddress.String(),
+			err,
+		)
+	}
+
+	contractABI, err := ethereumabi.JSON(strings.NewReader(abi.FullyBackedBondingABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate ABI: [%v]", err)
+	}
+
+	return &FullyBackedBonding{
+		contract:          randomBeaconContract,
+		contractAddress:   contractAddress,
+		contractABI:       &contractABI,
+		caller:            backend,
+		transactor:        backend,
+		callerOptions:     callerOptions,
+		transactorOptions: transactorOptions,
+		errorResolver:     ethutil.NewErrorResolver(backend, &contractABI, &contractAddress),
+		nonceManager:      nonceManager,
+		miningWaiter:      miningWaiter,
+		blockCounter:      blockCounter,
+		transactionMutex:  transactionMutex,
+	}, nil
+}
+
+// ----- Non-const Methods ------
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) AuthorizeSortitionPoolContract(
+	_operator common.Address,
+	_poolAddress common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction authorizeSortitionPoolContract",
+		"params: ",
+		fmt.Sprint(
+			_operator,
+			_poolAddress,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.AuthorizeSortitionPoolContract(
+		transactorOptions,
+		_operator,
+		_poolAddress,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"authorizeSortitionPoolContract",
+			_operator,
+			_poolAddress,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction authorizeSortitionPoolContract with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.AuthorizeSortitionPoolContract(
+				transactorOptions,
+				_operator,
+				_poolAddress,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"authorizeSortitionPoolContract",
+					_operator,
+					_poolAddress,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction authorizeSortitionPoolContract with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallAuthorizeSortitionPoolContract(
+	_operator common.Address,
+	_poolAddress common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"authorizeSortitionPoolContract",
+		&result,
+		_operator,
+		_poolAddress,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) AuthorizeSortitionPoolContractGasEstimate(
+	_operator common.Address,
+	_poolAddress common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"authorizeSortitionPoolContract",
+		fbb.contractABI,
+		fbb.transactor,
+		_operator,
+		_poolAddress,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) Delegate(
+	operator common.Address,
+	beneficiary common.Address,
+	authorizer common.Address,
+	value *big.Int,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction delegate",
+		"params: ",
+		fmt.Sprint(
+			operator,
+			beneficiary,
+			authorizer,
+		),
+		"value: ", value,
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	transactorOptions.Value = value
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.Delegate(
+		transactorOptions,
+		operator,
+		beneficiary,
+		authorizer,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			value,
+			"delegate",
+			operator,
+			beneficiary,
+			authorizer,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction delegate with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.Delegate(
+				transactorOptions,
+				operator,
+				beneficiary,
+				authorizer,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					value,
+					"delegate",
+					operator,
+					beneficiary,
+					authorizer,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction delegate with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallDelegate(
+	operator common.Address,
+	beneficiary common.Address,
+	authorizer common.Address,
+	value *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, value,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"delegate",
+		&result,
+		operator,
+		beneficiary,
+		authorizer,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) DelegateGasEstimate(
+	operator common.Address,
+	beneficiary common.Address,
+	authorizer common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"delegate",
+		fbb.contractABI,
+		fbb.transactor,
+		operator,
+		beneficiary,
+		authorizer,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) Deposit(
+	operator common.Address,
+	value *big.Int,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction deposit",
+		"params: ",
+		fmt.Sprint(
+			operator,
+		),
+		"value: ", value,
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	transactorOptions.Value = value
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.Deposit(
+		transactorOptions,
+		operator,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			value,
+			"deposit",
+			operator,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction deposit with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.Deposit(
+				transactorOptions,
+				operator,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					value,
+					"deposit",
+					operator,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction deposit with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallDeposit(
+	operator common.Address,
+	value *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, value,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"deposit",
+		&result,
+		operator,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) DepositGasEstimate(
+	operator common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"deposit",
+		fbb.contractABI,
+		fbb.transactor,
+		operator,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) ReassignBond(
+	operator common.Address,
+	referenceID *big.Int,
+	newHolder common.Address,
+	newReferenceID *big.Int,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction reassignBond",
+		"params: ",
+		fmt.Sprint(
+			operator,
+			referenceID,
+			newHolder,
+			newReferenceID,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.ReassignBond(
+		transactorOptions,
+		operator,
+		referenceID,
+		newHolder,
+		newReferenceID,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"reassignBond",
+			operator,
+			referenceID,
+			newHolder,
+			newReferenceID,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction reassignBond with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.ReassignBond(
+				transactorOptions,
+				operator,
+				referenceID,
+				newHolder,
+				newReferenceID,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"reassignBond",
+					operator,
+					referenceID,
+					newHolder,
+					newReferenceID,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction reassignBond with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallReassignBond(
+	operator common.Address,
+	referenceID *big.Int,
+	newHolder common.Address,
+	newReferenceID *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"reassignBond",
+		&result,
+		operator,
+		referenceID,
+		newHolder,
+		newReferenceID,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) ReassignBondGasEstimate(
+	operator common.Address,
+	referenceID *big.Int,
+	newHolder common.Address,
+	newReferenceID *big.Int,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"reassignBond",
+		fbb.contractABI,
+		fbb.transactor,
+		operator,
+		referenceID,
+		newHolder,
+		newReferenceID,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) CreateBond(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	authorizedSortitionPool common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction createBond",
+		"params: ",
+		fmt.Sprint(
+			operator,
+			holder,
+			referenceID,
+			amount,
+			authorizedSortitionPool,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.CreateBond(
+		transactorOptions,
+		operator,
+		holder,
+		referenceID,
+		amount,
+		authorizedSortitionPool,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"createBond",
+			operator,
+			holder,
+			referenceID,
+			amount,
+			authorizedSortitionPool,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction createBond with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.CreateBond(
+				transactorOptions,
+				operator,
+				holder,
+				referenceID,
+				amount,
+				authorizedSortitionPool,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"createBond",
+					operator,
+					holder,
+					referenceID,
+					amount,
+					authorizedSortitionPool,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction createBond with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallCreateBond(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	authorizedSortitionPool common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"createBond",
+		&result,
+		operator,
+		holder,
+		referenceID,
+		amount,
+		authorizedSortitionPool,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) CreateBondGasEstimate(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	authorizedSortitionPool common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"createBond",
+		fbb.contractABI,
+		fbb.transactor,
+		operator,
+		holder,
+		referenceID,
+		amount,
+		authorizedSortitionPool,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) DeauthorizeSortitionPoolContract(
+	_operator common.Address,
+	_poolAddress common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction deauthorizeSortitionPoolContract",
+		"params: ",
+		fmt.Sprint(
+			_operator,
+			_poolAddress,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.DeauthorizeSortitionPoolContract(
+		transactorOptions,
+		_operator,
+		_poolAddress,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"deauthorizeSortitionPoolContract",
+			_operator,
+			_poolAddress,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction deauthorizeSortitionPoolContract with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.DeauthorizeSortitionPoolContract(
+				transactorOptions,
+				_operator,
+				_poolAddress,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"deauthorizeSortitionPoolContract",
+					_operator,
+					_poolAddress,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction deauthorizeSortitionPoolContract with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallDeauthorizeSortitionPoolContract(
+	_operator common.Address,
+	_poolAddress common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"deauthorizeSortitionPoolContract",
+		&result,
+		_operator,
+		_poolAddress,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) DeauthorizeSortitionPoolContractGasEstimate(
+	_operator common.Address,
+	_poolAddress common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"deauthorizeSortitionPoolContract",
+		fbb.contractABI,
+		fbb.transactor,
+		_operator,
+		_poolAddress,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) SeizeBond(
+	operator common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	destination common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction seizeBond",
+		"params: ",
+		fmt.Sprint(
+			operator,
+			referenceID,
+			amount,
+			destination,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.SeizeBond(
+		transactorOptions,
+		operator,
+		referenceID,
+		amount,
+		destination,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"seizeBond",
+			operator,
+			referenceID,
+			amount,
+			destination,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction seizeBond with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.SeizeBond(
+				transactorOptions,
+				operator,
+				referenceID,
+				amount,
+				destination,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"seizeBond",
+					operator,
+					referenceID,
+					amount,
+					destination,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction seizeBond with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallSeizeBond(
+	operator common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	destination common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"seizeBond",
+		&result,
+		operator,
+		referenceID,
+		amount,
+		destination,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) SeizeBondGasEstimate(
+	operator common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	destination common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"seizeBond",
+		fbb.contractABI,
+		fbb.transactor,
+		operator,
+		referenceID,
+		amount,
+		destination,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) FreeBond(
+	operator common.Address,
+	referenceID *big.Int,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction freeBond",
+		"params: ",
+		fmt.Sprint(
+			operator,
+			referenceID,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.FreeBond(
+		transactorOptions,
+		operator,
+		referenceID,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"freeBond",
+			operator,
+			referenceID,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction freeBond with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.FreeBond(
+				transactorOptions,
+				operator,
+				referenceID,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"freeBond",
+					operator,
+					referenceID,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction freeBond with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallFreeBond(
+	operator common.Address,
+	referenceID *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"freeBond",
+		&result,
+		operator,
+		referenceID,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) FreeBondGasEstimate(
+	operator common.Address,
+	referenceID *big.Int,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"freeBond",
+		fbb.contractABI,
+		fbb.transactor,
+		operator,
+		referenceID,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) TopUp(
+	operator common.Address,
+	value *big.Int,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction topUp",
+		"params: ",
+		fmt.Sprint(
+			operator,
+		),
+		"value: ", value,
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	transactorOptions.Value = value
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.TopUp(
+		transactorOptions,
+		operator,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			value,
+			"topUp",
+			operator,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction topUp with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.TopUp(
+				transactorOptions,
+				operator,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					value,
+					"topUp",
+					operator,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction topUp with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallTopUp(
+	operator common.Address,
+	value *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, value,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"topUp",
+		&result,
+		operator,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) TopUpGasEstimate(
+	operator common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"topUp",
+		fbb.contractABI,
+		fbb.transactor,
+		operator,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) Withdraw(
+	amount *big.Int,
+	operator common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction withdraw",
+		"params: ",
+		fmt.Sprint(
+			amount,
+			operator,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.Withdraw(
+		transactorOptions,
+		amount,
+		operator,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"withdraw",
+			amount,
+			operator,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction withdraw with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.Withdraw(
+				transactorOptions,
+				amount,
+				operator,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"withdraw",
+					amount,
+					operator,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction withdraw with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallWithdraw(
+	amount *big.Int,
+	operator common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"withdraw",
+		&result,
+		amount,
+		operator,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) WithdrawGasEstimate(
+	amount *big.Int,
+	operator common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"withdraw",
+		fbb.contractABI,
+		fbb.transactor,
+		amount,
+		operator,
+	)
+
+	return result, err
+}
+
+// ----- Const Methods ------
+
+func (fbb *FullyBackedBonding) MINIMUMDELEGATIONDEPOSIT() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbb.contract.MINIMUMDELEGATIONDEPOSIT(
+		fbb.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"mINIMUMDELEGATIONDEPOSIT",
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) MINIMUMDELEGATIONDEPOSITAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"mINIMUMDELEGATIONDEPOSIT",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) BeneficiaryOf(
+	_operator common.Address,
+) (common.Address, error) {
+	var result common.Address
+	result, err := fbb.contract.BeneficiaryOf(
+		fbb.callerOptions,
+		_operator,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"beneficiaryOf",
+			_operator,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) BeneficiaryOfAtBlock(
+	_operator common.Address,
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"beneficiaryOf",
+		&result,
+		_operator,
+	)
+
+	return result, err
+}
+
+type DelegationInfo struct {
+	CreatedAt     *big.Int
+	UndelegatedAt *big.Int
+}
+
+func (fbb *FullyBackedBonding) GetDelegationInfo(
+	operator common.Address,
+) (DelegationInfo, error) {
+	var result DelegationInfo
+	result, err := fbb.contract.GetDelegationInfo(
+		fbb.callerOptions,
+		operator,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"getDelegationInfo",
+			operator,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) GetDelegationInfoAtBlock(
+	operator common.Address,
+	blockNumber *big.Int,
+) (DelegationInfo, error) {
+	var result DelegationInfo
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"getDelegationInfo",
+		&result,
+		operator,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) DELEGATIONLOCKPERIOD() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbb.contract.DELEGATIONLOCKPERIOD(
+		fbb.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"dELEGATIONLOCKPERIOD",
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) DELEGATIONLOCKPERIODAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"dELEGATIONLOCKPERIOD",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) BondAmount(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := fbb.contract.BondAmount(
+		fbb.callerOptions,
+		operator,
+		holder,
+		referenceID,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"bondAmount",
+			operator,
+			holder,
+			referenceID,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) BondAmountAtBlock(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"bondAmount",
+		&result,
+		operator,
+		holder,
+		referenceID,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) IsAuthorizedForOperator(
+	_operator common.Address,
+	_operatorContract common.Address,
+) (bool, error) {
+	var result bool
+	result, err := fbb.contract.IsAuthorizedForOperator(
+		fbb.callerOptions,
+		_operator,
+		_operatorContract,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"isAuthorizedForOperator",
+			_operator,
+			_operatorContract,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) IsAuthorizedForOperatorAtBlock(
+	_operator common.Address,
+	_operatorContract common.Address,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"isAuthorizedForOperator",
+		&result,
+		_operator,
+		_operatorContract,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) AvailableUnbondedValue(
+	operator common.Address,
+	bondCreator common.Address,
+	authorizedSortitionPool common.Address,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := fbb.contract.AvailableUnbondedValue(
+		fbb.callerOptions,
+		operator,
+		bondCreator,
+		authorizedSortitionPool,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"availableUnbondedValue",
+			operator,
+			bondCreator,
+			authorizedSortitionPool,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) AvailableUnbondedValueAtBlock(
+	operator common.Address,
+	bondCreator common.Address,
+	authorizedSortitionPool common.Address,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"availableUnbondedValue",
+		&result,
+		operator,
+		bondCreator,
+		authorizedSortitionPool,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) InitializationPeriod() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbb.contract.InitializationPeriod(
+		fbb.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"initializationPeriod",
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) InitializationPeriodAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"initializationPeriod",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) UnbondedValue(
+	arg0 common.Address,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := fbb.contract.UnbondedValue(
+		fbb.callerOptions,
+		arg0,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"unbondedValue",
+			arg0,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) UnbondedValueAtBlock(
+	arg0 common.Address,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"unbondedValue",
+		&result,
+		arg0,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) AuthorizerOf(
+	_operator common.Address,
+) (common.Address, error) {
+	var result common.Address
+	result, err := fbb.contract.AuthorizerOf(
+		fbb.callerOptions,
+		_operator,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"authorizerOf",
+			_operator,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) AuthorizerOfAtBlock(
+	_operator common.Address,
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"authorizerOf",
+		&result,
+		_operator,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) IsInitialized(
+	operator common.Address,
+	bondCreator common.Address,
+) (bool, error) {
+	var result bool
+	result, err := fbb.contract.IsInitialized(
+		fbb.callerOptions,
+		operator,
+		bondCreator,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"isInitialized",
+			operator,
+			bondCreator,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) IsInitializedAtBlock(
+	operator common.Address,
+	bondCreator common.Address,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"isInitialized",
+		&result,
+		operator,
+		bondCreator,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) HasSecondaryAuthorization(
+	_operator common.Address,
+	_poolAddress common.Address,
+) (bool, error) {
+	var result bool
+	result, err := fbb.contract.HasSecondaryAuthorization(
+		fbb.callerOptions,
+		_operator,
+		_poolAddress,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"hasSecondaryAuthorization",
+			_operator,
+			_poolAddress,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) HasSecondaryAuthorizationAtBlock(
+	_operator common.Address,
+	_poolAddress common.Address,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"hasSecondaryAuthorization",
+		&result,
+		_operator,
+		_poolAddress,
+	)
+
+	return result, err
+}
+
+// ------ Events -------
+
+func (fbb *FullyBackedBonding) BondReassigned(
+	opts *ethutil.SubscribeOpts,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) *BondReassignedSubscription {
+	if opts == nil {
+		opts = new(ethutil.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = ethutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = ethutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &BondReassignedSubscription{
+		fbb,
+		opts,
+		operatorFilter,
+		referenceIDFilter,
+	}
+}
+
+type BondReassignedSubscription struct {
+	contract          *FullyBackedBonding
+	opts              *ethutil.SubscribeOpts
+	operatorFilter    []common.Address
+	referenceIDFilter []*big.Int
+}
+
+type fullyBackedBondingBondReassignedFunc func(
+	Operator common.Address,
+	ReferenceID *big.Int,
+	NewHolder common.Address,
+	NewReferenceID *big.Int,
+	blockNumber uint64,
+)
+
+func (brs *BondReassignedSubscription) OnEvent(
+	handler fullyBackedBondingBondReassignedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedBondingBondReassigned)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.ReferenceID,
+					event.NewHolder,
+					event.NewReferenceID,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := brs.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (brs *BondReassignedSubscription) Pipe(
+	sink chan *abi.FullyBackedBondingBondReassigned,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(brs.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := brs.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - brs.opts.PastBlocks
+
+				fbbLogger.Infof(
+					"subscription monitoring fetching past BondReassigned events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := brs.contract.PastBondReassignedEvents(
+					fromBlock,
+					nil,
+					brs.operatorFilter,
+					brs.referenceIDFilter,
+				)
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbbLogger.Infof(
+					"subscription monitoring fetched [%v] past BondReassigned events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := brs.contract.watchBondReassigned(
+		sink,
+		brs.operatorFilter,
+		brs.referenceIDFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbb *FullyBackedBonding) watchBondReassigned(
+	sink chan *abi.FullyBackedBondingBondReassigned,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbb.contract.WatchBondReassigned(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+			referenceIDFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbbLogger.Errorf(
+			"subscription to event BondReassigned had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"Ethereum connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbbLogger.Errorf(
+			"subscription to event BondReassigned failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return ethutil.WithResubscription(
+		ethutil.SubscriptionBackoffMax,
+		subscribeFn,
+		ethutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbb *FullyBackedBonding) PastBondReassignedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) ([]*abi.FullyBackedBondingBondReassigned, error) {
+	iterator, err := fbb.contract.FilterBondReassigned(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		referenceIDFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past BondReassigned events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingBondReassigned, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) Delegated(
+	opts *ethutil.SubscribeOpts,
+	ownerFilter []common.Address,
+	operatorFilter []common.Address,
+) *DelegatedSubscription {
+	if opts == nil {
+		opts = new(ethutil.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = ethutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = ethutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &DelegatedSubscription{
+		fbb,
+		opts,
+		ownerFilter,
+		operatorFilter,
+	}
+}
+
+type DelegatedSubscription struct {
+	contract       *FullyBackedBonding
+	opts           *ethutil.SubscribeOpts
+	ownerFilter    []common.Address
+	operatorFilter []common.Address
+}
+
+type fullyBackedBondingDelegatedFunc func(
+	Owner common.Address,
+	Operator common.Address,
+	blockNumber uint64,
+)
+
+func (ds *DelegatedSubscription) OnEvent(
+	handler fullyBackedBondingDelegatedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedBondingDelegated)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Owner,
+					event.Operator,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := ds.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (ds *DelegatedSubscription) Pipe(
+	sink chan *abi.FullyBackedBondingDelegated,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(ds.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := ds.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - ds.opts.PastBlocks
+
+				fbbLogger.Infof(
+					"subscription monitoring fetching past Delegated events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := ds.contract.PastDelegatedEvents(
+					fromBlock,
+					nil,
+					ds.ownerFilter,
+					ds.operatorFilter,
+				)
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbbLogger.Infof(
+					"subscription monitoring fetched [%v] past Delegated events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := ds.contract.watchDelegated(
+		sink,
+		ds.ownerFilter,
+		ds.operatorFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbb *FullyBackedBonding) watchDelegated(
+	sink chan *abi.FullyBackedBondingDelegated,
+	ownerFilter []common.Address,
+	operatorFilter []common.Address,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbb.contract.WatchDelegated(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			ownerFilter,
+			operatorFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbbLogger.Errorf(
+			"subscription to event Delegated had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"Ethereum connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbbLogger.Errorf(
+			"subscription to event Delegated failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return ethutil.WithResubscription(
+		ethutil.SubscriptionBackoffMax,
+		subscribeFn,
+		ethutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbb *FullyBackedBonding) PastDelegatedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	ownerFilter []common.Address,
+	operatorFilter []common.Address,
+) ([]*abi.FullyBackedBondingDelegated, error) {
+	iterator, err := fbb.contract.FilterDelegated(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		ownerFilter,
+		operatorFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past Delegated events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingDelegated, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) OperatorDelegated(
+	opts *ethutil.SubscribeOpts,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+	authorizerFilter []common.Address,
+) *OperatorDelegatedSubscription {
+	if opts == nil {
+		opts = new(ethutil.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = ethutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = ethutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &OperatorDelegatedSubscription{
+		fbb,
+		opts,
+		operatorFilter,
+		beneficiaryFilter,
+		authorizerFilter,
+	}
+}
+
+type OperatorDelegatedSubscription struct {
+	contract          *FullyBackedBonding
+	opts              *ethutil.SubscribeOpts
+	operatorFilter    []common.Address
+	beneficiaryFilter []common.Address
+	authorizerFilter  []common.Address
+}
+
+type fullyBackedBondingOperatorDelegatedFunc func(
+	Operator common.Address,
+	Beneficiary common.Address,
+	Authorizer common.Address,
+	Value *big.Int,
+	blockNumber uint64,
+)
+
+func (ods *OperatorDelegatedSubscription) OnEvent(
+	handler fullyBackedBondingOperatorDelegatedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedBondingOperatorDelegated)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.Beneficiary,
+					event.Authorizer,
+					event.Value,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := ods.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (ods *OperatorDelegatedSubscription) Pipe(
+	sink chan *abi.FullyBackedBondingOperatorDelegated,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(ods.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := ods.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - ods.opts.PastBlocks
+
+				fbbLogger.Infof(
+					"subscription monitoring fetching past OperatorDelegated events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := ods.contract.PastOperatorDelegatedEvents(
+					fromBlock,
+					nil,
+					ods.operatorFilter,
+					ods.beneficiaryFilter,
+					ods.authorizerFilter,
+				)
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbbLogger.Infof(
+					"subscription monitoring fetched [%v] past OperatorDelegated events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := ods.contract.watchOperatorDelegated(
+		sink,
+		ods.operatorFilter,
+		ods.beneficiaryFilter,
+		ods.authorizerFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbb *FullyBackedBonding) watchOperatorDelegated(
+	sink chan *abi.FullyBackedBondingOperatorDelegated,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+	authorizerFilter []common.Address,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbb.contract.WatchOperatorDelegated(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+			beneficiaryFilter,
+			authorizerFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbbLogger.Errorf(
+			"subscription to event OperatorDelegated had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"Ethereum connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbbLogger.Errorf(
+			"subscription to event OperatorDelegated failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return ethutil.WithResubscription(
+		ethutil.SubscriptionBackoffMax,
+		subscribeFn,
+		ethutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbb *FullyBackedBonding) PastOperatorDelegatedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+	authorizerFilter []common.Address,
+) ([]*abi.FullyBackedBondingOperatorDelegated, error) {
+	iterator, err := fbb.contract.FilterOperatorDelegated(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		beneficiaryFilter,
+		authorizerFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past OperatorDelegated events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingOperatorDelegated, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) OperatorToppedUp(
+	opts *ethutil.SubscribeOpts,
+	operatorFilter []common.Address,
+) *OperatorToppedUpSubscription {
+	if opts == nil {
+		opts = new(ethutil.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = ethutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = ethutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &OperatorToppedUpSubscription{
+		fbb,
+		opts,
+		operatorFilter,
+	}
+}
+
+type OperatorToppedUpSubscription struct {
+	contract       *FullyBackedBonding
+	opts           *ethutil.SubscribeOpts
+	operatorFilter []common.Address
+}
+
+type fullyBackedBondingOperatorToppedUpFunc func(
+	Operator common.Address,
+	Value *big.Int,
+	blockNumber uint64,
+)
+
+func (otus *OperatorToppedUpSubscription) OnEvent(
+	handler fullyBackedBondingOperatorToppedUpFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedBondingOperatorToppedUp)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.Value,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := otus.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (otus *OperatorToppedUpSubscription) Pipe(
+	sink chan *abi.FullyBackedBondingOperatorToppedUp,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(otus.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := otus.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - otus.opts.PastBlocks
+
+				fbbLogger.Infof(
+					"subscription monitoring fetching past OperatorToppedUp events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := otus.contract.PastOperatorToppedUpEvents(
+					fromBlock,
+					nil,
+					otus.operatorFilter,
+				)
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbbLogger.Infof(
+					"subscription monitoring fetched [%v] past OperatorToppedUp events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := otus.contract.watchOperatorToppedUp(
+		sink,
+		otus.operatorFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbb *FullyBackedBonding) watchOperatorToppedUp(
+	sink chan *abi.FullyBackedBondingOperatorToppedUp,
+	operatorFilter []common.Address,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbb.contract.WatchOperatorToppedUp(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbbLogger.Errorf(
+			"subscription to event OperatorToppedUp had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"Ethereum connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbbLogger.Errorf(
+			"subscription to event OperatorToppedUp failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return ethutil.WithResubscription(
+		ethutil.SubscriptionBackoffMax,
+		subscribeFn,
+		ethutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbb *FullyBackedBonding) PastOperatorToppedUpEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+) ([]*abi.FullyBackedBondingOperatorToppedUp, error) {
+	iterator, err := fbb.contract.FilterOperatorToppedUp(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past OperatorToppedUp events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingOperatorToppedUp, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) UnbondedValueDeposited(
+	opts *ethutil.SubscribeOpts,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) *UnbondedValueDepositedSubscription {
+	if opts == nil {
+		opts = new(ethutil.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = ethutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = ethutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &UnbondedValueDepositedSubscription{
+		fbb,
+		opts,
+		operatorFilter,
+		beneficiaryFilter,
+	}
+}
+
+type UnbondedValueDepositedSubscription struct {
+	contract          *FullyBackedBonding
+	opts              *ethutil.SubscribeOpts
+	operatorFilter    []common.Address
+	beneficiaryFilter []common.Address
+}
+
+type fullyBackedBondingUnbondedValueDepositedFunc func(
+	Operator common.Address,
+	Beneficiary common.Address,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (uvds *UnbondedValueDepositedSubscription) OnEvent(
+	handler fullyBackedBondingUnbondedValueDepositedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedBondingUnbondedValueDeposited)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.Beneficiary,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := uvds.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (uvds *UnbondedValueDepositedSubscription) Pipe(
+	sink chan *abi.FullyBackedBondingUnbondedValueDeposited,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(uvds.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := uvds.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - uvds.opts.PastBlocks
+
+				fbbLogger.Infof(
+					"subscription monitoring fetching past UnbondedValueDeposited events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := uvds.contract.PastUnbondedValueDepositedEvents(
+					fromBlock,
+					nil,
+					uvds.operatorFilter,
+					uvds.beneficiaryFilter,
+				)
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbbLogger.Infof(
+					"subscription monitoring fetched [%v] past UnbondedValueDeposited events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := uvds.contract.watchUnbondedValueDeposited(
+		sink,
+		uvds.operatorFilter,
+		uvds.beneficiaryFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbb *FullyBackedBonding) watchUnbondedValueDeposited(
+	sink chan *abi.FullyBackedBondingUnbondedValueDeposited,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbb.contract.WatchUnbondedValueDeposited(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+			beneficiaryFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbbLogger.Errorf(
+			"subscription to event UnbondedValueDeposited had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"Ethereum connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbbLogger.Errorf(
+			"subscription to event UnbondedValueDeposited failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return ethutil.WithResubscription(
+		ethutil.SubscriptionBackoffMax,
+		subscribeFn,
+		ethutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbb *FullyBackedBonding) PastUnbondedValueDepositedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) ([]*abi.FullyBackedBondingUnbondedValueDeposited, error) {
+	iterator, err := fbb.contract.FilterUnbondedValueDeposited(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		beneficiaryFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past UnbondedValueDeposited events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingUnbondedValueDeposited, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) BondReleased(
+	opts *ethutil.SubscribeOpts,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) *BondReleasedSubscription {
+	if opts == nil {
+		opts = new(ethutil.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = ethutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = ethutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &BondReleasedSubscription{
+		fbb,
+		opts,
+		operatorFilter,
+		referenceIDFilter,
+	}
+}
+
+type BondReleasedSubscription struct {
+	contract          *FullyBackedBonding
+	opts              *ethutil.SubscribeOpts
+	operatorFilter    []common.Address
+	referenceIDFilter []*big.Int
+}
+
+type fullyBackedBondingBondReleasedFunc func(
+	Operator common.Address,
+	ReferenceID *big.Int,
+	blockNumber uint64,
+)
+
+func (brs *BondReleasedSubscription) OnEvent(
+	handler fullyBackedBondingBondReleasedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedBondingBondReleased)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.ReferenceID,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := brs.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (brs *BondReleasedSubscription) Pipe(
+	sink chan *abi.FullyBackedBondingBondReleased,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(brs.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := brs.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - brs.opts.PastBlocks
+
+				fbbLogger.Infof(
+					"subscription monitoring fetching past BondReleased events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := brs.contract.PastBondReleasedEvents(
+					fromBlock,
+					nil,
+					brs.operatorFilter,
+					brs.referenceIDFilter,
+				)
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbbLogger.Infof(
+					"subscription monitoring fetched [%v] past BondReleased events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := brs.contract.watchBondReleased(
+		sink,
+		brs.operatorFilter,
+		brs.referenceIDFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbb *FullyBackedBonding) watchBondReleased(
+	sink chan *abi.FullyBackedBondingBondReleased,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbb.contract.WatchBondReleased(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+			referenceIDFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbbLogger.Errorf(
+			"subscription to event BondReleased had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"Ethereum connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbbLogger.Errorf(
+			"subscription to event BondReleased failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return ethutil.WithResubscription(
+		ethutil.SubscriptionBackoffMax,
+		subscribeFn,
+		ethutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbb *FullyBackedBonding) PastBondReleasedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) ([]*abi.FullyBackedBondingBondReleased, error) {
+	iterator, err := fbb.contract.FilterBondReleased(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		referenceIDFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past BondReleased events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingBondReleased, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) BondSeized(
+	opts *ethutil.SubscribeOpts,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) *BondSeizedSubscription {
+	if opts == nil {
+		opts = new(ethutil.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = ethutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = ethutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &BondSeizedSubscription{
+		fbb,
+		opts,
+		operatorFilter,
+		referenceIDFilter,
+	}
+}
+
+type BondSeizedSubscription struct {
+	contract          *FullyBackedBonding
+	opts              *ethutil.SubscribeOpts
+	operatorFilter    []common.Address
+	referenceIDFilter []*big.Int
+}
+
+type fullyBackedBondingBondSeizedFunc func(
+	Operator common.Address,
+	ReferenceID *big.Int,
+	Destination common.Address,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (bss *BondSeizedSubscription) OnEvent(
+	handler fullyBackedBondingBondSeizedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedBondingBondSeized)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.ReferenceID,
+					event.Destination,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := bss.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (bss *BondSeizedSubscription) Pipe(
+	sink chan *abi.FullyBackedBondingBondSeized,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(bss.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := bss.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - bss.opts.PastBlocks
+
+				fbbLogger.Infof(
+					"subscription monitoring fetching past BondSeized events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := bss.contract.PastBondSeizedEvents(
+					fromBlock,
+					nil,
+					bss.operatorFilter,
+					bss.referenceIDFilter,
+				)
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbbLogger.Infof(
+					"subscription monitoring fetched [%v] past BondSeized events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := bss.contract.watchBondSeized(
+		sink,
+		bss.operatorFilter,
+		bss.referenceIDFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbb *FullyBackedBonding) watchBondSeized(
+	sink chan *abi.FullyBackedBondingBondSeized,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbb.contract.WatchBondSeized(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+			referenceIDFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbbLogger.Errorf(
+			"subscription to event BondSeized had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"Ethereum connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbbLogger.Errorf(
+			"subscription to event BondSeized failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return ethutil.WithResubscription(
+		ethutil.SubscriptionBackoffMax,
+		subscribeFn,
+		ethutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbb *FullyBackedBonding) PastBondSeizedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) ([]*abi.FullyBackedBondingBondSeized, error) {
+	iterator, err := fbb.contract.FilterBondSeized(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		referenceIDFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past BondSeized events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingBondSeized, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) UnbondedValueWithdrawn(
+	opts *ethutil.SubscribeOpts,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) *UnbondedValueWithdrawnSubscription {
+	if opts == nil {
+		opts = new(ethutil.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = ethutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = ethutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &UnbondedValueWithdrawnSubscription{
+		fbb,
+		opts,
+		operatorFilter,
+		beneficiaryFilter,
+	}
+}
+
+type UnbondedValueWithdrawnSubscription struct {
+	contract          *FullyBackedBonding
+	opts              *ethutil.SubscribeOpts
+	operatorFilter    []common.Address
+	beneficiaryFilter []common.Address
+}
+
+type fullyBackedBondingUnbondedValueWithdrawnFunc func(
+	Operator common.Address,
+	Beneficiary common.Address,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (uvws *UnbondedValueWithdrawnSubscription) OnEvent(
+	handler fullyBackedBondingUnbondedValueWithdrawnFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedBondingUnbondedValueWithdrawn)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.Beneficiary,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := uvws.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (uvws *UnbondedValueWithdrawnSubscription) Pipe(
+	sink chan *abi.FullyBackedBondingUnbondedValueWithdrawn,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(uvws.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := uvws.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - uvws.opts.PastBlocks
+
+				fbbLogger.Infof(
+					"subscription monitoring fetching past UnbondedValueWithdrawn events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := uvws.contract.PastUnbondedValueWithdrawnEvents(
+					fromBlock,
+					nil,
+					uvws.operatorFilter,
+					uvws.beneficiaryFilter,
+				)
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbbLogger.Infof(
+					"subscription monitoring fetched [%v] past UnbondedValueWithdrawn events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := uvws.contract.watchUnbondedValueWithdrawn(
+		sink,
+		uvws.operatorFilter,
+		uvws.beneficiaryFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbb *FullyBackedBonding) watchUnbondedValueWithdrawn(
+	sink chan *abi.FullyBackedBondingUnbondedValueWithdrawn,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbb.contract.WatchUnbondedValueWithdrawn(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+			beneficiaryFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbbLogger.Errorf(
+			"subscription to event UnbondedValueWithdrawn had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"Ethereum connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbbLogger.Errorf(
+			"subscription to event UnbondedValueWithdrawn failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return ethutil.WithResubscription(
+		ethutil.SubscriptionBackoffMax,
+		subscribeFn,
+		ethutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbb *FullyBackedBonding) PastUnbondedValueWithdrawnEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) ([]*abi.FullyBackedBondingUnbondedValueWithdrawn, error) {
+	iterator, err := fbb.contract.FilterUnbondedValueWithdrawn(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		beneficiaryFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past UnbondedValueWithdrawn events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingUnbondedValueWithdrawn, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) BondCreated(
+	opts *ethutil.SubscribeOpts,
+	operatorFilter []common.Address,
+	holderFilter []common.Address,
+	sortitionPoolFilter []common.Address,
+) *BondCreatedSubscription {
+	if opts == nil {
+		opts = new(ethutil.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = ethutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = ethutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &BondCreatedSubscription{
+		fbb,
+		opts,
+		operatorFilter,
+		holderFilter,
+		sortitionPoolFilter,
+	}
+}
+
+type BondCreatedSubscription struct {
+	contract            *FullyBackedBonding
+	opts                *ethutil.SubscribeOpts
+	operatorFilter      []common.Address
+	holderFilter        []common.Address
+	sortitionPoolFilter []common.Address
+}
+
+type fullyBackedBondingBondCreatedFunc func(
+	Operator common.Address,
+	Holder common.Address,
+	SortitionPool common.Address,
+	ReferenceID *big.Int,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (bcs *BondCreatedSubscription) OnEvent(
+	handler fullyBackedBondingBondCreatedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedBondingBondCreated)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.Holder,
+					event.SortitionPool,
+					event.ReferenceID,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := bcs.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (bcs *BondCreatedSubscription) Pipe(
+	sink chan *abi.FullyBackedBondingBondCreated,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(bcs.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := bcs.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - bcs.opts.PastBlocks
+
+				fbbLogger.Infof(
+					"subscription monitoring fetching past BondCreated events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := bcs.contract.PastBondCreatedEvents(
+					fromBlock,
+					nil,
+					bcs.operatorFilter,
+					bcs.holderFilter,
+					bcs.sortitionPoolFilter,
+				)
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbbLogger.Infof(
+					"subscription monitoring fetched [%v] past BondCreated events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := bcs.contract.watchBondCreated(
+		sink,
+		bcs.operatorFilter,
+		bcs.holderFilter,
+		bcs.sortitionPoolFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbb *FullyBackedBonding) watchBondCreated(
+	sink chan *abi.FullyBackedBondingBondCreated,
+	operatorFilter []common.Address,
+	holderFilter []common.Address,
+	sortitionPoolFilter []common.Address,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbb.contract.WatchBondCreated(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+			holderFilter,
+			sortitionPoolFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbbLogger.Errorf(
+			"subscription to event BondCreated had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"Ethereum connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbbLogger.Errorf(
+			"subscription to event BondCreated failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return ethutil.WithResubscription(
+		ethutil.SubscriptionBackoffMax,
+		subscribeFn,
+		ethutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbb *FullyBackedBonding) PastBondCreatedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	holderFilter []common.Address,
+	sortitionPoolFilter []common.Address,
+) ([]*abi.FullyBackedBondingBondCreated, error) {
+	iterator, err := fbb.contract.FilterBondCreated(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		holderFilter,
+		sortitionPoolFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past BondCreated events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingBondCreated, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,2406 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contract
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	ethereumabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/ipfs/go-log"
+
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/blockcounter"
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+	"github.com/keep-network/keep-common/pkg/subscription"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/abi"
+)
+
+// Create a package-level logger for this contract. The logger exists at
+// package level so that the logger is registered at startup and can be
+// included or excluded from logging at startup by name.
+var fbecdsakfLogger = log.Logger("keep-contract-FullyBackedECDSAKeepFactory")
+
+type FullyBackedECDSAKeepFactory struct {
+	contract          *abi.FullyBackedECDSAKeepFactory
+	contractAddress   common.Address
+	contractABI       *ethereumabi.ABI
+	caller            bind.ContractCaller
+	transactor        bind.ContractTransactor
+	callerOptions     *bind.CallOpts
+	transactorOptions *bind.TransactOpts
+	errorResolver     *ethutil.ErrorResolver
+	nonceManager      *ethutil.NonceManager
+	miningWaiter      *ethutil.MiningWaiter
+	blockCounter      *blockcounter.EthereumBlockCounter
+
+	transactionMutex *sync.Mutex
+}
+
+func NewFullyBackedECDSAKeepFactory(
+	contractAddress common.Address,
+	accountKey *keystore.Key,
+	backend bind.ContractBackend,
+	nonceManager *ethutil.NonceManager,
+	miningWaiter *ethutil.MiningWaiter,
+	blockCounter *blockcounter.EthereumBlockCounter,
+	transactionMutex *sync.Mutex,
+) (*FullyBackedECDSAKeepFactory, error) {
+	callerOptions := &bind.CallOpts{
+		From: accountKey.Address,
+	}
+
+	transactorOptions := bind.NewKeyedTransactor(
+		accountKey.PrivateKey,
+	)
+
+	randomBeaconContract, err := abi.NewFullyBackedECDSAKeepFactory(
+		contractAddress,
+		backend,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to instantiate contract at address: %s [%v]",
+			contractAddress.String(),
+			err,
+		)
+	}
+
+	contractABI, err := ethereumabi.JSON(strings.NewReader(abi.FullyBackedECDSAKeepFactoryABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate ABI: [%v]", err)
+	}
+
+	return &FullyBackedECDSAKeepFactory{
+		contract:          randomBeaconContract,
+		contractAddress:   contractAddress,
+		contractABI:       &contractABI,
+		caller:            backend,
+		transactor:        backend,
+		callerOptions:     callerOptions,
+		transactorOptions: transactorOptions,
+		errorResolver:     ethutil.NewErrorResolver(backend, &contractABI, &contractAddress),
+		nonceManager:      nonceManager,
+		miningWaiter:      miningWaiter,
+		blockCounter:      blockCounter,
+		transactionMutex:  transactionMutex,
+	}, nil
+}
+
+// ----- Non-const Methods ------
+
+// Transaction submission.
+func (fbecdsakf *FullyBackedECDSAKeepFactory) SetMinimumBondableValue(
+	_minimumBondableValue *big.Int,
+	_groupSize *big.Int,
+	_honestThreshold *big.Int,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakfLogger.Debug(
+		"submitting transaction setMinimumBondableValue",
+		"params: ",
+		fmt.Sprint(
+			_minimumBondableValue,
+			_groupSize,
+			_honestThreshold,
+		),
+	)
+
+	fbecdsakf.transactionMutex.Lock()
+	defer fbecdsakf.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsakf.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsakf.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsakf.contract.SetMinimumBondableValue(
+		transactorOptions,
+		_minimumBondableValue,
+		_groupSize,
+		_honestThreshold,
+	)
+	if err != nil {
+		return transaction, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.transactorOptions.From,
+			nil,
+			"setMinimumBondableValue",
+			_minimumBondableValue,
+			_groupSize,
+			_honestThreshold,
+		)
+	}
+
+	fbecdsakfLogger.Infof(
+		"submitted transaction setMinimumBondableValue with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsakf.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbecdsakf.contract.SetMinimumBondableValue(
+				transactorOptions,
+				_minimumBondableValue,
+				_groupSize,
+				_honestThreshold,
+			)
+			if err != nil {
+				return transaction, fbecdsakf.errorResolver.ResolveError(
+					err,
+					fbecdsakf.transactorOptions.From,
+					nil,
+					"setMinimumBondableValue",
+					_minimumBondableValue,
+					_groupSize,
+					_honestThreshold,
+				)
+			}
+
+			fbecdsakfLogger.Infof(
+				"submitted transaction setMinimumBondableValue with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsakf.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsakf *FullyBackedECDSAKeepFactory) CallSetMinimumBondableValue(
+	_minimumBondableValue *big.Int,
+	_groupSize *big.Int,
+	_honestThreshold *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"setMinimumBondableValue",
+		&result,
+		_minimumBondableValue,
+		_groupSize,
+		_honestThreshold,
+	)
+
+	return err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) SetMinimumBondableValueGasEstimate(
+	_minimumBondableValue *big.Int,
+	_groupSize *big.Int,
+	_honestThreshold *big.Int,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbecdsakf.callerOptions.From,
+		fbecdsakf.contractAddress,
+		"setMinimumBondableValue",
+		fbecdsakf.contractABI,
+		fbecdsakf.transactor,
+		_minimumBondableValue,
+		_groupSize,
+		_honestThreshold,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsakf *FullyBackedECDSAKeepFactory) OpenKeep(
+	_groupSize *big.Int,
+	_honestThreshold *big.Int,
+	_owner common.Address,
+	_bond *big.Int,
+	_stakeLockDuration *big.Int,
+	value *big.Int,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakfLogger.Debug(
+		"submitting transaction openKeep",
+		"params: ",
+		fmt.Sprint(
+			_groupSize,
+			_honestThreshold,
+			_owner,
+			_bond,
+			_stakeLockDuration,
+		),
+		"value: ", value,
+	)
+
+	fbecdsakf.transactionMutex.Lock()
+	defer fbecdsakf.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsakf.transactorOptions
+
+	transactorOptions.Value = value
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsakf.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsakf.contract.OpenKeep(
+		transactorOptions,
+		_groupSize,
+		_honestThreshold,
+		_owner,
+		_bond,
+		_stakeLockDuration,
+	)
+	if err != nil {
+		return transaction, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.transactorOptions.From,
+			value,
+			"openKeep",
+			_groupSize,
+			_honestThreshold,
+			_owner,
+			_bond,
+			_stakeLockDuration,
+		)
+	}
+
+	fbecdsakfLogger.Infof(
+		"submitted transaction openKeep with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsakf.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbecdsakf.contract.OpenKeep(
+				transactorOptions,
+				_groupSize,
+				_honestThreshold,
+				_owner,
+				_bond,
+				_stakeLockDuration,
+			)
+			if err != nil {
+				return transaction, fbecdsakf.errorResolver.ResolveError(
+					err,
+					fbecdsakf.transactorOptions.From,
+					value,
+					"openKeep",
+					_groupSize,
+					_honestThreshold,
+					_owner,
+					_bond,
+					_stakeLockDuration,
+				)
+			}
+
+			fbecdsakfLogger.Infof(
+				"submitted transaction openKeep with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsakf.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsakf *FullyBackedECDSAKeepFactory) CallOpenKeep(
+	_groupSize *big.Int,
+	_honestThreshold *big.Int,
+	_owner common.Address,
+	_bond *big.Int,
+	_stakeLockDuration *big.Int,
+	value *big.Int,
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.transactorOptions.From,
+		blockNumber, value,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"openKeep",
+		&result,
+		_groupSize,
+		_honestThreshold,
+		_owner,
+		_bond,
+		_stakeLockDuration,
+	)
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) OpenKeepGasEstimate(
+	_groupSize *big.Int,
+	_honestThreshold *big.Int,
+	_owner common.Address,
+	_bond *big.Int,
+	_stakeLockDuration *big.Int,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbecdsakf.callerOptions.From,
+		fbecdsakf.contractAddress,
+		"openKeep",
+		fbecdsakf.contractABI,
+		fbecdsakf.transactor,
+		_groupSize,
+		_honestThreshold,
+		_owner,
+		_bond,
+		_stakeLockDuration,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsakf *FullyBackedECDSAKeepFactory) RegisterMemberCandidate(
+	_application common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakfLogger.Debug(
+		"submitting transaction registerMemberCandidate",
+		"params: ",
+		fmt.Sprint(
+			_application,
+		),
+	)
+
+	fbecdsakf.transactionMutex.Lock()
+	defer fbecdsakf.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsakf.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsakf.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsakf.contract.RegisterMemberCandidate(
+		transactorOptions,
+		_application,
+	)
+	if err != nil {
+		return transaction, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.transactorOptions.From,
+			nil,
+			"registerMemberCandidate",
+			_application,
+		)
+	}
+
+	fbecdsakfLogger.Infof(
+		"submitted transaction registerMemberCandidate with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsakf.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbecdsakf.contract.RegisterMemberCandidate(
+				transactorOptions,
+				_application,
+			)
+			if err != nil {
+				return transaction, fbecdsakf.errorResolver.ResolveError(
+					err,
+					fbecdsakf.transactorOptions.From,
+					nil,
+					"registerMemberCandidate",
+					_application,
+				)
+			}
+
+			fbecdsakfLogger.Infof(
+				"submitted transaction registerMemberCandidate with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsakf.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsakf *FullyBackedECDSAKeepFactory) CallRegisterMemberCandidate(
+	_application common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"registerMemberCandidate",
+		&result,
+		_application,
+	)
+
+	return err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) RegisterMemberCandidateGasEstimate(
+	_application common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbecdsakf.callerOptions.From,
+		fbecdsakf.contractAddress,
+		"registerMemberCandidate",
+		fbecdsakf.contractABI,
+		fbecdsakf.transactor,
+		_application,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsakf *FullyBackedECDSAKeepFactory) UpdateOperatorStatus(
+	_operator common.Address,
+	_application common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakfLogger.Debug(
+		"submitting transaction updateOperatorStatus",
+		"params: ",
+		fmt.Sprint(
+			_operator,
+			_application,
+		),
+	)
+
+	fbecdsakf.transactionMutex.Lock()
+	defer fbecdsakf.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsakf.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsakf.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsakf.contract.UpdateOperatorStatus(
+		transactorOptions,
+		_operator,
+		_application,
+	)
+	if err != nil {
+		return transaction, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.transactorOptions.From,
+			nil,
+			"updateOperatorStatus",
+			_operator,
+			_application,
+		)
+	}
+
+	fbecdsakfLogger.Infof(
+		"submitted transaction updateOperatorStatus with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsakf.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbecdsakf.contract.UpdateOperatorStatus(
+				transactorOptions,
+				_operator,
+				_application,
+			)
+			if err != nil {
+				return transaction, fbecdsakf.errorResolver.ResolveError(
+					err,
+					fbecdsakf.transactorOptions.From,
+					nil,
+					"updateOperatorStatus",
+					_operator,
+					_application,
+				)
+			}
+
+			fbecdsakfLogger.Infof(
+				"submitted transaction updateOperatorStatus with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsakf.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsakf *FullyBackedECDSAKeepFactory) CallUpdateOperatorStatus(
+	_operator common.Address,
+	_application common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"updateOperatorStatus",
+		&result,
+		_operator,
+		_application,
+	)
+
+	return err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) UpdateOperatorStatusGasEstimate(
+	_operator common.Address,
+	_application common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbecdsakf.callerOptions.From,
+		fbecdsakf.contractAddress,
+		"updateOperatorStatus",
+		fbecdsakf.contractABI,
+		fbecdsakf.transactor,
+		_operator,
+		_application,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsakf *FullyBackedECDSAKeepFactory) BeaconCallback(
+	_relayEntry *big.Int,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakfLogger.Debug(
+		"submitting transaction beaconCallback",
+		"params: ",
+		fmt.Sprint(
+			_relayEntry,
+		),
+	)
+
+	fbecdsakf.transactionMutex.Lock()
+	defer fbecdsakf.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsakf.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsakf.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsakf.contract.BeaconCallback(
+		transactorOptions,
+		_relayEntry,
+	)
+	if err != nil {
+		return transaction, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.transactorOptions.From,
+			nil,
+			"beaconCallback",
+			_relayEntry,
+		)
+	}
+
+	fbecdsakfLogger.Infof(
+		"submitted transaction beaconCallback with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsakf.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbecdsakf.contract.BeaconCallback(
+				transactorOptions,
+				_relayEntry,
+			)
+			if err != nil {
+				return transaction, fbecdsakf.errorResolver.ResolveError(
+					err,
+					fbecdsakf.transactorOptions.From,
+					nil,
+					"beaconCallback",
+					_relayEntry,
+				)
+			}
+
+			fbecdsakfLogger.Infof(
+				"submitted transaction beaconCallback with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsakf.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsakf *FullyBackedECDSAKeepFactory) CallBeaconCallback(
+	_relayEntry *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"beaconCallback",
+		&result,
+		_relayEntry,
+	)
+
+	return err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) BeaconCallbackGasEstimate(
+	_relayEntry *big.Int,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbecdsakf.callerOptions.From,
+		fbecdsakf.contractAddress,
+		"beaconCallback",
+		fbecdsakf.contractABI,
+		fbecdsakf.transactor,
+		_relayEntry,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsakf *FullyBackedECDSAKeepFactory) BanKeepMembers(
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakfLogger.Debug(
+		"submitting transaction banKeepMembers",
+	)
+
+	fbecdsakf.transactionMutex.Lock()
+	defer fbecdsakf.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsakf.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsakf.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsakf.contract.BanKeepMembers(
+		transactorOptions,
+	)
+	if err != nil {
+		return transaction, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.transactorOptions.From,
+			nil,
+			"banKeepMembers",
+		)
+	}
+
+	fbecdsakfLogger.Infof(
+		"submitted transaction banKeepMembers with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsakf.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbecdsakf.contract.BanKeepMembers(
+				transactorOptions,
+			)
+			if err != nil {
+				return transaction, fbecdsakf.errorResolver.ResolveError(
+					err,
+					fbecdsakf.transactorOptions.From,
+					nil,
+					"banKeepMembers",
+				)
+			}
+
+			fbecdsakfLogger.Infof(
+				"submitted transaction banKeepMembers with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsakf.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsakf *FullyBackedECDSAKeepFactory) CallBanKeepMembers(
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"banKeepMembers",
+		&result,
+	)
+
+	return err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) BanKeepMembersGasEstimate() (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbecdsakf.callerOptions.From,
+		fbecdsakf.contractAddress,
+		"banKeepMembers",
+		fbecdsakf.contractABI,
+		fbecdsakf.transactor,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsakf *FullyBackedECDSAKeepFactory) CreateSortitionPool(
+	_application common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakfLogger.Debug(
+		"submitting transaction createSortitionPool",
+		"params: ",
+		fmt.Sprint(
+			_application,
+		),
+	)
+
+	fbecdsakf.transactionMutex.Lock()
+	defer fbecdsakf.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsakf.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsakf.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsakf.contract.CreateSortitionPool(
+		transactorOptions,
+		_application,
+	)
+	if err != nil {
+		return transaction, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.transactorOptions.From,
+			nil,
+			"createSortitionPool",
+			_application,
+		)
+	}
+
+	fbecdsakfLogger.Infof(
+		"submitted transaction createSortitionPool with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsakf.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbecdsakf.contract.CreateSortitionPool(
+				transactorOptions,
+				_application,
+			)
+			if err != nil {
+				return transaction, fbecdsakf.errorResolver.ResolveError(
+					err,
+					fbecdsakf.transactorOptions.From,
+					nil,
+					"createSortitionPool",
+					_application,
+				)
+			}
+
+			fbecdsakfLogger.Infof(
+				"submitted transaction createSortitionPool with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsakf.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsakf *FullyBackedECDSAKeepFactory) CallCreateSortitionPool(
+	_application common.Address,
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"createSortitionPool",
+		&result,
+		_application,
+	)
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) CreateSortitionPoolGasEstimate(
+	_application common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbecdsakf.callerOptions.From,
+		fbecdsakf.contractAddress,
+		"createSortitionPool",
+		fbecdsakf.contractABI,
+		fbecdsakf.transactor,
+		_application,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsakf *FullyBackedECDSAKeepFactory) RequestNewGroupSelectionSeed(
+	value *big.Int,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakfLogger.Debug(
+		"submitting transaction requestNewGroupSelectionSeed",
+		"value: ", value,
+	)
+
+	fbecdsakf.transactionMutex.Lock()
+	defer fbecdsakf.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsakf.transactorOptions
+
+	transactorOptions.Value = value
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsakf.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsakf.contract.RequestNewGroupSelectionSeed(
+		transactorOptions,
+	)
+	if err != nil {
+		return transaction, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.transactorOptions.From,
+			value,
+			"requestNewGroupSelectionSeed",
+		)
+	}
+
+	fbecdsakfLogger.Infof(
+		"submitted transaction requestNewGroupSelectionSeed with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsakf.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbecdsakf.contract.RequestNewGroupSelectionSeed(
+				transactorOptions,
+			)
+			if err != nil {
+				return transaction, fbecdsakf.errorResolver.ResolveError(
+					err,
+					fbecdsakf.transactorOptions.From,
+					value,
+					"requestNewGroupSelectionSeed",
+				)
+			}
+
+			fbecdsakfLogger.Infof(
+				"submitted transaction requestNewGroupSelectionSeed with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsakf.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsakf *FullyBackedECDSAKeepFactory) CallRequestNewGroupSelectionSeed(
+	value *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.transactorOptions.From,
+		blockNumber, value,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"requestNewGroupSelectionSeed",
+		&result,
+	)
+
+	return err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) RequestNewGroupSelectionSeedGasEstimate() (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbecdsakf.callerOptions.From,
+		fbecdsakf.contractAddress,
+		"requestNewGroupSelectionSeed",
+		fbecdsakf.contractABI,
+		fbecdsakf.transactor,
+	)
+
+	return result, err
+}
+
+// ----- Const Methods ------
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) ReseedPool() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbecdsakf.contract.ReseedPool(
+		fbecdsakf.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.callerOptions.From,
+			nil,
+			"reseedPool",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) ReseedPoolAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"reseedPool",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) IsRecognized(
+	_delegatedAuthorityRecipient common.Address,
+) (bool, error) {
+	var result bool
+	result, err := fbecdsakf.contract.IsRecognized(
+		fbecdsakf.callerOptions,
+		_delegatedAuthorityRecipient,
+	)
+
+	if err != nil {
+		return result, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.callerOptions.From,
+			nil,
+			"isRecognized",
+			_delegatedAuthorityRecipient,
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) IsRecognizedAtBlock(
+	_delegatedAuthorityRecipient common.Address,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"isRecognized",
+		&result,
+		_delegatedAuthorityRecipient,
+	)
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) DefaultMinimumBond() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbecdsakf.contract.DefaultMinimumBond(
+		fbecdsakf.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.callerOptions.From,
+			nil,
+			"defaultMinimumBond",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) DefaultMinimumBondAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"defaultMinimumBond",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) GetKeepCount() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbecdsakf.contract.GetKeepCount(
+		fbecdsakf.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.callerOptions.From,
+			nil,
+			"getKeepCount",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) GetKeepCountAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"getKeepCount",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) Keeps(
+	arg0 *big.Int,
+) (common.Address, error) {
+	var result common.Address
+	result, err := fbecdsakf.contract.Keeps(
+		fbecdsakf.callerOptions,
+		arg0,
+	)
+
+	if err != nil {
+		return result, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.callerOptions.From,
+			nil,
+			"keeps",
+			arg0,
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) KeepsAtBlock(
+	arg0 *big.Int,
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"keeps",
+		&result,
+		arg0,
+	)
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) MasterKeepAddress() (common.Address, error) {
+	var result common.Address
+	result, err := fbecdsakf.contract.MasterKeepAddress(
+		fbecdsakf.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.callerOptions.From,
+			nil,
+			"masterKeepAddress",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) MasterKeepAddressAtBlock(
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"masterKeepAddress",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) NewEntryFeeEstimate() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbecdsakf.contract.NewEntryFeeEstimate(
+		fbecdsakf.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.callerOptions.From,
+			nil,
+			"newEntryFeeEstimate",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) NewEntryFeeEstimateAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"newEntryFeeEstimate",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) NewGroupSelectionSeedFee() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbecdsakf.contract.NewGroupSelectionSeedFee(
+		fbecdsakf.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.callerOptions.From,
+			nil,
+			"newGroupSelectionSeedFee",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) NewGroupSelectionSeedFeeAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"newGroupSelectionSeedFee",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) BondWeightDivisor() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbecdsakf.contract.BondWeightDivisor(
+		fbecdsakf.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.callerOptions.From,
+			nil,
+			"bondWeightDivisor",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) BondWeightDivisorAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"bondWeightDivisor",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) GetKeepOpenedTimestamp(
+	_keep common.Address,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := fbecdsakf.contract.GetKeepOpenedTimestamp(
+		fbecdsakf.callerOptions,
+		_keep,
+	)
+
+	if err != nil {
+		return result, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.callerOptions.From,
+			nil,
+			"getKeepOpenedTimestamp",
+			_keep,
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) GetKeepOpenedTimestampAtBlock(
+	_keep common.Address,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"getKeepOpenedTimestamp",
+		&result,
+		_keep,
+	)
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) GetSortitionPool(
+	_application common.Address,
+) (common.Address, error) {
+	var result common.Address
+	result, err := fbecdsakf.contract.GetSortitionPool(
+		fbecdsakf.callerOptions,
+		_application,
+	)
+
+	if err != nil {
+		return result, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.callerOptions.From,
+			nil,
+			"getSortitionPool",
+			_application,
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) GetSortitionPoolAtBlock(
+	_application common.Address,
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"getSortitionPool",
+		&result,
+		_application,
+	)
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) GroupSelectionSeed() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbecdsakf.contract.GroupSelectionSeed(
+		fbecdsakf.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.callerOptions.From,
+			nil,
+			"groupSelectionSeed",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) GroupSelectionSeedAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"groupSelectionSeed",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) IsOperatorAuthorized(
+	_operator common.Address,
+) (bool, error) {
+	var result bool
+	result, err := fbecdsakf.contract.IsOperatorAuthorized(
+		fbecdsakf.callerOptions,
+		_operator,
+	)
+
+	if err != nil {
+		return result, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.callerOptions.From,
+			nil,
+			"isOperatorAuthorized",
+			_operator,
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) IsOperatorAuthorizedAtBlock(
+	_operator common.Address,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"isOperatorAuthorized",
+		&result,
+		_operator,
+	)
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) IsOperatorRegistered(
+	_operator common.Address,
+	_application common.Address,
+) (bool, error) {
+	var result bool
+	result, err := fbecdsakf.contract.IsOperatorRegistered(
+		fbecdsakf.callerOptions,
+		_operator,
+		_application,
+	)
+
+	if err != nil {
+		return result, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.callerOptions.From,
+			nil,
+			"isOperatorRegistered",
+			_operator,
+			_application,
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) IsOperatorRegisteredAtBlock(
+	_operator common.Address,
+	_application common.Address,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"isOperatorRegistered",
+		&result,
+		_operator,
+		_application,
+	)
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) OpenKeepFeeEstimate() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbecdsakf.contract.OpenKeepFeeEstimate(
+		fbecdsakf.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.callerOptions.From,
+			nil,
+			"openKeepFeeEstimate",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) OpenKeepFeeEstimateAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"openKeepFeeEstimate",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) GetKeepAtIndex(
+	index *big.Int,
+) (common.Address, error) {
+	var result common.Address
+	result, err := fbecdsakf.contract.GetKeepAtIndex(
+		fbecdsakf.callerOptions,
+		index,
+	)
+
+	if err != nil {
+		return result, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.callerOptions.From,
+			nil,
+			"getKeepAtIndex",
+			index,
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) GetKeepAtIndexAtBlock(
+	index *big.Int,
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"getKeepAtIndex",
+		&result,
+		index,
+	)
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) GetSortitionPoolWeight(
+	_application common.Address,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := fbecdsakf.contract.GetSortitionPoolWeight(
+		fbecdsakf.callerOptions,
+		_application,
+	)
+
+	if err != nil {
+		return result, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.callerOptions.From,
+			nil,
+			"getSortitionPoolWeight",
+			_application,
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) GetSortitionPoolWeightAtBlock(
+	_application common.Address,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"getSortitionPoolWeight",
+		&result,
+		_application,
+	)
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) IsOperatorEligible(
+	_operator common.Address,
+	_application common.Address,
+) (bool, error) {
+	var result bool
+	result, err := fbecdsakf.contract.IsOperatorEligible(
+		fbecdsakf.callerOptions,
+		_operator,
+		_application,
+	)
+
+	if err != nil {
+		return result, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.callerOptions.From,
+			nil,
+			"isOperatorEligible",
+			_operator,
+			_application,
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) IsOperatorEligibleAtBlock(
+	_operator common.Address,
+	_application common.Address,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"isOperatorEligible",
+		&result,
+		_operator,
+		_application,
+	)
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) IsOperatorUpToDate(
+	_operator common.Address,
+	_application common.Address,
+) (bool, error) {
+	var result bool
+	result, err := fbecdsakf.contract.IsOperatorUpToDate(
+		fbecdsakf.callerOptions,
+		_operator,
+		_application,
+	)
+
+	if err != nil {
+		return result, fbecdsakf.errorResolver.ResolveError(
+			err,
+			fbecdsakf.callerOptions.From,
+			nil,
+			"isOperatorUpToDate",
+			_operator,
+			_application,
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) IsOperatorUpToDateAtBlock(
+	_operator common.Address,
+	_application common.Address,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		fbecdsakf.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsakf.contractABI,
+		fbecdsakf.caller,
+		fbecdsakf.errorResolver,
+		fbecdsakf.contractAddress,
+		"isOperatorUpToDate",
+		&result,
+		_operator,
+		_application,
+	)
+
+	return result, err
+}
+
+// ------ Events -------
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) FullyBackedECDSAKeepCreated(
+	opts *ethutil.SubscribeOpts,
+	keepAddressFilter []common.Address,
+	ownerFilter []common.Address,
+	applicationFilter []common.Address,
+) *FullyBackedECDSAKeepCreatedSubscription {
+	if opts == nil {
+		opts = new(ethutil.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = ethutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = ethutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &FullyBackedECDSAKeepCreatedSubscription{
+		fbecdsakf,
+		opts,
+		keepAddressFilter,
+		ownerFilter,
+		applicationFilter,
+	}
+}
+
+type FullyBackedECDSAKeepCreatedSubscription struct {
+	contract          *FullyBackedECDSAKeepFactory
+	opts              *ethutil.SubscribeOpts
+	keepAddressFilter []common.Address
+	ownerFilter       []common.Address
+	applicationFilter []common.Address
+}
+
+type fullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreatedFunc func(
+	KeepAddress common.Address,
+	Members []common.Address,
+	Owner common.Address,
+	Application common.Address,
+	HonestThreshold *big.Int,
+	blockNumber uint64,
+)
+
+func (fbecdsakcs *FullyBackedECDSAKeepCreatedSubscription) OnEvent(
+	handler fullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreatedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreated)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.KeepAddress,
+					event.Members,
+					event.Owner,
+					event.Application,
+					event.HonestThreshold,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := fbecdsakcs.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbecdsakcs *FullyBackedECDSAKeepCreatedSubscription) Pipe(
+	sink chan *abi.FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreated,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(fbecdsakcs.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := fbecdsakcs.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbecdsakfLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - fbecdsakcs.opts.PastBlocks
+
+				fbecdsakfLogger.Infof(
+					"subscription monitoring fetching past FullyBackedECDSAKeepCreated events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := fbecdsakcs.contract.PastFullyBackedECDSAKeepCreatedEvents(
+					fromBlock,
+					nil,
+					fbecdsakcs.keepAddressFilter,
+					fbecdsakcs.ownerFilter,
+					fbecdsakcs.applicationFilter,
+				)
+				if err != nil {
+					fbecdsakfLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbecdsakfLogger.Infof(
+					"subscription monitoring fetched [%v] past FullyBackedECDSAKeepCreated events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := fbecdsakcs.contract.watchFullyBackedECDSAKeepCreated(
+		sink,
+		fbecdsakcs.keepAddressFilter,
+		fbecdsakcs.ownerFilter,
+		fbecdsakcs.applicationFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) watchFullyBackedECDSAKeepCreated(
+	sink chan *abi.FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreated,
+	keepAddressFilter []common.Address,
+	ownerFilter []common.Address,
+	applicationFilter []common.Address,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbecdsakf.contract.WatchFullyBackedECDSAKeepCreated(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			keepAddressFilter,
+			ownerFilter,
+			applicationFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbecdsakfLogger.Errorf(
+			"subscription to event FullyBackedECDSAKeepCreated had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"Ethereum connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbecdsakfLogger.Errorf(
+			"subscription to event FullyBackedECDSAKeepCreated failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return ethutil.WithResubscription(
+		ethutil.SubscriptionBackoffMax,
+		subscribeFn,
+		ethutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) PastFullyBackedECDSAKeepCreatedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	keepAddressFilter []common.Address,
+	ownerFilter []common.Address,
+	applicationFilter []common.Address,
+) ([]*abi.FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreated, error) {
+	iterator, err := fbecdsakf.contract.FilterFullyBackedECDSAKeepCreated(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		keepAddressFilter,
+		ownerFilter,
+		applicationFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past FullyBackedECDSAKeepCreated events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreated, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) OperatorBanned(
+	opts *ethutil.SubscribeOpts,
+	operatorFilter []common.Address,
+	applicationFilter []common.Address,
+) *OperatorBannedSubscription {
+	if opts == nil {
+		opts = new(ethutil.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = ethutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = ethutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &OperatorBannedSubscription{
+		fbecdsakf,
+		opts,
+		operatorFilter,
+		applicationFilter,
+	}
+}
+
+type OperatorBannedSubscription struct {
+	contract          *FullyBackedECDSAKeepFactory
+	opts              *ethutil.SubscribeOpts
+	operatorFilter    []common.Address
+	applicationFilter []common.Address
+}
+
+type fullyBackedECDSAKeepFactoryOperatorBannedFunc func(
+	Operator common.Address,
+	Application common.Address,
+	blockNumber uint64,
+)
+
+func (obs *OperatorBannedSubscription) OnEvent(
+	handler fullyBackedECDSAKeepFactoryOperatorBannedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedECDSAKeepFactoryOperatorBanned)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.Application,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := obs.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (obs *OperatorBannedSubscription) Pipe(
+	sink chan *abi.FullyBackedECDSAKeepFactoryOperatorBanned,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(obs.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := obs.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbecdsakfLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - obs.opts.PastBlocks
+
+				fbecdsakfLogger.Infof(
+					"subscription monitoring fetching past OperatorBanned events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := obs.contract.PastOperatorBannedEvents(
+					fromBlock,
+					nil,
+					obs.operatorFilter,
+					obs.applicationFilter,
+				)
+				if err != nil {
+					fbecdsakfLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbecdsakfLogger.Infof(
+					"subscription monitoring fetched [%v] past OperatorBanned events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := obs.contract.watchOperatorBanned(
+		sink,
+		obs.operatorFilter,
+		obs.applicationFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) watchOperatorBanned(
+	sink chan *abi.FullyBackedECDSAKeepFactoryOperatorBanned,
+	operatorFilter []common.Address,
+	applicationFilter []common.Address,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbecdsakf.contract.WatchOperatorBanned(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+			applicationFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbecdsakfLogger.Errorf(
+			"subscription to event OperatorBanned had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"Ethereum connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbecdsakfLogger.Errorf(
+			"subscription to event OperatorBanned failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return ethutil.WithResubscription(
+		ethutil.SubscriptionBackoffMax,
+		subscribeFn,
+		ethutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbecdsakf *FullyBackedECDSAKeepFactory) PastOperatorBannedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	applicationFilter []common.Address,
+) ([]*abi.FullyBackedECDSAKeepFactoryOperatorBanned, error) {
+	iterator, err := fbecdsakf.contract.FilterOperatorBanned(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		applicationFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past OperatorBanned events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedECDSAKeepFactoryOperatorBanned, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
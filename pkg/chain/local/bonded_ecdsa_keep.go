@@ -2,6 +2,8 @@ package local
 
 import (
 	"fmt"
+	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
@@ -20,6 +22,7 @@ type localKeep struct {
 	members      []common.Address
 	status       keepStatus
 	latestDigest [32]byte
+	digests      map[[32]byte]uint64 // digest -> block number of the request
 
 	signatureRequestedHandlers map[int]func(event *eth.SignatureRequestedEvent)
 
@@ -27,6 +30,11 @@ type localKeep struct {
 	keepTerminatedHandlers map[int]func(event *eth.KeepTerminatedEvent)
 
 	signatureSubmittedEvents []*eth.SignatureSubmittedEvent
+
+	membersETHBalances map[common.Address]*big.Int
+	bondAmount         *big.Int
+	application        common.Address
+	openedTimestamp    time.Time
 }
 
 func (c *localChain) RequestSignature(keepAddress common.Address, digest [32]byte) error {
@@ -49,10 +57,17 @@ func (c *localChain) RequestSignature(keepAddress common.Address, digest [32]byt
 		)
 	}
 
+	currentBlock, err := c.blockCounter.CurrentBlock()
+	if err != nil {
+		return err
+	}
+
 	keep.latestDigest = digest
+	keep.digests[digest] = currentBlock
 
 	signatureRequestedEvent := &eth.SignatureRequestedEvent{
-		Digest: digest,
+		Digest:      digest,
+		BlockNumber: currentBlock,
 	}
 
 	for _, handler := range keep.signatureRequestedHandlers {
@@ -2,6 +2,8 @@ package local
 
 import (
 	"fmt"
+	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	chain "github.com/keep-network/keep-ecdsa/pkg/chain"
@@ -25,13 +27,26 @@ func (c *localChain) createKeepWithMembers(
 		)
 	}
 
+	// The keep is opened in the current block so it has the block timestamp,
+	// as on-chain. The block may not have been observed yet.
+	openedTimestamp := time.Now()
+	if currentBlock, err := c.blockCounter.CurrentBlock(); err == nil {
+		if blockTimestamp, ok := c.blocksTimestamps.Load(currentBlock); ok {
+			openedTimestamp = time.Unix(int64(blockTimestamp.(uint64)), 0)
+		}
+	}
+
 	localKeep := &localKeep{
 		publicKey:                  [64]byte{},
 		members:                    members,
+		digests:                    make(map[[32]byte]uint64),
 		signatureRequestedHandlers: make(map[int]func(event *chain.SignatureRequestedEvent)),
 		keepClosedHandlers:         make(map[int]func(event *chain.KeepClosedEvent)),
 		keepTerminatedHandlers:     make(map[int]func(event *chain.KeepTerminatedEvent)),
 		signatureSubmittedEvents:   make([]*chain.SignatureSubmittedEvent, 0),
+		membersETHBalances:         make(map[common.Address]*big.Int),
+		bondAmount:                 big.NewInt(0),
+		openedTimestamp:            openedTimestamp,
 	}
 
 	c.keeps[keepAddress] = localKeep
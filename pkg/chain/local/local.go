@@ -5,6 +5,7 @@ import (
 	cecdsa "crypto/ecdsa"
 	"crypto/elliptic"
 	crand "crypto/rand"
+	"crypto/sha256"
 	"fmt"
 	"math/big"
 	"math/rand"
@@ -33,6 +34,14 @@ type Chain interface {
 	TerminateKeep(keepAddress common.Address) error
 	RequestSignature(keepAddress common.Address, digest [32]byte) error
 	AuthorizeOperator(operatorAddress common.Address)
+	SetMemberETHBalance(
+		keepAddress common.Address,
+		member common.Address,
+		balance *big.Int,
+	) error
+	SetAvailableUnbondedValue(application common.Address, value *big.Int)
+	SetBondAmount(keepAddress common.Address, amount *big.Int) error
+	SetKeepApplication(keepAddress common.Address, application common.Address) error
 }
 
 // localChain is an implementation of ethereum blockchain interface.
@@ -54,6 +63,11 @@ type localChain struct {
 	signer      chain.Signing
 
 	authorizations map[common.Address]bool
+
+	unbondedValues map[common.Address]*big.Int
+	minimumBond    *big.Int
+
+	transactionSenders map[common.Hash]common.Address
 }
 
 // Connect performs initialization for communication with Ethereum blockchain
@@ -78,6 +92,9 @@ func Connect(ctx context.Context) Chain {
 		operatorKey:         operatorKey,
 		signer:              signer,
 		authorizations:      make(map[common.Address]bool),
+		unbondedValues:      make(map[common.Address]*big.Int),
+		minimumBond:         new(big.Int).Mul(big.NewInt(20), big.NewInt(1e18)),
+		transactionSenders:  make(map[common.Hash]common.Address),
 	}
 
 	// block 0 must be stored manually as it is not delivered by the block counter
@@ -224,7 +241,8 @@ func (lc *localChain) SubmitKeepPublicKey(
 }
 
 // SubmitSignature submits a signature to a keep contract deployed under a
-// given address.
+// given address. The hash of the transaction is the hash of the keep address,
+// the digest and the signature.
 func (lc *localChain) SubmitSignature(
 	keepAddress common.Address,
 	signature *ecdsa.Signature,
@@ -248,6 +266,8 @@ func (lc *localChain) SubmitSignature(
 		)
 	}
 
+	digest := keep.latestDigest
+
 	rBytes, err := byteutils.BytesTo32Byte(signature.R.Bytes())
 	if err != nil {
 		return err
@@ -258,19 +278,108 @@ func (lc *localChain) SubmitSignature(
 		return err
 	}
 
+	currentBlock, err := lc.blockCounter.CurrentBlock()
+	if err != nil {
+		return err
+	}
+
+	transactionHash := common.Hash(sha256.Sum256(
+		append(
+			append(keepAddress.Bytes(), digest[:]...),
+			append(signature.R.Bytes(), signature.S.Bytes()...)...,
+		),
+	))
+	lc.transactionSenders[transactionHash] = lc.Address()
+
 	keep.signatureSubmittedEvents = append(
 		keep.signatureSubmittedEvents,
 		&eth.SignatureSubmittedEvent{
-			Digest:     keep.latestDigest,
-			R:          rBytes,
-			S:          sBytes,
-			RecoveryID: uint8(signature.RecoveryID),
+			Digest:          digest,
+			R:               rBytes,
+			S:               sBytes,
+			RecoveryID:      uint8(signature.RecoveryID),
+			BlockNumber:     currentBlock,
+			TransactionHash: transactionHash,
 		},
 	)
 
 	return nil
 }
 
+// SetMemberETHBalance sets the ETH balance of the keep member available
+// for withdrawal.
+func (lc *localChain) SetMemberETHBalance(
+	keepAddress common.Address,
+	member common.Address,
+	balance *big.Int,
+) error {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	keep, ok := lc.keeps[keepAddress]
+	if !ok {
+		return fmt.Errorf(
+			"failed to find keep with address: [%s]",
+			keepAddress.String(),
+		)
+	}
+
+	keep.membersETHBalances[member] = balance
+
+	return nil
+}
+
+func (lc *localChain) GetMemberETHBalance(
+	keepAddress common.Address,
+	member common.Address,
+) (*big.Int, error) {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	keep, ok := lc.keeps[keepAddress]
+	if !ok {
+		return nil, fmt.Errorf(
+			"failed to find keep with address: [%s]",
+			keepAddress.String(),
+		)
+	}
+
+	balance, ok := keep.membersETHBalances[member]
+	if !ok {
+		return big.NewInt(0), nil
+	}
+
+	return balance, nil
+}
+
+func (lc *localChain) WithdrawMemberETHBalance(
+	keepAddress common.Address,
+	member common.Address,
+) error {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	keep, ok := lc.keeps[keepAddress]
+	if !ok {
+		return fmt.Errorf(
+			"failed to find keep with address: [%s]",
+			keepAddress.String(),
+		)
+	}
+
+	balance, ok := keep.membersETHBalances[member]
+	if !ok || balance.Sign() == 0 {
+		return fmt.Errorf(
+			"no balance to withdraw for member [%s]",
+			member.String(),
+		)
+	}
+
+	keep.membersETHBalances[member] = big.NewInt(0)
+
+	return nil
+}
+
 // IsAwaitingSignature checks if the keep is waiting for a signature to be
 // calculated for the given digest.
 func (lc *localChain) IsAwaitingSignature(
@@ -353,6 +462,107 @@ func (lc *localChain) GetKeepCount() (*big.Int, error) {
 	return big.NewInt(int64(len(lc.keeps))), nil
 }
 
+// SetAvailableUnbondedValue sets the operator's value available for bonding
+// in keeps of the given application.
+func (lc *localChain) SetAvailableUnbondedValue(
+	application common.Address,
+	value *big.Int,
+) {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	lc.unbondedValues[application] = value
+}
+
+func (lc *localChain) AvailableUnbondedValue(
+	application common.Address,
+) (*big.Int, error) {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	value, ok := lc.unbondedValues[application]
+	if !ok {
+		return big.NewInt(0), nil
+	}
+
+	return value, nil
+}
+
+// SetBondAmount sets the value of the operator's bond held by the keep.
+func (lc *localChain) SetBondAmount(
+	keepAddress common.Address,
+	amount *big.Int,
+) error {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	keep, ok := lc.keeps[keepAddress]
+	if !ok {
+		return fmt.Errorf(
+			"failed to find keep with address: [%s]",
+			keepAddress.String(),
+		)
+	}
+
+	keep.bondAmount = amount
+
+	return nil
+}
+
+func (lc *localChain) BondAmount(keepAddress common.Address) (*big.Int, error) {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	keep, ok := lc.keeps[keepAddress]
+	if !ok {
+		return nil, fmt.Errorf(
+			"failed to find keep with address: [%s]",
+			keepAddress.String(),
+		)
+	}
+
+	return keep.bondAmount, nil
+}
+
+func (lc *localChain) MinimumBond() (*big.Int, error) {
+	return lc.minimumBond, nil
+}
+
+// SetKeepApplication sets the application which opened the keep.
+func (lc *localChain) SetKeepApplication(
+	keepAddress common.Address,
+	application common.Address,
+) error {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	keep, ok := lc.keeps[keepAddress]
+	if !ok {
+		return fmt.Errorf(
+			"failed to find keep with address: [%s]",
+			keepAddress.String(),
+		)
+	}
+
+	keep.application = application
+
+	return nil
+}
+
+func (lc *localChain) KeepApplication(
+	keepAddress common.Address,
+) (common.Address, error) {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	keep, ok := lc.keeps[keepAddress]
+	if !ok {
+		return common.Address{}, nil
+	}
+
+	return keep.application, nil
+}
+
 func (lc *localChain) GetKeepAtIndex(
 	keepIndex *big.Int,
 ) (common.Address, error) {
@@ -444,7 +654,15 @@ func (lc *localChain) SignatureRequestedBlock(
 	keepAddress common.Address,
 	digest [32]byte,
 ) (uint64, error) {
-	panic("implement")
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	keep, ok := lc.keeps[keepAddress]
+	if !ok {
+		return 0, fmt.Errorf("no keep with address [%v]", keepAddress)
+	}
+
+	return keep.digests[digest], nil
 }
 
 func (lc *localChain) GetMembers(
@@ -467,7 +685,18 @@ func (lc *localChain) GetHonestThreshold(
 }
 
 func (lc *localChain) GetOpenedTimestamp(keepAddress common.Address) (time.Time, error) {
-	panic("implement")
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	keep, ok := lc.keeps[keepAddress]
+	if !ok {
+		return time.Unix(0, 0), fmt.Errorf(
+			"no keep with address [%v]",
+			keepAddress.String(),
+		)
+	}
+
+	return keep.openedTimestamp, nil
 }
 
 func (lc *localChain) PastSignatureSubmittedEvents(
@@ -494,6 +723,23 @@ func (lc *localChain) BlockTimestamp(blockNumber *big.Int) (uint64, error) {
 	return blockTimestamp.(uint64), nil
 }
 
+func (lc *localChain) TransactionSender(
+	transactionHash common.Hash,
+) (common.Address, error) {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	sender, ok := lc.transactionSenders[transactionHash]
+	if !ok {
+		return common.Address{}, fmt.Errorf(
+			"no transaction with hash [%s]",
+			transactionHash.String(),
+		)
+	}
+
+	return sender, nil
+}
+
 func generateHandlerID() int {
 	// #nosec G404 (insecure random number source (rand))
 	// Local chain implementation doesn't require secure randomness.
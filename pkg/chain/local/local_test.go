@@ -221,6 +221,9 @@ func TestSubmitSignature(t *testing.T) {
 
 	lastEvent := events[len(events)-1]
 
+	// The transaction hash is checked by resolving the transaction sender.
+	expectedEvent.TransactionHash = lastEvent.TransactionHash
+
 	if !reflect.DeepEqual(expectedEvent, lastEvent) {
 		t.Fatalf(
 			"unexpected signature submitted event\nexpected: [%+v]\nactual:   [%+v]",
@@ -228,6 +231,19 @@ func TestSubmitSignature(t *testing.T) {
 			lastEvent,
 		)
 	}
+
+	sender, err := chain.TransactionSender(lastEvent.TransactionHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if sender != chain.Address() {
+		t.Errorf(
+			"unexpected transaction sender\nexpected: [%v]\nactual:   [%v]",
+			chain.Address(),
+			sender,
+		)
+	}
 }
 
 func TestIsAwaitingSignature(t *testing.T) {
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand"
+	"sort"
 	"sync"
 
 	chain "github.com/keep-network/keep-ecdsa/pkg/chain"
@@ -21,9 +22,10 @@ const (
 )
 
 type localDeposit struct {
-	keepAddress string
-	pubkey      []byte
-	state       chain.DepositState
+	keepAddress  string
+	pubkey       []byte
+	state        chain.DepositState
+	createdBlock uint64
 
 	utxoValue           *big.Int
 	redemptionDigest    [32]byte
@@ -122,9 +124,15 @@ func (tlc *TBTCLocalChain) CreateDeposit(
 	keepAddress := generateAddress()
 	tlc.OpenKeep(keepAddress, signers)
 
+	currentBlock, err := tlc.BlockCounter().CurrentBlock()
+	if err != nil {
+		panic(err) // should never happen
+	}
+
 	tlc.deposits[depositAddress] = &localDeposit{
 		keepAddress:               keepAddress.Hex(),
 		state:                     chain.AwaitingSignerSetup,
+		createdBlock:              currentBlock,
 		utxoValue:                 big.NewInt(defaultUTXOValue),
 		redemptionRequestedEvents: make([]*chain.DepositRedemptionRequestedEvent, 0),
 	}
@@ -292,6 +300,39 @@ func (tlc *TBTCLocalChain) OnDepositRedeemed(
 	})
 }
 
+func (tlc *TBTCLocalChain) PastDepositCreatedEvents(
+	startBlock uint64,
+	keepAddresses []string,
+) ([]*chain.DepositCreatedEvent, error) {
+	tlc.tbtcLocalChainMutex.Lock()
+	defer tlc.tbtcLocalChainMutex.Unlock()
+
+	result := make([]*chain.DepositCreatedEvent, 0)
+
+	for depositAddress, deposit := range tlc.deposits {
+		if deposit.createdBlock < startBlock {
+			continue
+		}
+
+		for _, keepAddress := range keepAddresses {
+			if common.HexToAddress(keepAddress) ==
+				common.HexToAddress(deposit.keepAddress) {
+				result = append(result, &chain.DepositCreatedEvent{
+					DepositAddress: depositAddress,
+					KeepAddress:    deposit.keepAddress,
+					BlockNumber:    deposit.createdBlock,
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].BlockNumber < result[j].BlockNumber
+	})
+
+	return result, nil
+}
+
 func (tlc *TBTCLocalChain) PastDepositRedemptionRequestedEvents(
 	startBlock uint64,
 	depositAddress string,
@@ -93,6 +93,15 @@ type TBTCSystem interface {
 		handler func(depositAddress string),
 	) subscription.EventSubscription
 
+	// PastDepositCreatedEvents returns all deposit created events for the
+	// given keeps which occurred after the provided start block.
+	// All implementations should returns those events sorted by the
+	// block number in the ascending order.
+	PastDepositCreatedEvents(
+		startBlock uint64,
+		keepAddresses []string,
+	) ([]*DepositCreatedEvent, error)
+
 	// PastDepositRedemptionRequestedEvents returns all redemption requested
 	// events for the given deposit which occurred after the provided start block.
 	// All implementations should returns those events sorted by the
@@ -103,6 +112,14 @@ type TBTCSystem interface {
 	) ([]*DepositRedemptionRequestedEvent, error)
 }
 
+// DepositCreatedEvent is an event emitted when a new deposit has been created
+// and a keep has been opened for it.
+type DepositCreatedEvent struct {
+	DepositAddress string
+	KeepAddress    string
+	BlockNumber    uint64
+}
+
 // DepositRedemptionRequestedEvent is an event emitted when a deposit
 // redemption has been requested or the redemption fee has been increased.
 type DepositRedemptionRequestedEvent struct {
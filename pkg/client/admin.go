@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// KeyGenerationsInProgress returns addresses of keeps for which the client
+// is currently generating a key.
+func (h *Handle) KeyGenerationsInProgress() []common.Address {
+	return h.eventDeduplicator.KeyGenInProgress()
+}
+
+// SigningsInProgress returns digests the client is currently calculating
+// signatures for, grouped by the keep address.
+func (h *Handle) SigningsInProgress() map[common.Address][][32]byte {
+	return h.eventDeduplicator.SigningInProgress()
+}
+
+// RecheckKeep forces the client to check if the keep awaits a signature and
+// to start signing if so. The check is executed in the background.
+func (h *Handle) RecheckKeep(keepAddress common.Address) error {
+	if _, err := h.keepsRegistry.GetSigner(keepAddress); err != nil {
+		return err
+	}
+
+	logger.Infof("forced re-check of keep [%s]", keepAddress.String())
+
+	go checkAwaitingSignature(
+		h.ethereumChain,
+		h.clientConfig,
+		h.tssNode,
+		keepAddress,
+		h.keepsRegistry,
+		h.eventDeduplicator,
+		h.journal,
+		h.signingAuthorization,
+	)
+
+	return nil
+}
+
+// KeyRefreshesInProgress returns addresses of keeps for which the client is
+// currently refreshing the key.
+func (h *Handle) KeyRefreshesInProgress() []common.Address {
+	return h.eventDeduplicator.KeyRefreshInProgress()
+}
+
+// RefreshKeep forces the client to refresh key shares of the keep with other
+// members of the keep. The key refresh is executed in the background.
+func (h *Handle) RefreshKeep(keepAddress common.Address) error {
+	if _, err := h.keepsRegistry.GetSigner(keepAddress); err != nil {
+		return err
+	}
+
+	logger.Infof("forced key refresh of keep [%s]", keepAddress.String())
+
+	go refreshKeyForKeep(
+		context.Background(),
+		h.ethereumChain,
+		h.tssNode,
+		h.keepsRegistry,
+		h.eventDeduplicator,
+		h.journal,
+		keepAddress,
+	)
+
+	return nil
+}
+
+// ArchiveKeep archives the keep once it is confirmed on-chain that the keep
+// is no longer active and stops the monitoring of its events. The keep is
+// checked to be inactive before returning; awaiting the required number of
+// block confirmations is executed in the background.
+func (h *Handle) ArchiveKeep(keepAddress common.Address) error {
+	if !h.keepsRegistry.HasSigner(keepAddress) {
+		return fmt.Errorf(
+			"keep [%s] is not registered",
+			keepAddress.String(),
+		)
+	}
+
+	isActive, err := h.ethereumChain.IsActive(keepAddress)
+	if err != nil {
+		return fmt.Errorf("failed to check if keep is active: [%v]", err)
+	}
+
+	if isActive {
+		return fmt.Errorf("keep [%s] is still active", keepAddress.String())
+	}
+
+	if shouldHandle := h.eventDeduplicator.NotifyClosingStarted(keepAddress); !shouldHandle {
+		return fmt.Errorf(
+			"keep [%s] is already being archived",
+			keepAddress.String(),
+		)
+	}
+
+	go func() {
+		defer h.eventDeduplicator.NotifyClosingCompleted(keepAddress)
+
+		if isInactivityConfirmed := confirmIsInactive(h.ethereumChain, keepAddress); !isInactivityConfirmed {
+			logger.Warningf(
+				"could not confirm that keep [%s] is no longer active; "+
+					"keep will not be archived",
+				keepAddress.String(),
+			)
+			return
+		}
+
+		logger.Infof(
+			"confirmed that keep [%s] is no longer active; archiving on "+
+				"operator's request",
+			keepAddress.String(),
+		)
+
+		archiveKeep(h.keepsRegistry, h.keepMonitors, keepAddress)
+	}()
+
+	return nil
+}
@@ -11,15 +11,19 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ipfs/go-log"
 
-	"github.com/keep-network/keep-common/pkg/persistence"
 	"github.com/keep-network/keep-common/pkg/subscription"
 	"github.com/keep-network/keep-core/pkg/net"
 	"github.com/keep-network/keep-core/pkg/operator"
+	"github.com/keep-network/keep-ecdsa/pkg/audit"
+	"github.com/keep-network/keep-ecdsa/pkg/authorization"
 	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
 	"github.com/keep-network/keep-ecdsa/pkg/client/event"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+	"github.com/keep-network/keep-ecdsa/pkg/metrics/collector"
 	"github.com/keep-network/keep-ecdsa/pkg/node"
 	"github.com/keep-network/keep-ecdsa/pkg/registry"
+	"github.com/keep-network/keep-ecdsa/pkg/reliability"
+	"github.com/keep-network/keep-ecdsa/pkg/rewards"
 	"github.com/keep-network/keep-ecdsa/pkg/utils"
 )
 
@@ -39,7 +43,17 @@ const awaitingSignatureEventCheckTimeout = 60 * time.Second
 
 // Handle represents a handle to the ECDSA client.
 type Handle struct {
-	tssNode *node.Node
+	ethereumChain        eth.Handle
+	clientConfig         *Config
+	tssNode              *node.Node
+	keepsRegistry        *registry.Keeps
+	eventDeduplicator    *event.Deduplicator
+	journal              *registry.Journal
+	signingAuthorization *authorization.Engine
+	keepMonitors         *keepMonitors
+	rewardsWithdrawer    *rewards.Withdrawer
+	peerReliability      *reliability.Tracker
+	metrics              *collector.Collector
 }
 
 // TSSPreParamsPoolSize returns the current size of the TSS params pool.
@@ -47,24 +61,94 @@ func (h *Handle) TSSPreParamsPoolSize() int {
 	return h.tssNode.TSSPreParamsPoolSize()
 }
 
+// RewardsWithdrawalsCount returns the number of rewards withdrawals submitted
+// by the client. It returns zero if automatic withdrawals are disabled.
+func (h *Handle) RewardsWithdrawalsCount() uint64 {
+	if h.rewardsWithdrawer == nil {
+		return 0
+	}
+
+	return h.rewardsWithdrawer.WithdrawalsCount()
+}
+
+// RewardsWithdrawnValue returns the total value in wei of rewards withdrawals
+// submitted by the client. It returns zero if automatic withdrawals are
+// disabled.
+func (h *Handle) RewardsWithdrawnValue() *big.Int {
+	if h.rewardsWithdrawer == nil {
+		return big.NewInt(0)
+	}
+
+	return h.rewardsWithdrawer.WithdrawnValue()
+}
+
+// MetricsCollector returns the collector of key generation, signing and
+// extension action metrics.
+func (h *Handle) MetricsCollector() *collector.Collector {
+	return h.metrics
+}
+
+// PeerReliability returns the tracker of faults of peer members of keeps
+// the client is a member of.
+func (h *Handle) PeerReliability() *reliability.Tracker {
+	return h.peerReliability
+}
+
+// KeepsRegistry returns the registry of keeps the client is a member of.
+func (h *Handle) KeepsRegistry() *registry.Keeps {
+	return h.keepsRegistry
+}
+
+// KeepFactory is a keep factory the client serves keeps of. Chain is
+// the chain handle operating on the factory contract and SanctionedApplications
+// are applications selected by the operator for which operator will be
+// registered as a member candidate in that factory.
+type KeepFactory struct {
+	Chain                  eth.Handle
+	SanctionedApplications []common.Address
+}
+
 // Initialize initializes the ECDSA client with rules related to events handling.
-// Expects a slice of sanctioned applications selected by the operator for which
-// operator will be registered as a member candidate.
+// Expects a slice of keep factories the client should serve keeps of. Keeps
+// already known to the client are handled with the provided ethereum chain
+// handle as all keeps, no matter which factory created them, share the same
+// contract interface. Signatures calculated by the client are recorded in
+// the provided audit log. Each signing request is authorized with the provided
+// signing policies before the signature is calculated.
 func Initialize(
 	ctx context.Context,
 	operatorPublicKey *operator.PublicKey,
 	ethereumChain eth.Handle,
 	networkProvider net.Provider,
-	persistence persistence.Handle,
-	sanctionedApplications []common.Address,
+	storage registry.Storage,
+	auditLog *audit.Log,
+	signingPolicies []authorization.Policy,
+	keepFactories []*KeepFactory,
 	clientConfig *Config,
 	tssConfig *tss.Config,
 ) *Handle {
-	keepsRegistry := registry.NewKeepsRegistry(persistence)
+	keepsRegistry := registry.NewKeepsRegistry(storage)
+	keepMonitors := newKeepMonitors()
+
+	metrics := collector.NewCollector()
+
+	peerReliability := reliability.NewTracker(storage)
 
-	tssNode := node.NewNode(ethereumChain, networkProvider, tssConfig)
+	tssNode := node.NewNode(
+		ethereumChain,
+		networkProvider,
+		tssConfig,
+		metrics,
+		auditLog,
+		peerReliability,
+		&node.RetryPolicies{
+			Protocol:   clientConfig.GetProtocolRetryPolicy(),
+			Chain:      clientConfig.GetChainRetryPolicy(),
+			Submission: clientConfig.GetSubmissionRetryPolicy(),
+		},
+	)
 
-	tssNode.InitializeTSSPreParamsPool()
+	tssNode.InitializeTSSPreParamsPool(storage)
 
 	eventDeduplicator := event.NewDeduplicator(
 		keepsRegistry,
@@ -74,32 +158,15 @@ func Initialize(
 	// Load current keeps' signers from storage and register for signing events.
 	keepsRegistry.LoadExistingKeeps()
 
-	confirmIsInactive := func(keepAddress common.Address) bool {
-		currentBlock, err := ethereumChain.BlockCounter().CurrentBlock()
-		if err != nil {
-			logger.Errorf("failed to get current block height [%v]", err)
-			return false
-		}
-
-		isKeepActive, err := chainutil.WaitForBlockConfirmations(
-			ethereumChain.BlockCounter(),
-			currentBlock,
-			blockConfirmations,
-			func() (bool, error) {
-				return ethereumChain.IsActive(keepAddress)
-			},
-		)
-		if err != nil {
-			logger.Errorf(
-				"failed to confirm that keep [%s] is inactive: [%v]",
-				keepAddress.String(),
-				err,
-			)
-			return false
-		}
+	// Load key generations and signings which were pending when the client
+	// was stopped. They are resumed or abandoned depending on the chain state.
+	journal := registry.NewJournal(storage)
+	journal.Load()
 
-		return !isKeepActive
-	}
+	signingAuthorization := authorization.NewEngine(
+		metrics.SigningAuthorization,
+		signingPolicies...,
+	)
 
 	for _, keepAddress := range keepsRegistry.GetKeepsAddresses() {
 		go func(keepAddress common.Address) {
@@ -119,12 +186,12 @@ func Initialize(
 					"keep [%s] seems no longer active; confirming",
 					keepAddress.String(),
 				)
-				if isInactivityConfirmed := confirmIsInactive(keepAddress); isInactivityConfirmed {
+				if isInactivityConfirmed := confirmIsInactive(ethereumChain, keepAddress); isInactivityConfirmed {
 					logger.Infof(
 						"confirmed that keep [%s] is no longer active; archiving",
 						keepAddress.String(),
 					)
-					keepsRegistry.UnregisterKeep(keepAddress)
+					archiveKeep(keepsRegistry, keepMonitors, keepAddress)
 					return
 				}
 				logger.Warningf("keep [%s] is still active", keepAddress.String())
@@ -142,106 +209,183 @@ func Initialize(
 				return
 			}
 
-			subscriptionOnSignatureRequested, err := monitorSigningRequests(
+			monitorKeepEvents(
+				ctx,
 				ethereumChain,
 				clientConfig,
 				tssNode,
 				keepAddress,
 				signer,
-				eventDeduplicator,
-			)
-			if err != nil {
-				logger.Errorf(
-					"failed registering for requested signature event for keep [%s]: [%v]",
-					keepAddress.String(),
-					err,
-				)
-				// In case of an error we want to avoid subscribing to keep
-				// closed events. Something is wrong and we should stop
-				// further processing.
-				return
-			}
-			go monitorKeepClosedEvents(
-				ethereumChain,
-				keepAddress,
-				keepsRegistry,
-				subscriptionOnSignatureRequested,
-				eventDeduplicator,
-			)
-			go monitorKeepTerminatedEvent(
-				ethereumChain,
-				keepAddress,
 				keepsRegistry,
-				subscriptionOnSignatureRequested,
+				keepMonitors,
 				eventDeduplicator,
+				journal,
+				signingAuthorization,
 			)
-
 		}(keepAddress)
 	}
 
-	go checkAwaitingKeyGeneration(
+	go checkPendingKeyGenerations(
 		ctx,
 		ethereumChain,
 		clientConfig,
 		tssNode,
 		operatorPublicKey,
 		keepsRegistry,
+		keepMonitors,
 		eventDeduplicator,
+		journal,
+		signingAuthorization,
 	)
 
-	// Watch for new keeps creation.
-	_ = ethereumChain.OnBondedECDSAKeepCreated(func(event *eth.BondedECDSAKeepCreatedEvent) {
-		logger.Infof(
-			"new keep [%s] created with members: [%x] at block [%d]",
-			event.KeepAddress.String(),
-			event.Members,
-			event.BlockNumber,
+	for _, keepFactory := range keepFactories {
+		go checkAwaitingKeyGeneration(
+			ctx,
+			keepFactory.Chain,
+			clientConfig,
+			tssNode,
+			operatorPublicKey,
+			keepsRegistry,
+			keepMonitors,
+			eventDeduplicator,
+			journal,
+			signingAuthorization,
 		)
 
-		if event.IsMember(ethereumChain.Address()) {
-			go func(event *eth.BondedECDSAKeepCreatedEvent) {
-				if shouldHandle := eventDeduplicator.NotifyKeyGenStarted(event.KeepAddress); !shouldHandle {
-					logger.Infof(
-						"key generation request for keep [%s] already handled",
-						event.KeepAddress.String(),
-					)
+		// Watch for new keeps creation.
+		_ = keepFactory.Chain.OnBondedECDSAKeepCreated(func(event *eth.BondedECDSAKeepCreatedEvent) {
+			logger.Infof(
+				"new keep [%s] created with members: [%x] at block [%d]",
+				event.KeepAddress.String(),
+				event.Members,
+				event.BlockNumber,
+			)
 
-					// currently handling or already handled in the past
-					// in case this event is a duplicate.
-					return
-				}
-				defer eventDeduplicator.NotifyKeyGenCompleted(event.KeepAddress)
+			if event.IsMember(ethereumChain.Address()) {
+				go func(event *eth.BondedECDSAKeepCreatedEvent) {
+					if shouldHandle := eventDeduplicator.NotifyKeyGenStarted(event.KeepAddress); !shouldHandle {
+						logger.Infof(
+							"key generation request for keep [%s] already handled",
+							event.KeepAddress.String(),
+						)
 
-				generateKeyForKeep(
-					ctx,
-					ethereumChain,
-					clientConfig,
-					tssNode,
-					operatorPublicKey,
-					keepsRegistry,
-					eventDeduplicator,
-					event.KeepAddress,
-					event.Members,
-					event.HonestThreshold,
+						// currently handling or already handled in the past
+						// in case this event is a duplicate.
+						return
+					}
+					defer eventDeduplicator.NotifyKeyGenCompleted(event.KeepAddress)
+
+					generateKeyForKeep(
+						ctx,
+						ethereumChain,
+						clientConfig,
+						tssNode,
+						operatorPublicKey,
+						keepsRegistry,
+						keepMonitors,
+						eventDeduplicator,
+						journal,
+						signingAuthorization,
+						event.KeepAddress,
+						event.Members,
+						event.HonestThreshold,
+					)
+				}(event)
+			} else {
+				logger.Infof(
+					"not a signing group member in keep [%s], skipping",
+					event.KeepAddress.String(),
 				)
-			}(event)
-		} else {
-			logger.Infof(
-				"not a signing group member in keep [%s], skipping",
-				event.KeepAddress.String(),
+			}
+		})
+
+		for _, application := range keepFactory.SanctionedApplications {
+			go checkStatusAndRegisterForApplication(
+				ctx,
+				keepFactory.Chain,
+				application,
+				clientConfig.GetChainRetryPolicy(),
 			)
 		}
-	})
+	}
 
-	for _, application := range sanctionedApplications {
-		go checkStatusAndRegisterForApplication(ctx, ethereumChain, application)
+	if clientConfig.GetKeyRefreshAge() > 0 {
+		go monitorKeyRefreshSchedule(
+			ctx,
+			ethereumChain,
+			clientConfig,
+			tssNode,
+			keepsRegistry,
+			eventDeduplicator,
+			journal,
+		)
+	} else {
+		logger.Infof("scheduled key refresh is disabled")
+	}
+
+	var rewardsWithdrawer *rewards.Withdrawer
+	if threshold := clientConfig.GetRewardsWithdrawalThreshold(); threshold != nil {
+		rewardsWithdrawer = rewards.NewWithdrawer(
+			ethereumChain,
+			keepsRegistry,
+			threshold,
+		)
+		rewardsWithdrawer.Start(
+			ctx,
+			clientConfig.GetRewardsCheckInterval(),
+			clientConfig.GetChainRetryPolicy(),
+		)
+	} else {
+		logger.Infof("automatic rewards withdrawals are disabled")
 	}
 
 	return &Handle{
-		tssNode: tssNode,
+		ethereumChain:        ethereumChain,
+		clientConfig:         clientConfig,
+		tssNode:              tssNode,
+		keepsRegistry:        keepsRegistry,
+		eventDeduplicator:    eventDeduplicator,
+		journal:              journal,
+		signingAuthorization: signingAuthorization,
+		keepMonitors:         keepMonitors,
+		rewardsWithdrawer:    rewardsWithdrawer,
+		peerReliability:      peerReliability,
+		metrics:              metrics,
 	}
 }
 
+// confirmIsInactive confirms with the required number of block confirmations
+// that the keep is no longer active.
+func confirmIsInactive(
+	ethereumChain eth.Handle,
+	keepAddress common.Address,
+) bool {
+	currentBlock, err := ethereumChain.BlockCounter().CurrentBlock()
+	if err != nil {
+		logger.Errorf("failed to get current block height [%v]", err)
+		return false
+	}
+
+	isKeepActive, err := chainutil.WaitForBlockConfirmations(
+		ethereumChain.BlockCounter(),
+		currentBlock,
+		blockConfirmations,
+		func() (bool, error) {
+			return ethereumChain.IsActive(keepAddress)
+		},
+	)
+	if err != nil {
+		logger.Errorf(
+			"failed to confirm that keep [%s] is inactive: [%v]",
+			keepAddress.String(),
+			err,
+		)
+		return false
+	}
+
+	return !isKeepActive
+}
+
 func checkAwaitingKeyGeneration(
 	ctx context.Context,
 	ethereumChain eth.Handle,
@@ -249,7 +393,10 @@ func checkAwaitingKeyGeneration(
 	tssNode *node.Node,
 	operatorPublicKey *operator.PublicKey,
 	keepsRegistry *registry.Keeps,
+	keepMonitors *keepMonitors,
 	eventDeduplicator *event.Deduplicator,
+	journal *registry.Journal,
+	signingAuthorization *authorization.Engine,
 ) {
 	keepCount, err := ethereumChain.GetKeepCount()
 	if err != nil {
@@ -309,7 +456,10 @@ func checkAwaitingKeyGeneration(
 			tssNode,
 			operatorPublicKey,
 			keepsRegistry,
+			keepMonitors,
 			eventDeduplicator,
+			journal,
+			signingAuthorization,
 			keep,
 		)
 		if err != nil {
@@ -329,7 +479,10 @@ func checkAwaitingKeyGenerationForKeep(
 	tssNode *node.Node,
 	operatorPublicKey *operator.PublicKey,
 	keepsRegistry *registry.Keeps,
+	keepMonitors *keepMonitors,
 	eventDeduplicator *event.Deduplicator,
+	journal *registry.Journal,
+	signingAuthorization *authorization.Engine,
 	keep common.Address,
 ) error {
 	publicKey, err := ethereumChain.GetPublicKey(keep)
@@ -369,18 +522,35 @@ func checkAwaitingKeyGenerationForKeep(
 
 	for _, member := range members {
 		if ethereumChain.Address() == member {
-			go generateKeyForKeep(
-				ctx,
-				ethereumChain,
-				clientConfig,
-				tssNode,
-				operatorPublicKey,
-				keepsRegistry,
-				eventDeduplicator,
-				keep,
-				members,
-				honestThreshold,
-			)
+			go func() {
+				// The same keep may be checked both from the lookback
+				// period scan and from the journal of pending key
+				// generations.
+				if shouldHandle := eventDeduplicator.NotifyKeyGenStarted(keep); !shouldHandle {
+					logger.Infof(
+						"key generation for keep [%s] already handled",
+						keep.String(),
+					)
+					return
+				}
+				defer eventDeduplicator.NotifyKeyGenCompleted(keep)
+
+				generateKeyForKeep(
+					ctx,
+					ethereumChain,
+					clientConfig,
+					tssNode,
+					operatorPublicKey,
+					keepsRegistry,
+					keepMonitors,
+					eventDeduplicator,
+					journal,
+					signingAuthorization,
+					keep,
+					members,
+					honestThreshold,
+				)
+			}()
 
 			break
 		}
@@ -396,26 +566,18 @@ func generateKeyForKeep(
 	tssNode *node.Node,
 	operatorPublicKey *operator.PublicKey,
 	keepsRegistry *registry.Keeps,
+	keepMonitors *keepMonitors,
 	eventDeduplicator *event.Deduplicator,
+	journal *registry.Journal,
+	signingAuthorization *authorization.Engine,
 	keepAddress common.Address,
 	members []common.Address,
 	honestThreshold uint64,
 ) {
-	if len(members) < 2 {
-		// TODO: #408 Implement single signer support.
+	if honestThreshold < 1 || honestThreshold > uint64(len(members)) {
 		logger.Errorf(
-			"keep [%s] has [%d] members; only keeps with at least 2 members are supported",
-			keepAddress.String(),
-			len(members),
-		)
-		return
-	}
-
-	if honestThreshold != uint64(len(members)) {
-		// TODO: #325 Implement threshold support.
-		logger.Errorf(
-			"keep [%s] has honest threshold [%s] and [%d] members; "+
-				"only keeps with honest threshold same as group size are supported",
+			"keep [%s] has honest threshold [%d] and [%d] members; "+
+				"honest threshold should be between 1 and group size",
 			keepAddress.String(),
 			honestThreshold,
 			len(members),
@@ -429,6 +591,18 @@ func generateKeyForKeep(
 		keepAddress.String(),
 	)
 
+	if err := journal.RecordKeyGenerationStarted(
+		keepAddress,
+		members,
+		honestThreshold,
+	); err != nil {
+		logger.Errorf(
+			"failed to record pending key generation for keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+	}
+
 	signer, err := generateSignerForKeep(
 		ctx,
 		clientConfig,
@@ -436,8 +610,19 @@ func generateKeyForKeep(
 		operatorPublicKey,
 		keepAddress,
 		members,
+		honestThreshold,
 		keepsRegistry,
 	)
+
+	// Key generation either succeeded or has been given up. In both cases
+	// there is nothing to resume after a restart.
+	if err := journal.RecordKeyGenerationCompleted(keepAddress); err != nil {
+		logger.Errorf(
+			"failed to record completed key generation for keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+	}
 	if err != nil {
 		logger.Errorf(
 			"failed to generate signer for keep [%s]: [%v]",
@@ -463,13 +648,49 @@ func generateKeyForKeep(
 		return
 	}
 
-	subscriptionOnSignatureRequested, err := monitorSigningRequests(
+	monitorKeepEvents(
+		ctx,
 		ethereumChain,
 		clientConfig,
 		tssNode,
 		keepAddress,
 		signer,
+		keepsRegistry,
+		keepMonitors,
+		eventDeduplicator,
+		journal,
+		signingAuthorization,
+	)
+}
+
+// monitorKeepEvents registers for events emitted by the keep the client holds
+// a signer for: signing requests, key refresh requests, keep closure and
+// termination. All subscriptions are cancelled once the keep is archived,
+// after it has been closed, terminated or archived on the operator's request.
+func monitorKeepEvents(
+	ctx context.Context,
+	ethereumChain eth.Handle,
+	clientConfig *Config,
+	tssNode *node.Node,
+	keepAddress common.Address,
+	signer *tss.ThresholdSigner,
+	keepsRegistry *registry.Keeps,
+	keepMonitors *keepMonitors,
+	eventDeduplicator *event.Deduplicator,
+	journal *registry.Journal,
+	signingAuthorization *authorization.Engine,
+) {
+	monitoringCtx := keepMonitors.start(ctx, keepAddress)
+
+	subscriptionOnSignatureRequested, err := monitorSigningRequests(
+		ethereumChain,
+		clientConfig,
+		tssNode,
+		keepAddress,
+		keepsRegistry,
 		eventDeduplicator,
+		journal,
+		signingAuthorization,
 	)
 	if err != nil {
 		logger.Errorf(
@@ -482,21 +703,40 @@ func generateKeyForKeep(
 		// In case of an error we want to avoid subscribing to keep
 		// closed events. Something is wrong and we should stop
 		// further processing.
+		keepMonitors.stop(keepAddress)
 		return
 	}
 
+	go func() {
+		<-monitoringCtx.Done()
+		subscriptionOnSignatureRequested.Unsubscribe()
+	}()
+
+	monitorKeyRefreshRequests(
+		monitoringCtx,
+		ethereumChain,
+		tssNode,
+		keepAddress,
+		signer,
+		keepsRegistry,
+		eventDeduplicator,
+		journal,
+	)
+
 	go monitorKeepClosedEvents(
+		monitoringCtx,
 		ethereumChain,
 		keepAddress,
 		keepsRegistry,
-		subscriptionOnSignatureRequested,
+		keepMonitors,
 		eventDeduplicator,
 	)
 	go monitorKeepTerminatedEvent(
+		monitoringCtx,
 		ethereumChain,
 		keepAddress,
 		keepsRegistry,
-		subscriptionOnSignatureRequested,
+		keepMonitors,
 		eventDeduplicator,
 	)
 }
@@ -508,6 +748,7 @@ func generateSignerForKeep(
 	operatorPublicKey *operator.PublicKey,
 	keepAddress common.Address,
 	members []common.Address,
+	honestThreshold uint64,
 	keepsRegistry *registry.Keeps,
 ) (*tss.ThresholdSigner, error) {
 	keygenCtx, cancel := context.WithTimeout(ctx, clientConfig.GetKeyGenerationTimeout())
@@ -518,6 +759,7 @@ func generateSignerForKeep(
 		operatorPublicKey,
 		keepAddress,
 		members,
+		honestThreshold,
 		keepsRegistry,
 	)
 }
@@ -529,16 +771,20 @@ func monitorSigningRequests(
 	clientConfig *Config,
 	tssNode *node.Node,
 	keepAddress common.Address,
-	signer *tss.ThresholdSigner,
+	keepsRegistry *registry.Keeps,
 	eventDeduplicator *event.Deduplicator,
+	journal *registry.Journal,
+	signingAuthorization *authorization.Engine,
 ) (subscription.EventSubscription, error) {
 	go checkAwaitingSignature(
 		ethereumChain,
 		clientConfig,
 		tssNode,
 		keepAddress,
-		signer,
+		keepsRegistry,
 		eventDeduplicator,
+		journal,
+		signingAuthorization,
 	)
 
 	return ethereumChain.OnSignatureRequested(
@@ -552,6 +798,9 @@ func monitorSigningRequests(
 			)
 
 			go func(event *eth.SignatureRequestedEvent) {
+				isHandled := false
+				isSigned := false
+
 				err := utils.DoWithDefaultRetry(
 					clientConfig.GetSigningTimeout(),
 					// TODO: extract the code into a separate function and see if
@@ -582,6 +831,7 @@ func monitorSigningRequests(
 							return nil
 						}
 
+						isHandled = true
 						defer eventDeduplicator.NotifySigningCompleted(keepAddress, event.Digest)
 
 						isAwaitingSignature, err := chainutil.WaitForBlockConfirmations(
@@ -613,175 +863,439 @@ func monitorSigningRequests(
 							return nil
 						}
 
-						if err := tssNode.CalculateSignature(
+						isAuthorized, err := isSigningAuthorized(
+							ctx,
+							signingAuthorization,
+							keepAddress,
+							event.Digest,
+							event.BlockNumber,
+						)
+						if err != nil {
+							return err
+						}
+						if !isAuthorized {
+							// the refusal is final, there is no point in retrying
+							return nil
+						}
+
+						if err := calculateSignature(
 							ctx,
-							signer,
+							tssNode,
+							keepAddress,
+							keepsRegistry,
 							event.Digest,
+							event.BlockNumber,
+							journal,
 						); err != nil {
-							logger.Errorf(
-								"signature calculation failed for keep [%s]: [%v]",
-								keepAddress.String(),
-								err,
-							)
+							return err
 						}
 
-						return err
+						isSigned = true
+						return nil
 					},
 				)
 				if err != nil {
 					logger.Errorf("failed to generate a signature: [%v]", err)
 				}
+
+				if isSigned {
+					tssNode.ObserveSignatureConfirmation(event.BlockNumber)
+				}
+
+				// Signing either succeeded or has been given up. In both
+				// cases there is nothing to resume after a restart.
+				if isHandled {
+					recordSigningCompleted(journal, keepAddress, event.Digest)
+				}
 			}(event)
 		},
 	)
 }
 
+// checkAwaitingSignature checks if the keep awaits a signature for its latest
+// digest or for any digest the signing of which has been interrupted according
+// to the journal. Signing is started for each digest the keep is still
+// awaiting a signature for.
 func checkAwaitingSignature(
 	ethereumChain eth.Handle,
 	clientConfig *Config,
 	tssNode *node.Node,
 	keepAddress common.Address,
-	signer *tss.ThresholdSigner,
+	keepsRegistry *registry.Keeps,
 	eventDeduplicator *event.Deduplicator,
+	journal *registry.Journal,
+	signingAuthorization *authorization.Engine,
 ) {
 	logger.Debugf("checking awaiting signature for keep [%s]", keepAddress.String())
 
+	digests := make(map[[32]byte]bool)
+
+	for _, pendingSigning := range journal.PendingSignings()[keepAddress] {
+		logger.Infof(
+			"found interrupted signing of digest [%+x] for keep [%s]",
+			pendingSigning.Digest,
+			keepAddress.String(),
+		)
+		digests[pendingSigning.Digest] = true
+	}
+
 	latestDigest, err := ethereumChain.LatestDigest(keepAddress)
 	if err != nil {
 		logger.Errorf("could not get latest digest for keep [%s]", keepAddress.String())
-		return
+	} else {
+		digests[latestDigest] = true
+	}
+
+	for digest := range digests {
+		go checkAwaitingSignatureForDigest(
+			ethereumChain,
+			clientConfig,
+			tssNode,
+			keepAddress,
+			keepsRegistry,
+			eventDeduplicator,
+			journal,
+			signingAuthorization,
+			digest,
+		)
 	}
+}
 
-	isAwaitingDigest, err := ethereumChain.IsAwaitingSignature(keepAddress, latestDigest)
+func checkAwaitingSignatureForDigest(
+	ethereumChain eth.Handle,
+	clientConfig *Config,
+	tssNode *node.Node,
+	keepAddress common.Address,
+	keepsRegistry *registry.Keeps,
+	eventDeduplicator *event.Deduplicator,
+	journal *registry.Journal,
+	signingAuthorization *authorization.Engine,
+	digest [32]byte,
+) {
+	isAwaitingDigest, err := ethereumChain.IsAwaitingSignature(keepAddress, digest)
 	if err != nil {
 		logger.Errorf(
 			"could not check awaiting signature of "+
 				"digest [%+x] for keep [%s]",
-			latestDigest,
+			digest,
 			keepAddress.String(),
 		)
 		return
 	}
 
-	if isAwaitingDigest {
-		logger.Infof(
-			"awaiting a signature from keep [%s] for digest [%+x]",
+	if !isAwaitingDigest {
+		// The signature has been already provided or the keep is no longer
+		// awaiting it. If the signing was interrupted, there is nothing to
+		// resume.
+		recordSigningCompleted(journal, keepAddress, digest)
+		return
+	}
+
+	logger.Infof(
+		"awaiting a signature from keep [%s] for digest [%+x]",
+		keepAddress.String(),
+		digest,
+	)
+
+	isHandled := false
+
+	err = utils.DoWithDefaultRetry(
+		clientConfig.GetSigningTimeout(),
+		func(ctx context.Context) error {
+			shouldHandle, err := eventDeduplicator.NotifySigningStarted(
+				awaitingSignatureEventCheckTimeout,
+				keepAddress,
+				digest,
+			)
+			if err != nil {
+				logger.Errorf(
+					"could not deduplicate signing request event: [%v]",
+					err,
+				)
+				return err
+			}
+
+			if !shouldHandle {
+				logger.Infof(
+					"signing request for keep [%s] and digest [%+x] already handled",
+					keepAddress.String(),
+					digest,
+				)
+				// currently handling - it is possible that event
+				// subscription also received this event
+				return nil
+			}
+
+			isHandled = true
+			defer eventDeduplicator.NotifySigningCompleted(keepAddress, digest)
+
+			startBlock, err := ethereumChain.SignatureRequestedBlock(keepAddress, digest)
+			if err != nil {
+				logger.Errorf(
+					"failed to get signature request block height for keep [%s] and digest [%x]: [%v]",
+					keepAddress.String(),
+					digest,
+					err,
+				)
+				return err
+			}
+
+			isStillAwaitingSignature, err := chainutil.WaitForBlockConfirmations(
+				ethereumChain.BlockCounter(),
+				startBlock,
+				blockConfirmations,
+				func() (bool, error) {
+					isAwaitingSignature, err := ethereumChain.IsAwaitingSignature(keepAddress, digest)
+					if err != nil {
+						return false, err
+					}
+
+					isActive, err := ethereumChain.IsActive(keepAddress)
+					if err != nil {
+						return false, err
+					}
+
+					return (isAwaitingSignature && isActive), nil
+				},
+			)
+			if err != nil {
+				logger.Errorf(
+					"failed to confirm signing request for keep [%s] and digest [%+x]: [%v]",
+					keepAddress.String(),
+					digest,
+					err,
+				)
+				return err
+			}
+
+			if !isStillAwaitingSignature {
+				logger.Warningf(
+					"keep [%s] is not awaiting a signature for digest [%+x]",
+					keepAddress.String(),
+					digest,
+				)
+
+				// deeper chain reorg, nothing we should do
+				return nil
+			}
+
+			isAuthorized, err := isSigningAuthorized(
+				ctx,
+				signingAuthorization,
+				keepAddress,
+				digest,
+				startBlock,
+			)
+			if err != nil {
+				return err
+			}
+			if !isAuthorized {
+				// the refusal is final, there is no point in retrying
+				return nil
+			}
+
+			return calculateSignature(
+				ctx,
+				tssNode,
+				keepAddress,
+				keepsRegistry,
+				digest,
+				startBlock,
+				journal,
+			)
+		},
+	)
+	if err != nil {
+		logger.Errorf("failed to generate a signature: [%v]", err)
+	}
+
+	// Signing either succeeded or has been given up. In both cases there is
+	// nothing to resume after a restart.
+	if isHandled {
+		recordSigningCompleted(journal, keepAddress, digest)
+	}
+}
+
+// calculateSignature records the signing of the digest in the journal and
+// calculates the signature. The journal entry is removed once the signature
+// is successfully calculated and published.
+//
+// The signer is taken from the registry by the node for each signing attempt
+// so that the signer holding the most recently refreshed key share is used.
+func calculateSignature(
+	ctx context.Context,
+	tssNode *node.Node,
+	keepAddress common.Address,
+	keepsRegistry *registry.Keeps,
+	digest [32]byte,
+	requestBlockNumber uint64,
+	journal *registry.Journal,
+) error {
+	if _, err := keepsRegistry.GetSigner(keepAddress); err != nil {
+		logger.Errorf(
+			"no signer for keep [%s]: [%v]",
 			keepAddress.String(),
-			latestDigest,
+			err,
 		)
+		return err
+	}
 
-		err := utils.DoWithDefaultRetry(
-			clientConfig.GetSigningTimeout(),
-			func(ctx context.Context) error {
-				shouldHandle, err := eventDeduplicator.NotifySigningStarted(
-					awaitingSignatureEventCheckTimeout,
-					keepAddress,
-					latestDigest,
-				)
-				if err != nil {
-					logger.Errorf(
-						"could not deduplicate signing request event: [%v]",
-						err,
-					)
-					return err
-				}
+	if err := journal.RecordSigningStarted(keepAddress, digest); err != nil {
+		logger.Errorf(
+			"failed to record pending signing of digest [%+x] "+
+				"for keep [%s]: [%v]",
+			digest,
+			keepAddress.String(),
+			err,
+		)
+	}
 
-				if !shouldHandle {
-					logger.Infof(
-						"signing request for keep [%s] and digest [%+x] already handled",
-						keepAddress.String(),
-						latestDigest,
-					)
-					// currently handling - it is possible that event
-					// subscription also received this event
-					return nil
-				}
+	if err := tssNode.CalculateSignature(
+		ctx,
+		keepAddress,
+		keepsRegistry,
+		digest,
+		requestBlockNumber,
+	); err != nil {
+		logger.Errorf(
+			"signature calculation failed for keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+		return err
+	}
 
-				defer eventDeduplicator.NotifySigningCompleted(keepAddress, latestDigest)
+	recordSigningCompleted(journal, keepAddress, digest)
 
-				startBlock, err := ethereumChain.SignatureRequestedBlock(keepAddress, latestDigest)
-				if err != nil {
-					logger.Errorf(
-						"failed to get signature request block height for keep [%s] and digest [%x]: [%v]",
-						keepAddress.String(),
-						latestDigest,
-						err,
-					)
-					return err
-				}
+	return nil
+}
 
-				isStillAwaitingSignature, err := chainutil.WaitForBlockConfirmations(
-					ethereumChain.BlockCounter(),
-					startBlock,
-					blockConfirmations,
-					func() (bool, error) {
-						isAwaitingSignature, err := ethereumChain.IsAwaitingSignature(keepAddress, latestDigest)
-						if err != nil {
-							return false, err
-						}
+// isSigningAuthorized consults signing authorization policies before
+// the signature for the digest is calculated. Refusals and errors are logged
+// and counted by the authorization engine. An error is returned when policies
+// could not decide, so the check can be retried.
+func isSigningAuthorized(
+	ctx context.Context,
+	signingAuthorization *authorization.Engine,
+	keepAddress common.Address,
+	digest [32]byte,
+	requestBlockNumber uint64,
+) (bool, error) {
+	err := signingAuthorization.Authorize(ctx, &authorization.Request{
+		KeepAddress:  keepAddress,
+		Digest:       digest,
+		RequestBlock: requestBlockNumber,
+	})
+	if err != nil {
+		if authorization.IsRefusal(err) {
+			return false, nil
+		}
 
-						isActive, err := ethereumChain.IsActive(keepAddress)
-						if err != nil {
-							return false, err
-						}
+		return false, err
+	}
 
-						return (isAwaitingSignature && isActive), nil
-					},
-				)
-				if err != nil {
-					logger.Errorf(
-						"failed to confirm signing request for keep [%s] and digest [%+x]: [%v]",
-						keepAddress.String(),
-						latestDigest,
-						err,
-					)
-					return err
-				}
+	return true, nil
+}
 
-				if !isStillAwaitingSignature {
-					logger.Warningf(
-						"keep [%s] is not awaiting a signature for digest [%+x]",
-						keepAddress.String(),
-						latestDigest,
-					)
+func recordSigningCompleted(
+	journal *registry.Journal,
+	keepAddress common.Address,
+	digest [32]byte,
+) {
+	if err := journal.RecordSigningCompleted(keepAddress, digest); err != nil {
+		logger.Errorf(
+			"failed to record completed signing of digest [%+x] "+
+				"for keep [%s]: [%v]",
+			digest,
+			keepAddress.String(),
+			err,
+		)
+	}
+}
 
-					// deeper chain reorg, nothing we should do
-					return nil
-				}
+// checkPendingKeyGenerations resumes key generations interrupted by the client
+// restart if the keep is still active and awaits the public key. Otherwise,
+// the key generation is abandoned.
+func checkPendingKeyGenerations(
+	ctx context.Context,
+	ethereumChain eth.Handle,
+	clientConfig *Config,
+	tssNode *node.Node,
+	operatorPublicKey *operator.PublicKey,
+	keepsRegistry *registry.Keeps,
+	keepMonitors *keepMonitors,
+	eventDeduplicator *event.Deduplicator,
+	journal *registry.Journal,
+	signingAuthorization *authorization.Engine,
+) {
+	for keepAddress, pendingKeyGeneration := range journal.PendingKeyGenerations() {
+		logger.Infof(
+			"found key generation for keep [%s] started at [%s] and interrupted",
+			keepAddress.String(),
+			pendingKeyGeneration.StartedAt,
+		)
 
-				if err := tssNode.CalculateSignature(
-					ctx,
-					signer,
-					latestDigest,
-				); err != nil {
-					logger.Errorf(
-						"signature calculation failed for keep [%s]: [%v]",
-						keepAddress.String(),
-						err,
-					)
-				}
+		isActive, err := ethereumChain.IsActive(keepAddress)
+		if err != nil {
+			logger.Warningf(
+				"could not check if keep [%s] is still active: [%v]",
+				keepAddress.String(),
+				err,
+			)
+			continue
+		}
 
-				return err
-			},
+		if !isActive {
+			logger.Infof(
+				"keep [%s] is no longer active; abandoning key generation",
+				keepAddress.String(),
+			)
+			if err := journal.RecordKeyGenerationCompleted(keepAddress); err != nil {
+				logger.Errorf(
+					"failed to record abandoned key generation for keep [%s]: [%v]",
+					keepAddress.String(),
+					err,
+				)
+			}
+			continue
+		}
+
+		// Key generation is resumed only if the keep still awaits the public
+		// key and the key material has not been stored yet.
+		err = checkAwaitingKeyGenerationForKeep(
+			ctx,
+			ethereumChain,
+			clientConfig,
+			tssNode,
+			operatorPublicKey,
+			keepsRegistry,
+			keepMonitors,
+			eventDeduplicator,
+			journal,
+			signingAuthorization,
+			keepAddress,
 		)
 		if err != nil {
-			logger.Errorf("failed to generate a signature: [%v]", err)
+			logger.Warningf(
+				"could not check pending key generation for keep [%s]: [%v]",
+				keepAddress.String(),
+				err,
+			)
 		}
 	}
 }
 
 // monitorKeepClosedEvent monitors KeepClosed event and if that event happens
-// unsubscribes from signing event for the given keep and unregisters it from
-// the keep registry.
+// archives the keep, which stops the monitoring of all events of the keep.
+// The subscription is cancelled once the monitoring context is done.
 func monitorKeepClosedEvents(
+	ctx context.Context,
 	ethereumChain eth.Handle,
 	keepAddress common.Address,
 	keepsRegistry *registry.Keeps,
-	subscriptionOnSignatureRequested subscription.EventSubscription,
+	keepMonitors *keepMonitors,
 	eventDeduplicator *event.Deduplicator,
 ) {
-	keepClosed := make(chan *eth.KeepClosedEvent)
-
 	subscriptionOnKeepClosed, err := ethereumChain.OnKeepClosed(
 		keepAddress,
 		func(event *eth.KeepClosedEvent) {
@@ -826,8 +1340,7 @@ func monitorKeepClosedEvents(
 					return
 				}
 
-				keepsRegistry.UnregisterKeep(keepAddress)
-				keepClosed <- event
+				archiveKeep(keepsRegistry, keepMonitors, keepAddress)
 			}(event)
 		},
 	)
@@ -842,25 +1355,26 @@ func monitorKeepClosedEvents(
 	}
 
 	defer subscriptionOnKeepClosed.Unsubscribe()
-	defer subscriptionOnSignatureRequested.Unsubscribe()
 
-	<-keepClosed
+	<-ctx.Done()
 
-	logger.Info("unsubscribing from events on keep closed")
+	logger.Infof(
+		"unsubscribing from events of keep [%s]",
+		keepAddress.String(),
+	)
 }
 
 // monitorKeepTerminatedEvent monitors KeepTerminated event and if that event
-// happens unsubscribes from signing event for the given keep and unregisters it
-// from the keep registry.
+// happens archives the keep, which stops the monitoring of all events of
+// the keep. The subscription is cancelled once the monitoring context is done.
 func monitorKeepTerminatedEvent(
+	ctx context.Context,
 	ethereumChain eth.Handle,
 	keepAddress common.Address,
 	keepsRegistry *registry.Keeps,
-	subscriptionOnSignatureRequested subscription.EventSubscription,
+	keepMonitors *keepMonitors,
 	eventDeduplicator *event.Deduplicator,
 ) {
-	keepTerminated := make(chan *eth.KeepTerminatedEvent)
-
 	subscriptionOnKeepTerminated, err := ethereumChain.OnKeepTerminated(
 		keepAddress,
 		func(event *eth.KeepTerminatedEvent) {
@@ -905,8 +1419,7 @@ func monitorKeepTerminatedEvent(
 					return
 				}
 
-				keepsRegistry.UnregisterKeep(keepAddress)
-				keepTerminated <- event
+				archiveKeep(keepsRegistry, keepMonitors, keepAddress)
 			}(event)
 		},
 	)
@@ -921,9 +1434,6 @@ func monitorKeepTerminatedEvent(
 	}
 
 	defer subscriptionOnKeepTerminated.Unsubscribe()
-	defer subscriptionOnSignatureRequested.Unsubscribe()
-
-	<-keepTerminated
 
-	logger.Info("unsubscribing from events on keep terminated")
+	<-ctx.Done()
 }
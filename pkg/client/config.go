@@ -1,9 +1,12 @@
 package client
 
 import (
+	"math/big"
 	"time"
 
+	"github.com/keep-network/keep-common/pkg/chain/ethereum"
 	configtime "github.com/keep-network/keep-ecdsa/config/time"
+	"github.com/keep-network/keep-ecdsa/pkg/utils"
 )
 
 const (
@@ -20,6 +23,29 @@ const (
 
 	// The default value of a timeout for a signature calculation.
 	defaultSigningTimeout = 2 * time.Hour
+
+	// The default interval of checking member ETH balances of keeps for
+	// automatic rewards withdrawals.
+	defaultRewardsCheckInterval = 6 * time.Hour
+
+	// The default interval of checking if keys of keeps are old enough to be
+	// refreshed.
+	defaultKeyRefreshCheckInterval = 6 * time.Hour
+
+	// The default backoffs between retries of failed protocol executions,
+	// such as key generation, signing or key resharing, and of failed TSS
+	// pre-parameters generations.
+	defaultProtocolRetryInitialBackoff = 1 * time.Second
+	defaultProtocolRetryMaxBackoff     = 1 * time.Minute
+
+	// The default backoffs between retries of failed chain calls.
+	defaultChainRetryInitialBackoff = 1 * time.Second
+	defaultChainRetryMaxBackoff     = 30 * time.Second
+
+	// The default backoffs between retries of failed submissions of
+	// a calculated signature on-chain.
+	defaultSubmissionRetryInitialBackoff = 1 * time.Minute
+	defaultSubmissionRetryMaxBackoff     = 10 * time.Minute
 )
 
 // Config contains configuration for tss protocol execution.
@@ -32,6 +58,33 @@ type Config struct {
 	// Timeout for key generation and signature calculation.
 	KeyGenerationTimeout configtime.Duration
 	SigningTimeout       configtime.Duration
+
+	// Minimum member ETH balance of a keep which is automatically withdrawn
+	// to the beneficiary. Automatic withdrawals are disabled if the threshold
+	// is not set.
+	RewardsWithdrawalThreshold *ethereum.Wei
+	// Interval of checking member ETH balances of keeps.
+	RewardsCheckInterval configtime.Duration
+
+	// Age of the key after which key shares of the keep are automatically
+	// refreshed with other members. The age is counted from the last key
+	// refresh or, if the key has never been refreshed, from the keep opening.
+	// Scheduled key refresh is disabled if the age is not set.
+	KeyRefreshAge configtime.Duration
+	// Interval of checking if keys of keeps are old enough to be refreshed.
+	KeyRefreshCheckInterval configtime.Duration
+
+	// Backoffs between retries of failed protocol executions. The backoff
+	// starts from the initial value and grows exponentially, with a jitter,
+	// up to the max value.
+	ProtocolRetryInitialBackoff configtime.Duration
+	ProtocolRetryMaxBackoff     configtime.Duration
+	// Backoffs between retries of failed chain calls.
+	ChainRetryInitialBackoff configtime.Duration
+	ChainRetryMaxBackoff     configtime.Duration
+	// Backoffs between retries of failed signature submissions.
+	SubmissionRetryInitialBackoff configtime.Duration
+	SubmissionRetryMaxBackoff     configtime.Duration
 }
 
 // GetAwaitingKeyGenerationLookback returns a look-back period to check if
@@ -67,3 +120,104 @@ func (c *Config) GetSigningTimeout() time.Duration {
 
 	return timeout
 }
+
+// GetRewardsWithdrawalThreshold returns the minimum member ETH balance of
+// a keep which is automatically withdrawn. If a value is not set it returns
+// nil meaning automatic withdrawals are disabled.
+func (c *Config) GetRewardsWithdrawalThreshold() *big.Int {
+	if c.RewardsWithdrawalThreshold == nil {
+		return nil
+	}
+
+	return c.RewardsWithdrawalThreshold.Int
+}
+
+// GetRewardsCheckInterval returns the interval of checking member ETH balances
+// of keeps. If a value is not set it returns a default value.
+func (c *Config) GetRewardsCheckInterval() time.Duration {
+	interval := c.RewardsCheckInterval.ToDuration()
+	if interval == 0 {
+		interval = defaultRewardsCheckInterval
+	}
+
+	return interval
+}
+
+// GetKeyRefreshAge returns the age of the key after which key shares of the
+// keep are automatically refreshed. If a value is not set it returns zero
+// meaning scheduled key refresh is disabled.
+func (c *Config) GetKeyRefreshAge() time.Duration {
+	return c.KeyRefreshAge.ToDuration()
+}
+
+// GetKeyRefreshCheckInterval returns the interval of checking if keys of keeps
+// are old enough to be refreshed. If a value is not set it returns a default
+// value.
+func (c *Config) GetKeyRefreshCheckInterval() time.Duration {
+	interval := c.KeyRefreshCheckInterval.ToDuration()
+	if interval == 0 {
+		interval = defaultKeyRefreshCheckInterval
+	}
+
+	return interval
+}
+
+// GetProtocolRetryPolicy returns the backoff policy of retries of failed
+// protocol executions. If values are not set it returns default values.
+func (c *Config) GetProtocolRetryPolicy() utils.BackoffPolicy {
+	return newBackoffPolicy(
+		c.ProtocolRetryInitialBackoff,
+		c.ProtocolRetryMaxBackoff,
+		defaultProtocolRetryInitialBackoff,
+		defaultProtocolRetryMaxBackoff,
+	)
+}
+
+// GetChainRetryPolicy returns the backoff policy of retries of failed chain
+// calls. If values are not set it returns default values.
+func (c *Config) GetChainRetryPolicy() utils.BackoffPolicy {
+	return newBackoffPolicy(
+		c.ChainRetryInitialBackoff,
+		c.ChainRetryMaxBackoff,
+		defaultChainRetryInitialBackoff,
+		defaultChainRetryMaxBackoff,
+	)
+}
+
+// GetSubmissionRetryPolicy returns the backoff policy of retries of failed
+// signature submissions. If values are not set it returns default values.
+func (c *Config) GetSubmissionRetryPolicy() utils.BackoffPolicy {
+	return newBackoffPolicy(
+		c.SubmissionRetryInitialBackoff,
+		c.SubmissionRetryMaxBackoff,
+		defaultSubmissionRetryInitialBackoff,
+		defaultSubmissionRetryMaxBackoff,
+	)
+}
+
+// newBackoffPolicy creates a backoff policy from configured values falling
+// back to the defaults for values which are not set. The max backoff is never
+// shorter than the initial backoff.
+func newBackoffPolicy(
+	initialBackoff configtime.Duration,
+	maxBackoff configtime.Duration,
+	defaultInitialBackoff time.Duration,
+	defaultMaxBackoff time.Duration,
+) utils.BackoffPolicy {
+	policy := utils.BackoffPolicy{
+		InitialBackoff: initialBackoff.ToDuration(),
+		MaxBackoff:     maxBackoff.ToDuration(),
+	}
+
+	if policy.InitialBackoff == 0 {
+		policy.InitialBackoff = defaultInitialBackoff
+	}
+	if policy.MaxBackoff == 0 {
+		policy.MaxBackoff = defaultMaxBackoff
+	}
+	if policy.MaxBackoff < policy.InitialBackoff {
+		policy.MaxBackoff = policy.InitialBackoff
+	}
+
+	return policy
+}
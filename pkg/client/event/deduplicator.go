@@ -20,11 +20,12 @@ import (
 // event is a duplicate and should be ignored or if it is not a duplicate and
 // should be handled.
 //
-// Four events are supported:
+// Five events are supported:
 // - key generation request for a new keep,
 // - signature request for a keep,
 // - keep close request,
-// - keep terminate request.
+// - keep terminate request,
+// - key refresh request for a keep.
 type Deduplicator struct {
 	keepRegistry keepRegistry
 	chain        chain.Handle
@@ -33,6 +34,7 @@ type Deduplicator struct {
 	requestedSignatures *requestedSignaturesTrack
 	closingKeeps        *uniqueEventTrack
 	terminatingKeeps    *uniqueEventTrack
+	refreshingKeeps     *uniqueEventTrack
 }
 
 type keepRegistry interface {
@@ -58,6 +60,9 @@ func NewDeduplicator(
 	terminatingKeeps := &uniqueEventTrack{
 		data: make(map[string]bool),
 	}
+	refreshingKeeps := &uniqueEventTrack{
+		data: make(map[string]bool),
+	}
 
 	return &Deduplicator{
 		keepRegistry:        keepRegistry,
@@ -66,6 +71,7 @@ func NewDeduplicator(
 		requestedSignatures: requestedSignatures,
 		closingKeeps:        closingKeeps,
 		terminatingKeeps:    terminatingKeeps,
+		refreshingKeeps:     refreshingKeeps,
 	}
 }
 
@@ -213,3 +219,49 @@ func (d *Deduplicator) NotifyTerminatingStarted(keepAddress common.Address) bool
 func (d *Deduplicator) NotifyTerminatingCompleted(keepAddress common.Address) {
 	d.terminatingKeeps.remove(keepAddress)
 }
+
+// NotifyKeyRefreshStarted notifies the client wants to refresh the key of
+// a keep upon receiving a request. It returns boolean indicating whether the
+// client should proceed with the execution or ignore the request as
+// a duplicate.
+//
+// In case the client proceeds with the key refresh, it should call
+// NotifyKeyRefreshCompleted once the protocol completes, no matter if it
+// failed or succeeded.
+func (d *Deduplicator) NotifyKeyRefreshStarted(keepAddress common.Address) bool {
+	if d.refreshingKeeps.has(keepAddress) {
+		return false
+	}
+
+	// There is no key to refresh if keep with the given address does not
+	// exist in the registry.
+	if !d.keepRegistry.HasSigner(keepAddress) {
+		return false
+	}
+
+	return d.refreshingKeeps.add(keepAddress)
+}
+
+// NotifyKeyRefreshCompleted should be called once client completed refreshing
+// the key of the keep, no matter if the execution succeeded or failed.
+func (d *Deduplicator) NotifyKeyRefreshCompleted(keepAddress common.Address) {
+	d.refreshingKeeps.remove(keepAddress)
+}
+
+// KeyRefreshInProgress returns addresses of keeps for which key refresh is
+// currently being handled by the client.
+func (d *Deduplicator) KeyRefreshInProgress() []common.Address {
+	return d.refreshingKeeps.list()
+}
+
+// KeyGenInProgress returns addresses of keeps for which key generation is
+// currently being handled by the client.
+func (d *Deduplicator) KeyGenInProgress() []common.Address {
+	return d.keyGenKeeps.list()
+}
+
+// SigningInProgress returns digests for which signing is currently being
+// handled by the client, grouped by the keep address.
+func (d *Deduplicator) SigningInProgress() map[common.Address][][32]byte {
+	return d.requestedSignatures.list()
+}
@@ -315,6 +315,63 @@ func TestDoNotTerminateIfAlreadyTerminated(t *testing.T) {
 	}
 }
 
+func TestDoRefreshKey(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	deduplicator, registry, _ := newDeduplicator(ctx)
+	registry.AddSigner(keepAddress)
+
+	canRefresh := deduplicator.NotifyKeyRefreshStarted(keepAddress)
+	if !canRefresh {
+		t.Fatal("should be allowed to refresh key")
+	}
+}
+
+func TestDoNotRefreshKeyIfCurrentlyRefreshing(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	deduplicator, registry, _ := newDeduplicator(ctx)
+	registry.AddSigner(keepAddress)
+
+	deduplicator.NotifyKeyRefreshStarted(keepAddress)
+
+	canRefresh := deduplicator.NotifyKeyRefreshStarted(keepAddress)
+	if canRefresh {
+		t.Fatal("should not be allowed to refresh key")
+	}
+}
+
+func TestDoRefreshKeyOneMoreTime(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	deduplicator, registry, _ := newDeduplicator(ctx)
+	registry.AddSigner(keepAddress)
+
+	deduplicator.NotifyKeyRefreshStarted(keepAddress)
+	deduplicator.NotifyKeyRefreshCompleted(keepAddress)
+
+	canRefresh := deduplicator.NotifyKeyRefreshStarted(keepAddress)
+	if !canRefresh {
+		t.Fatal("should be allowed to refresh key")
+	}
+}
+
+func TestDoNotRefreshKeyIfNotRegistered(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	deduplicator, _, _ := newDeduplicator(ctx)
+	// keep not in the registry
+
+	canRefresh := deduplicator.NotifyKeyRefreshStarted(keepAddress)
+	if canRefresh {
+		t.Fatal("should not be allowed to refresh key")
+	}
+}
+
 func newDeduplicator(ctx context.Context) (
 	*Deduplicator,
 	*mockRegistry,
@@ -51,6 +51,18 @@ func (uet *uniqueEventTrack) remove(keepAddress common.Address) {
 	delete(uet.data, keepAddress.String())
 }
 
+func (uet *uniqueEventTrack) list() []common.Address {
+	uet.mutex.Lock()
+	defer uet.mutex.Unlock()
+
+	keeps := make([]common.Address, 0, len(uet.data))
+	for keepAddress := range uet.data {
+		keeps = append(keeps, common.HexToAddress(keepAddress))
+	}
+
+	return keeps
+}
+
 // requestedSignaturesTrack is used to track signature calculation started after
 // signature request event is received. It is used to ensure that the process execution
 // is not duplicated, e.g. when the client receives the same event multiple times.
@@ -108,3 +120,24 @@ func (rst *requestedSignaturesTrack) remove(keepAddress common.Address, digest [
 		}
 	}
 }
+
+func (rst *requestedSignaturesTrack) list() map[common.Address][][32]byte {
+	rst.mutex.Lock()
+	defer rst.mutex.Unlock()
+
+	signatures := make(map[common.Address][][32]byte, len(rst.data))
+	for keepAddress, keepSignatures := range rst.data {
+		digests := make([][32]byte, 0, len(keepSignatures))
+		for digestString := range keepSignatures {
+			var digest [32]byte
+			digestBytes, _ := hex.DecodeString(digestString)
+			copy(digest[:], digestBytes)
+
+			digests = append(digests, digest)
+		}
+
+		signatures[common.HexToAddress(keepAddress)] = digests
+	}
+
+	return signatures
+}
@@ -94,6 +94,29 @@ func TestUniqueEventTrackHas(t *testing.T) {
 	}
 }
 
+func TestUniqueEventTrackList(t *testing.T) {
+	keepAddress := common.BytesToAddress([]byte{1})
+
+	rs := &uniqueEventTrack{
+		data: make(map[string]bool),
+	}
+
+	if len(rs.list()) != 0 {
+		t.Errorf("no events were emitted and none should be listed")
+	}
+
+	rs.add(keepAddress)
+
+	keeps := rs.list()
+	if len(keeps) != 1 || keeps[0] != keepAddress {
+		t.Errorf(
+			"unexpected tracked keeps\nexpected: [%v]\nactual:   [%v]",
+			[]common.Address{keepAddress},
+			keeps,
+		)
+	}
+}
+
 func TestRequestedSignaturesTrackAdd_SameKeep(t *testing.T) {
 	keepAddress := common.BytesToAddress([]byte{1})
 
@@ -250,3 +273,42 @@ func TestRequestedSignaturesTrackHas(t *testing.T) {
 		t.Errorf("event was removed and should no longer be tracked")
 	}
 }
+
+func TestRequestedSignaturesTrackList(t *testing.T) {
+	keepAddress1 := common.BytesToAddress([]byte{1})
+	keepAddress2 := common.BytesToAddress([]byte{2})
+
+	digest1 := [32]byte{9}
+	digest2 := [32]byte{10}
+
+	rs := &requestedSignaturesTrack{
+		data: make(map[string]map[string]bool),
+	}
+
+	rs.add(keepAddress1, digest1)
+	rs.add(keepAddress2, digest2)
+
+	signatures := rs.list()
+
+	if len(signatures) != 2 {
+		t.Fatalf(
+			"unexpected number of keeps\nexpected: [%v]\nactual:   [%v]",
+			2,
+			len(signatures),
+		)
+	}
+	if len(signatures[keepAddress1]) != 1 || signatures[keepAddress1][0] != digest1 {
+		t.Errorf(
+			"unexpected digests of keep 1\nexpected: [%v]\nactual:   [%v]",
+			[][32]byte{digest1},
+			signatures[keepAddress1],
+		)
+	}
+	if len(signatures[keepAddress2]) != 1 || signatures[keepAddress2][0] != digest2 {
+		t.Errorf(
+			"unexpected digests of keep 2\nexpected: [%v]\nactual:   [%v]",
+			[][32]byte{digest2},
+			signatures[keepAddress2],
+		)
+	}
+}
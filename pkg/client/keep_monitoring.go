@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
+)
+
+// keepMonitors holds functions stopping the monitoring of events of keeps.
+// The monitoring of the keep is stopped the same way no matter if the keep
+// has been closed, terminated or archived on the operator's request.
+type keepMonitors struct {
+	mutex sync.Mutex
+	stops map[common.Address]context.CancelFunc
+}
+
+func newKeepMonitors() *keepMonitors {
+	return &keepMonitors{
+		stops: make(map[common.Address]context.CancelFunc),
+	}
+}
+
+// start returns the context of the monitoring of events of the keep. The
+// context is done once the monitoring is stopped or the parent context is
+// done. All subscriptions to events of the keep should be bound to it.
+func (km *keepMonitors) start(
+	ctx context.Context,
+	keepAddress common.Address,
+) context.Context {
+	km.mutex.Lock()
+	defer km.mutex.Unlock()
+
+	monitoringCtx, stop := context.WithCancel(ctx)
+
+	if previousStop, ok := km.stops[keepAddress]; ok {
+		previousStop()
+	}
+	km.stops[keepAddress] = stop
+
+	return monitoringCtx
+}
+
+// stop stops the monitoring of events of the keep, if it is monitored.
+func (km *keepMonitors) stop(keepAddress common.Address) {
+	km.mutex.Lock()
+	defer km.mutex.Unlock()
+
+	if stop, ok := km.stops[keepAddress]; ok {
+		stop()
+		delete(km.stops, keepAddress)
+	}
+}
+
+// archiveKeep archives the keep confirmed to be no longer active and stops
+// the monitoring of its events.
+func archiveKeep(
+	keepsRegistry *registry.Keeps,
+	keepMonitors *keepMonitors,
+	keepAddress common.Address,
+) {
+	keepsRegistry.UnregisterKeep(keepAddress)
+	keepMonitors.stop(keepAddress)
+}
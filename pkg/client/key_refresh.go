@@ -0,0 +1,211 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/client/event"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+	"github.com/keep-network/keep-ecdsa/pkg/node"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
+)
+
+// The timeout for the entire key refresh, including waiting for TSS
+// pre-parameters, the key refresh protocol and its confirmation.
+const keyRefreshTimeout = 30 * time.Minute
+
+// The period after a completed key refresh or a key refresh attempt requested
+// by another member during which key refresh requests from other members are
+// ignored. Members keep retransmitting their readiness for a while after
+// the key refresh completed, so it is not possible to tell those messages
+// apart from a new request. Attempts are limited as well, so that a member
+// requesting key refreshes it never completes can not make the client
+// attempt them continuously.
+const keyRefreshRequestCooldown = 30 * time.Minute
+
+// refreshKeyForKeep refreshes key shares of the keep with other members if
+// the keep is still active and the client is not signing for the keep at the
+// moment.
+func refreshKeyForKeep(
+	ctx context.Context,
+	ethereumChain eth.Handle,
+	tssNode *node.Node,
+	keepsRegistry *registry.Keeps,
+	eventDeduplicator *event.Deduplicator,
+	journal *registry.Journal,
+	keepAddress common.Address,
+) {
+	if shouldHandle := eventDeduplicator.NotifyKeyRefreshStarted(keepAddress); !shouldHandle {
+		logger.Infof(
+			"key refresh for keep [%s] already in progress",
+			keepAddress.String(),
+		)
+		return
+	}
+	defer eventDeduplicator.NotifyKeyRefreshCompleted(keepAddress)
+
+	// Signing started with the current key share could not be completed
+	// once other members start using their refreshed key shares.
+	if len(eventDeduplicator.SigningInProgress()[keepAddress]) > 0 {
+		logger.Warningf(
+			"keep [%s] is signing at the moment; skipping key refresh",
+			keepAddress.String(),
+		)
+		return
+	}
+
+	isActive, err := ethereumChain.IsActive(keepAddress)
+	if err != nil {
+		logger.Errorf(
+			"could not check if keep [%s] is still active: [%v]",
+			keepAddress.String(),
+			err,
+		)
+		return
+	}
+
+	if !isActive {
+		logger.Infof(
+			"keep [%s] is no longer active; skipping key refresh",
+			keepAddress.String(),
+		)
+		return
+	}
+
+	logger.Infof("starting key refresh for keep [%s]", keepAddress.String())
+
+	refreshCtx, cancel := context.WithTimeout(ctx, keyRefreshTimeout)
+	defer cancel()
+
+	if _, err := tssNode.RefreshSignerForKeep(
+		refreshCtx,
+		keepAddress,
+		keepsRegistry,
+	); err != nil {
+		logger.Errorf(
+			"failed to refresh key for keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+		return
+	}
+
+	if err := journal.RecordKeyRefreshed(keepAddress); err != nil {
+		logger.Errorf(
+			"failed to record key refresh for keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+	}
+
+	logger.Infof("completed key refresh for keep [%s]", keepAddress.String())
+}
+
+// monitorKeyRefreshRequests joins the key refresh for the keep whenever
+// another member of the keep requests it, unless the key has been refreshed
+// or the key refresh has been attempted on request recently.
+func monitorKeyRefreshRequests(
+	ctx context.Context,
+	ethereumChain eth.Handle,
+	tssNode *node.Node,
+	keepAddress common.Address,
+	signer *tss.ThresholdSigner,
+	keepsRegistry *registry.Keeps,
+	eventDeduplicator *event.Deduplicator,
+	journal *registry.Journal,
+) {
+	lastAttemptMutex := &sync.Mutex{}
+	var lastAttempt time.Time
+
+	err := tssNode.OnKeyRefreshRequested(ctx, signer, func() {
+		if lastRefresh, refreshed := journal.LastKeyRefresh(keepAddress); refreshed &&
+			time.Since(lastRefresh) < keyRefreshRequestCooldown {
+			return
+		}
+
+		lastAttemptMutex.Lock()
+		if time.Since(lastAttempt) < keyRefreshRequestCooldown {
+			lastAttemptMutex.Unlock()
+			return
+		}
+		lastAttempt = time.Now()
+		lastAttemptMutex.Unlock()
+
+		go refreshKeyForKeep(
+			ctx,
+			ethereumChain,
+			tssNode,
+			keepsRegistry,
+			eventDeduplicator,
+			journal,
+			keepAddress,
+		)
+	})
+	if err != nil {
+		logger.Errorf(
+			"failed to register for key refresh requests for keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+	}
+}
+
+// monitorKeyRefreshSchedule periodically refreshes keys of keeps which have
+// not been refreshed for longer than the configured key age.
+func monitorKeyRefreshSchedule(
+	ctx context.Context,
+	ethereumChain eth.Handle,
+	clientConfig *Config,
+	tssNode *node.Node,
+	keepsRegistry *registry.Keeps,
+	eventDeduplicator *event.Deduplicator,
+	journal *registry.Journal,
+) {
+	keyRefreshAge := clientConfig.GetKeyRefreshAge()
+
+	ticker := time.NewTicker(clientConfig.GetKeyRefreshCheckInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, keepAddress := range keepsRegistry.GetKeepsAddresses() {
+				lastRefresh, refreshed := journal.LastKeyRefresh(keepAddress)
+				if !refreshed {
+					openedTimestamp, err := ethereumChain.GetOpenedTimestamp(keepAddress)
+					if err != nil {
+						logger.Errorf(
+							"could not get opened timestamp of keep [%s]: [%v]",
+							keepAddress.String(),
+							err,
+						)
+						continue
+					}
+					lastRefresh = openedTimestamp
+				}
+
+				if time.Since(lastRefresh) < keyRefreshAge {
+					continue
+				}
+
+				// Keys are refreshed one by one so that the key refresh does
+				// not exhaust the pool of TSS pre-parameters.
+				refreshKeyForKeep(
+					ctx,
+					ethereumChain,
+					tssNode,
+					keepsRegistry,
+					eventDeduplicator,
+					journal,
+					keepAddress,
+				)
+			}
+		}
+	}
+}
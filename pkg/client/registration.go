@@ -9,15 +9,11 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/utils"
 )
 
 const statusCheckIntervalBlocks = 100
 
-// retryDelay defines the delay between retries related to the registration logic
-// that do not have their own specific values (like for example `eligibilityRetryDelay`
-// for sortition pool join eligibility checks).
-const retryDelay = 1 * time.Second
-
 // eligibilityRetryDelay defines the delay between checks whether the operator
 // is eligible to join the sortition pool.
 const eligibilityRetryDelay = 20 * time.Minute
@@ -31,11 +27,15 @@ const eligibilityRetryDelay = 20 * time.Minute
 // process to keep the operator's status up to date in the pool.
 // If operator status in the pool cannot be monitored, e.g. when operator is
 // removed from the pool it triggers the registration process from the begining.
+// Failed chain calls are retried according to the provided retry policy.
 func checkStatusAndRegisterForApplication(
 	ctx context.Context,
 	ethereumChain eth.Handle,
 	application common.Address,
+	retryPolicy utils.BackoffPolicy,
 ) {
+	backoff := utils.NewBackoff(retryPolicy)
+
 RegistrationLoop:
 	for {
 		select {
@@ -49,26 +49,41 @@ RegistrationLoop:
 					application.String(),
 					err,
 				)
-				time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+				if err := backoff.Wait(ctx); err != nil {
+					return
+				}
 				continue RegistrationLoop
 			}
 
 			if !isRegistered {
 				// if the operator is not registered, we need to register it and
 				// wait until registration is confirmed
-				registerAsMemberCandidate(ctx, ethereumChain, application)
-				waitUntilRegistered(ctx, ethereumChain, application)
+				registerAsMemberCandidate(
+					ctx,
+					ethereumChain,
+					application,
+					retryPolicy,
+				)
+				waitUntilRegistered(ctx, ethereumChain, application, retryPolicy)
 			}
 
 			// once the registration is confirmed or if the client is already
 			// registered, we can start to monitor the status
-			if err := monitorSignerPoolStatus(ctx, ethereumChain, application); err != nil {
+			if err := monitorSignerPoolStatus(
+				ctx,
+				ethereumChain,
+				application,
+				backoff,
+			); err != nil {
 				logger.Errorf(
 					"failed on signer pool status monitoring; please inspect "+
-						"signer's unbonded value and stake: [%v]",
+						"signer's stake and unbonded value reported by the "+
+						"bond monitor: [%v]",
 					err,
 				)
-				time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+				if err := backoff.Wait(ctx); err != nil {
+					return
+				}
 				continue RegistrationLoop
 			}
 		}
@@ -84,6 +99,7 @@ func registerAsMemberCandidate(
 	parentCtx context.Context,
 	ethereumChain eth.Handle,
 	application common.Address,
+	retryPolicy utils.BackoffPolicy,
 ) {
 	// If the operator is eligible right now for registering as a member
 	// candidate for the application, we register the operator.
@@ -117,7 +133,12 @@ func registerAsMemberCandidate(
 	// We do the same in case the registration of eligible operator failed for
 	// some reason. As soon as the operator is eligible, we will proceed with
 	// the registration.
-	registerAsMemberCandidateWhenEligible(parentCtx, ethereumChain, application)
+	registerAsMemberCandidateWhenEligible(
+		parentCtx,
+		ethereumChain,
+		application,
+		retryPolicy,
+	)
 }
 
 // registerAsMemberCandidateWhenEligible for each new block checks the operator's
@@ -127,10 +148,13 @@ func registerAsMemberCandidateWhenEligible(
 	parentCtx context.Context,
 	ethereumChain eth.Handle,
 	application common.Address,
+	retryPolicy utils.BackoffPolicy,
 ) {
 	ctx, cancel := context.WithCancel(parentCtx)
 	defer cancel()
 
+	backoff := utils.NewBackoff(retryPolicy)
+
 	newBlockChan := ethereumChain.BlockCounter().WatchBlocks(ctx)
 	for {
 		select {
@@ -142,19 +166,27 @@ func registerAsMemberCandidateWhenEligible(
 					application.String(),
 					err,
 				)
-				time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+				if err := backoff.Wait(ctx); err != nil {
+					return
+				}
 				continue
 			}
 
 			if !isEligible {
+				backoff.Reset()
+
 				// if the operator is not yet eligible wait for the next
 				// block and execute the check again
 				logger.Warningf(
 					"operator is not eligible for application [%s]",
 					application.String(),
 				)
-				time.Sleep(eligibilityRetryDelay) // TODO: #413 Replace with backoff.
-				continue
+				select {
+				case <-time.After(eligibilityRetryDelay):
+					continue
+				case <-ctx.Done():
+					return
+				}
 			}
 
 			// if the operator is eligible, register it as a keep member
@@ -169,7 +201,9 @@ func registerAsMemberCandidateWhenEligible(
 					application.String(),
 					err,
 				)
-				time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+				if err := backoff.Wait(ctx); err != nil {
+					return
+				}
 				continue
 			}
 
@@ -188,9 +222,12 @@ func waitUntilRegistered(
 	ctx context.Context,
 	ethereumChain eth.Handle,
 	application common.Address,
+	retryPolicy utils.BackoffPolicy,
 ) {
 	newBlockChan := ethereumChain.BlockCounter().WatchBlocks(ctx)
 
+	backoff := utils.NewBackoff(retryPolicy)
+
 	for {
 		select {
 		case <-newBlockChan:
@@ -201,7 +238,9 @@ func waitUntilRegistered(
 					application.String(),
 					err,
 				)
-				time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+				if err := backoff.Wait(ctx); err != nil {
+					return
+				}
 				continue
 			}
 
@@ -213,6 +252,8 @@ func waitUntilRegistered(
 				return
 			}
 
+			backoff.Reset()
+
 			logger.Infof(
 				"operator is not yet registered for application [%s], waiting...",
 				application.String(),
@@ -225,10 +266,13 @@ func waitUntilRegistered(
 
 // monitorSignerPoolStatus tracks operator's state in the signing pool
 // (staking weight, bonding) and updates the status when it gets out of date.
+// The backoff of the registration is reset after each successful status check,
+// so failures of the long running monitoring are not backed off more and more.
 func monitorSignerPoolStatus(
 	ctx context.Context,
 	ethereumChain eth.Handle,
 	application common.Address,
+	backoff *utils.Backoff,
 ) error {
 	logger.Debugf(
 		"starting monitoring operatator status for application [%s]",
@@ -315,6 +359,8 @@ func monitorSignerPoolStatus(
 				}
 			}
 
+			backoff.Reset()
+
 			statusCheckTrigger, err = blockCounter.BlockHeightWaiter(
 				statusCheckBlock + statusCheckIntervalBlocks,
 			)
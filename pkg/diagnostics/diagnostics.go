@@ -0,0 +1,92 @@
+// Package diagnostics contains diagnostics sources specific to the ECDSA
+// client. General sources are provided by the keep-core diagnostics package.
+package diagnostics
+
+import (
+	"encoding/json"
+
+	"github.com/ipfs/go-log"
+	"github.com/keep-network/keep-common/pkg/diagnostics"
+
+	"github.com/keep-network/keep-ecdsa/pkg/bond"
+	"github.com/keep-network/keep-ecdsa/pkg/reliability"
+)
+
+var logger = log.Logger("keep-diagnostics")
+
+// RegisterBondingSource registers the diagnostics source providing
+// information about operator's unbonded value and bonds from the latest
+// check of the bond monitor. Values in wei are presented as decimal strings
+// to not lose precision.
+func RegisterBondingSource(
+	registry *diagnostics.DiagnosticsRegistry,
+	bondMonitor *bond.Monitor,
+) {
+	registry.RegisterSource("bonding", func() string {
+		status := bondMonitor.Status()
+
+		applications := make([]map[string]interface{}, len(status.Applications))
+		for i, application := range status.Applications {
+			applications[i] = map[string]interface{}{
+				"application":              application.Application.Hex(),
+				"available_unbonded_value": application.AvailableUnbondedValue.String(),
+				"minimum_bond":             application.MinimumBond.String(),
+				"minimum_bond_margin":      application.MinimumBondMargin.String(),
+				"alert":                    application.Alert,
+			}
+		}
+
+		bonds := make(map[string]string, len(status.Bonds))
+		for keepAddress, bondedValue := range status.Bonds {
+			bonds[keepAddress.Hex()] = bondedValue.String()
+		}
+
+		bonding := map[string]interface{}{
+			"applications":       applications,
+			"bonds":              bonds,
+			"total_bonded_value": status.TotalBondedValue.String(),
+			"checked_at":         status.CheckedAt.Unix(),
+		}
+
+		bytes, err := json.Marshal(bonding)
+		if err != nil {
+			logger.Errorf("error on serializing bonding status to JSON: [%v]", err)
+			return ""
+		}
+
+		return string(bytes)
+	})
+}
+
+// RegisterPeerReliabilitySource registers the diagnostics source providing
+// faults recorded for peer members of keeps by their operator addresses,
+// so the operator whose client causes protocols of keeps to fail can be
+// identified.
+func RegisterPeerReliabilitySource(
+	registry *diagnostics.DiagnosticsRegistry,
+	tracker *reliability.Tracker,
+) {
+	registry.RegisterSource("peer_reliability", func() string {
+		peers := make(map[string]interface{})
+		for peerAddress, peer := range tracker.Peers() {
+			peers[peerAddress.Hex()] = map[string]interface{}{
+				"faults":          peer.Faults,
+				"last_fault":      peer.LastFault,
+				"last_fault_keep": peer.LastFaultKeep.Hex(),
+				"last_fault_at":   peer.LastFaultAt.Unix(),
+				"evidence":        peer.Evidence,
+			}
+		}
+
+		bytes, err := json.Marshal(peers)
+		if err != nil {
+			logger.Errorf(
+				"error on serializing peer reliability to JSON: [%v]",
+				err,
+			)
+			return ""
+		}
+
+		return string(bytes)
+	})
+}
@@ -1,6 +1,7 @@
 package tss
 
 import (
+	"runtime"
 	"time"
 
 	configtime "github.com/keep-network/keep-ecdsa/config/time"
@@ -18,6 +19,13 @@ type Config struct {
 
 	// Target size of the TSS pre params pool.
 	PreParamsTargetPoolSize int
+
+	// Number of pre-parameters generated concurrently.
+	PreParamsGenerationConcurrency int
+
+	// Pauses pre-parameters generation while a key generation or signing
+	// protocol is executed.
+	PreParamsGenerationLowPriority bool
 }
 
 // GetPreParamsGenerationTimeout returns pre-parameters generation timeout. If
@@ -41,3 +49,15 @@ func (c *Config) GetPreParamsTargetPoolSize() int {
 
 	return poolSize
 }
+
+// GetPreParamsGenerationConcurrency returns the number of pre-parameters
+// generated concurrently. If a value is not set it returns the number of
+// logical CPUs.
+func (c *Config) GetPreParamsGenerationConcurrency() int {
+	concurrency := c.PreParamsGenerationConcurrency
+	if concurrency <= 0 {
+		concurrency = runtime.NumCPU()
+	}
+
+	return concurrency
+}
@@ -34,3 +34,134 @@ func (t timeoutError) Error() string {
 		t.stage,
 	)
 }
+
+// readyError is returned when the readiness signaling protocol executed
+// before key generation or signing fails.
+type readyError struct {
+	err error
+}
+
+func (r readyError) Error() string {
+	return fmt.Sprintf("readiness signaling protocol failed: [%v]", r.err)
+}
+
+// IsReadyError returns true if the error has been returned because
+// the readiness signaling protocol failed.
+func IsReadyError(err error) bool {
+	_, ok := err.(readyError)
+	return ok
+}
+
+// announceTimeoutError is returned when the announce protocol timed out before
+// all members announced their presence.
+type announceTimeoutError struct {
+	timeout time.Duration
+	// Addresses of members who have not announced their presence.
+	missingMembers []string
+}
+
+func (a announceTimeoutError) Error() string {
+	return fmt.Sprintf(
+		"waiting for announcements timed out after: [%v]",
+		a.timeout,
+	)
+}
+
+// MissingAnnouncements returns addresses of members who have not announced
+// their presence if the error has been returned because the announce protocol
+// timed out. Otherwise, it returns nil.
+func MissingAnnouncements(err error) []string {
+	if announceErr, ok := err.(announceTimeoutError); ok {
+		return announceErr.missingMembers
+	}
+
+	return nil
+}
+
+// readyTimeoutError is returned when the readiness signaling protocol timed
+// out before the required number of members signalled their readiness.
+type readyTimeoutError struct {
+	timeout time.Duration
+	// Members who have not signalled their readiness.
+	missingMembers []MemberID
+	// Fingerprints of key epochs of members who signalled their readiness
+	// holding key shares of another epoch than the current member.
+	keyEpochFingerprints [][]byte
+}
+
+func (r readyTimeoutError) Error() string {
+	return fmt.Sprintf("waiting for readiness timed out after: [%v]", r.timeout)
+}
+
+// MissingReadySignals returns members who have not signalled their readiness
+// if the error has been returned because the readiness signaling protocol
+// timed out. Otherwise, it returns nil.
+func MissingReadySignals(err error) []MemberID {
+	readyErr, ok := err.(readyError)
+	if !ok {
+		return nil
+	}
+
+	if timeoutErr, ok := readyErr.err.(readyTimeoutError); ok {
+		return timeoutErr.missingMembers
+	}
+
+	return nil
+}
+
+// PeerKeyEpochFingerprints returns fingerprints of key epochs of members
+// holding key shares of another epoch than the current member if the error
+// has been returned because the readiness signaling protocol timed out.
+// Otherwise, it returns nil.
+func PeerKeyEpochFingerprints(err error) [][]byte {
+	readyErr, ok := err.(readyError)
+	if !ok {
+		return nil
+	}
+
+	if timeoutErr, ok := readyErr.err.(readyTimeoutError); ok {
+		return timeoutErr.keyEpochFingerprints
+	}
+
+	return nil
+}
+
+// Culprit is a member blamed by tss-lib for sending invalid protocol messages
+// along with the evidence of the misbehaviour.
+type Culprit struct {
+	MemberID MemberID
+	// Evidence describes each invalid message of the member: the task and
+	// the round in which it has been found invalid and the reason.
+	Evidence []string
+}
+
+// culpritsError is returned when the protocol execution failed and tss-lib
+// blamed some members for sending invalid messages during the execution.
+type culpritsError struct {
+	err      error
+	culprits []*Culprit
+}
+
+func (c culpritsError) Error() string {
+	stringIDs := make([]string, len(c.culprits))
+	for i, culprit := range c.culprits {
+		stringIDs[i] = culprit.MemberID.String()
+	}
+
+	return fmt.Sprintf(
+		"%v; culprits: [%s]",
+		c.err,
+		strings.Join(stringIDs, ", "),
+	)
+}
+
+// Culprits returns members blamed by tss-lib for sending invalid messages if
+// the error has been returned from a failed protocol execution in which
+// such members have been found. Otherwise, it returns nil.
+func Culprits(err error) []*Culprit {
+	if culpritsErr, ok := err.(culpritsError); ok {
+		return culpritsErr.culprits
+	}
+
+	return nil
+}
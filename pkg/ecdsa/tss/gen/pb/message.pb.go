@@ -13,6 +13,7 @@ import (
 	strings "strings"
 
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -94,7 +95,11 @@ func (m *TSSProtocolMessage) GetSessionID() string {
 }
 
 type ReadyMessage struct {
-	SenderID []byte `protobuf:"bytes,1,opt,name=senderID,proto3" json:"senderID,omitempty"`
+	SenderID            []byte            `protobuf:"bytes,1,opt,name=senderID,proto3" json:"senderID,omitempty"`
+	SessionNonce        []byte            `protobuf:"bytes,2,opt,name=sessionNonce,proto3" json:"sessionNonce,omitempty"`
+	ConfirmedNonces     map[string][]byte `protobuf:"bytes,3,rep,name=confirmedNonces,proto3" json:"confirmedNonces,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	KeyEpochFingerprint []byte            `protobuf:"bytes,4,opt,name=keyEpochFingerprint,proto3" json:"keyEpochFingerprint,omitempty"`
+	ProtocolVersion     uint32            `protobuf:"varint,5,opt,name=protocolVersion,proto3" json:"protocolVersion,omitempty"`
 }
 
 func (m *ReadyMessage) Reset()      { *m = ReadyMessage{} }
@@ -136,6 +141,34 @@ func (m *ReadyMessage) GetSenderID() []byte {
 	return nil
 }
 
+func (m *ReadyMessage) GetSessionNonce() []byte {
+	if m != nil {
+		return m.SessionNonce
+	}
+	return nil
+}
+
+func (m *ReadyMessage) GetConfirmedNonces() map[string][]byte {
+	if m != nil {
+		return m.ConfirmedNonces
+	}
+	return nil
+}
+
+func (m *ReadyMessage) GetKeyEpochFingerprint() []byte {
+	if m != nil {
+		return m.KeyEpochFingerprint
+	}
+	return nil
+}
+
+func (m *ReadyMessage) GetProtocolVersion() uint32 {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return 0
+}
+
 type AnnounceMessage struct {
 	SenderID []byte `protobuf:"bytes,1,opt,name=senderID,proto3" json:"senderID,omitempty"`
 }
@@ -182,28 +215,38 @@ func (m *AnnounceMessage) GetSenderID() []byte {
 func init() {
 	proto.RegisterType((*TSSProtocolMessage)(nil), "tss.TSSProtocolMessage")
 	proto.RegisterType((*ReadyMessage)(nil), "tss.ReadyMessage")
+	proto.RegisterMapType((map[string][]byte)(nil), "tss.ReadyMessage.ConfirmedNoncesEntry")
 	proto.RegisterType((*AnnounceMessage)(nil), "tss.AnnounceMessage")
 }
 
 func init() { proto.RegisterFile("pb/message.proto", fileDescriptor_8447775385e7eb85) }
 
 var fileDescriptor_8447775385e7eb85 = []byte{
-	// 240 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x28, 0x48, 0xd2, 0xcf,
-	0x4d, 0x2d, 0x2e, 0x4e, 0x4c, 0x4f, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x2e, 0x29,
-	0x2e, 0x56, 0xea, 0x62, 0xe4, 0x12, 0x0a, 0x09, 0x0e, 0x0e, 0x00, 0x89, 0x24, 0xe7, 0xe7, 0xf8,
-	0x42, 0x54, 0x08, 0x49, 0x71, 0x71, 0x14, 0xa7, 0xe6, 0xa5, 0xa4, 0x16, 0x79, 0xba, 0x48, 0x30,
-	0x2a, 0x30, 0x6a, 0xf0, 0x04, 0xc1, 0xf9, 0x42, 0x12, 0x5c, 0xec, 0x05, 0x89, 0x95, 0x39, 0xf9,
-	0x89, 0x29, 0x12, 0x4c, 0x60, 0x29, 0x18, 0x57, 0x48, 0x81, 0x8b, 0x3b, 0xb3, 0xd8, 0xa9, 0x28,
-	0x3f, 0x31, 0x25, 0x39, 0xb1, 0xb8, 0x44, 0x82, 0x59, 0x81, 0x51, 0x83, 0x23, 0x08, 0x59, 0x48,
-	0x48, 0x86, 0x8b, 0xb3, 0x38, 0xb5, 0xb8, 0x38, 0x33, 0x3f, 0xcf, 0xd3, 0x45, 0x82, 0x45, 0x81,
-	0x51, 0x83, 0x33, 0x08, 0x21, 0xa0, 0xa4, 0xc5, 0xc5, 0x13, 0x94, 0x9a, 0x98, 0x52, 0x49, 0x84,
-	0x2b, 0x94, 0x74, 0xb9, 0xf8, 0x1d, 0xf3, 0xf2, 0xf2, 0x4b, 0xf3, 0x92, 0x53, 0x89, 0x50, 0xee,
-	0x64, 0x71, 0xe1, 0xa1, 0x1c, 0xc3, 0x8d, 0x87, 0x72, 0x0c, 0x1f, 0x1e, 0xca, 0x31, 0x36, 0x3c,
-	0x92, 0x63, 0x5c, 0xf1, 0x48, 0x8e, 0xf1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f,
-	0x3c, 0x92, 0x63, 0x7c, 0xf1, 0x48, 0x8e, 0xe1, 0xc3, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18,
-	0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x8a, 0xa9, 0x20, 0x29, 0x89, 0x0d, 0x1c,
-	0x5a, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x80, 0x6d, 0xec, 0x07, 0x41, 0x01, 0x00, 0x00,
+	// 371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xb1, 0x4f, 0xfa, 0x40,
+	0x14, 0xc7, 0x7b, 0xed, 0x8f, 0x9f, 0x70, 0xd4, 0x40, 0x4e, 0x86, 0x86, 0x98, 0x4b, 0xd3, 0xc1,
+	0x74, 0xb1, 0x1a, 0x5d, 0x88, 0x9b, 0x08, 0x26, 0x0c, 0x1a, 0x72, 0x18, 0x07, 0xb7, 0xd2, 0x9e,
+	0xd8, 0x00, 0x77, 0xcd, 0x5d, 0x31, 0xe9, 0xe6, 0xec, 0xe4, 0x9f, 0xe1, 0x9f, 0xe1, 0xe8, 0xc8,
+	0xc8, 0x28, 0x65, 0x71, 0xe4, 0x4f, 0x30, 0x14, 0x50, 0x20, 0x0c, 0x6c, 0xf7, 0x3e, 0xef, 0xbd,
+	0xdc, 0xe7, 0x7d, 0x61, 0x31, 0x6c, 0x9f, 0xf4, 0xa9, 0x94, 0x6e, 0x87, 0x3a, 0xa1, 0xe0, 0x11,
+	0x47, 0x5a, 0x24, 0xa5, 0xf5, 0x0a, 0x20, 0xba, 0x6b, 0xb5, 0x9a, 0x33, 0xe2, 0xf1, 0xde, 0xcd,
+	0x7c, 0x02, 0x95, 0x61, 0x56, 0x52, 0xe6, 0x53, 0xd1, 0xa8, 0x19, 0xc0, 0x04, 0xb6, 0x4e, 0x7e,
+	0x6b, 0x64, 0xc0, 0xbd, 0xd0, 0x8d, 0x7b, 0xdc, 0xf5, 0x0d, 0x35, 0x6d, 0x2d, 0x4b, 0x64, 0xc2,
+	0x7c, 0x20, 0xab, 0x82, 0xbb, 0xbe, 0xe7, 0xca, 0xc8, 0xd0, 0x4c, 0x60, 0x67, 0xc9, 0x2a, 0x42,
+	0x87, 0x30, 0x27, 0xa9, 0x94, 0x01, 0x67, 0x8d, 0x9a, 0xf1, 0xcf, 0x04, 0x76, 0x8e, 0xfc, 0x01,
+	0xeb, 0x43, 0x85, 0x3a, 0xa1, 0xae, 0x1f, 0xef, 0xa2, 0x61, 0x41, 0x7d, 0xb1, 0x79, 0xcb, 0x99,
+	0x47, 0x17, 0x2e, 0x6b, 0x0c, 0x35, 0x61, 0xc1, 0xe3, 0xec, 0x31, 0x10, 0x7d, 0xea, 0xa7, 0x44,
+	0x1a, 0x9a, 0xa9, 0xd9, 0xf9, 0xb3, 0x23, 0x27, 0x92, 0xd2, 0x59, 0xfd, 0xcb, 0xb9, 0x5a, 0x1f,
+	0xac, 0xb3, 0x48, 0xc4, 0x64, 0x73, 0x1d, 0x9d, 0xc2, 0x83, 0x2e, 0x8d, 0xeb, 0x21, 0xf7, 0x9e,
+	0xae, 0x03, 0xd6, 0xa1, 0x22, 0x14, 0x01, 0x8b, 0xd2, 0x53, 0x74, 0xb2, 0xad, 0x85, 0x6c, 0x58,
+	0x08, 0x17, 0xe9, 0xde, 0x53, 0x31, 0x73, 0x33, 0x32, 0x26, 0xb0, 0xf7, 0xc9, 0x26, 0x2e, 0x57,
+	0x61, 0x69, 0x9b, 0x04, 0x2a, 0x42, 0xad, 0x4b, 0xe3, 0x34, 0x80, 0x1c, 0x99, 0x3d, 0x51, 0x09,
+	0x66, 0x9e, 0xdd, 0xde, 0x60, 0x79, 0xf4, 0xbc, 0xb8, 0x50, 0x2b, 0xc0, 0x3a, 0x86, 0x85, 0x4b,
+	0xc6, 0xf8, 0x80, 0x79, 0x74, 0x87, 0x10, 0xab, 0x95, 0xe1, 0x18, 0x2b, 0xa3, 0x31, 0x56, 0xa6,
+	0x63, 0x0c, 0x5e, 0x12, 0x0c, 0xde, 0x13, 0x0c, 0x3e, 0x13, 0x0c, 0x86, 0x09, 0x06, 0x5f, 0x09,
+	0x06, 0xdf, 0x09, 0x56, 0xa6, 0x09, 0x06, 0x6f, 0x13, 0xac, 0x0c, 0x27, 0x58, 0x19, 0x4d, 0xb0,
+	0xf2, 0xa0, 0x86, 0xed, 0xf6, 0xff, 0xd4, 0xfe, 0xfc, 0x67, 0x00, 0xab, 0xfb, 0x93, 0x26, 0x58,
+	0x02, 0x00, 0x00,
 }
 
 func (this *TSSProtocolMessage) Equal(that interface{}) bool {
@@ -261,6 +304,23 @@ func (this *ReadyMessage) Equal(that interface{}) bool {
 	if !bytes.Equal(this.SenderID, that1.SenderID) {
 		return false
 	}
+	if !bytes.Equal(this.SessionNonce, that1.SessionNonce) {
+		return false
+	}
+	if len(this.ConfirmedNonces) != len(that1.ConfirmedNonces) {
+		return false
+	}
+	for i := range this.ConfirmedNonces {
+		if !bytes.Equal(this.ConfirmedNonces[i], that1.ConfirmedNonces[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.KeyEpochFingerprint, that1.KeyEpochFingerprint) {
+		return false
+	}
+	if this.ProtocolVersion != that1.ProtocolVersion {
+		return false
+	}
 	return true
 }
 func (this *AnnounceMessage) Equal(that interface{}) bool {
@@ -304,9 +364,25 @@ func (this *ReadyMessage) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 9)
 	s = append(s, "&pb.ReadyMessage{")
 	s = append(s, "SenderID: "+fmt.Sprintf("%#v", this.SenderID)+",\n")
+	s = append(s, "SessionNonce: "+fmt.Sprintf("%#v", this.SessionNonce)+",\n")
+	keysForConfirmedNonces := make([]string, 0, len(this.ConfirmedNonces))
+	for k, _ := range this.ConfirmedNonces {
+		keysForConfirmedNonces = append(keysForConfirmedNonces, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForConfirmedNonces)
+	mapStringForConfirmedNonces := "map[string][]byte{"
+	for _, k := range keysForConfirmedNonces {
+		mapStringForConfirmedNonces += fmt.Sprintf("%#v: %#v,", k, this.ConfirmedNonces[k])
+	}
+	mapStringForConfirmedNonces += "}"
+	if this.ConfirmedNonces != nil {
+		s = append(s, "ConfirmedNonces: "+mapStringForConfirmedNonces+",\n")
+	}
+	s = append(s, "KeyEpochFingerprint: "+fmt.Sprintf("%#v", this.KeyEpochFingerprint)+",\n")
+	s = append(s, "ProtocolVersion: "+fmt.Sprintf("%#v", this.ProtocolVersion)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -402,6 +478,46 @@ func (m *ReadyMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProtocolVersion != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ProtocolVersion))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.KeyEpochFingerprint) > 0 {
+		i -= len(m.KeyEpochFingerprint)
+		copy(dAtA[i:], m.KeyEpochFingerprint)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.KeyEpochFingerprint)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConfirmedNonces) > 0 {
+		for k := range m.ConfirmedNonces {
+			v := m.ConfirmedNonces[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintMessage(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SessionNonce) > 0 {
+		i -= len(m.SessionNonce)
+		copy(dAtA[i:], m.SessionNonce)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.SessionNonce)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.SenderID) > 0 {
 		i -= len(m.SenderID)
 		copy(dAtA[i:], m.SenderID)
@@ -487,6 +603,29 @@ func (m *ReadyMessage) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	l = len(m.SessionNonce)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.ConfirmedNonces) > 0 {
+		for k, v := range m.ConfirmedNonces {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovMessage(uint64(len(v)))
+			}
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.KeyEpochFingerprint)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovMessage(uint64(m.ProtocolVersion))
+	}
 	return n
 }
 
@@ -526,8 +665,22 @@ func (this *ReadyMessage) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForConfirmedNonces := make([]string, 0, len(this.ConfirmedNonces))
+	for k, _ := range this.ConfirmedNonces {
+		keysForConfirmedNonces = append(keysForConfirmedNonces, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForConfirmedNonces)
+	mapStringForConfirmedNonces := "map[string][]byte{"
+	for _, k := range keysForConfirmedNonces {
+		mapStringForConfirmedNonces += fmt.Sprintf("%v: %v,", k, this.ConfirmedNonces[k])
+	}
+	mapStringForConfirmedNonces += "}"
 	s := strings.Join([]string{`&ReadyMessage{`,
 		`SenderID:` + fmt.Sprintf("%v", this.SenderID) + `,`,
+		`SessionNonce:` + fmt.Sprintf("%v", this.SessionNonce) + `,`,
+		`ConfirmedNonces:` + mapStringForConfirmedNonces + `,`,
+		`KeyEpochFingerprint:` + fmt.Sprintf("%v", this.KeyEpochFingerprint) + `,`,
+		`ProtocolVersion:` + fmt.Sprintf("%v", this.ProtocolVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -786,6 +939,221 @@ func (m *ReadyMessage) Unmarshal(dAtA []byte) error {
 				m.SenderID = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionNonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionNonce = append(m.SessionNonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionNonce == nil {
+				m.SessionNonce = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmedNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfirmedNonces == nil {
+				m.ConfirmedNonces = make(map[string][]byte)
+			}
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ConfirmedNonces[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyEpochFingerprint", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyEpochFingerprint = append(m.KeyEpochFingerprint[:0], dAtA[iNdEx:postIndex]...)
+			if m.KeyEpochFingerprint == nil {
+				m.KeyEpochFingerprint = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -27,8 +27,10 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type ThresholdSigner struct {
-	GroupInfo    *ThresholdSigner_GroupInfo `protobuf:"bytes,1,opt,name=groupInfo,proto3" json:"groupInfo,omitempty"`
-	ThresholdKey []byte                     `protobuf:"bytes,2,opt,name=thresholdKey,proto3" json:"thresholdKey,omitempty"`
+	GroupInfo       *ThresholdSigner_GroupInfo `protobuf:"bytes,1,opt,name=groupInfo,proto3" json:"groupInfo,omitempty"`
+	ThresholdKey    []byte                     `protobuf:"bytes,2,opt,name=thresholdKey,proto3" json:"thresholdKey,omitempty"`
+	KeyEpoch        uint64                     `protobuf:"varint,3,opt,name=keyEpoch,proto3" json:"keyEpoch,omitempty"`
+	ProtocolVersion uint32                     `protobuf:"varint,4,opt,name=protocolVersion,proto3" json:"protocolVersion,omitempty"`
 }
 
 func (m *ThresholdSigner) Reset()      { *m = ThresholdSigner{} }
@@ -77,6 +79,20 @@ func (m *ThresholdSigner) GetThresholdKey() []byte {
 	return nil
 }
 
+func (m *ThresholdSigner) GetKeyEpoch() uint64 {
+	if m != nil {
+		return m.KeyEpoch
+	}
+	return 0
+}
+
+func (m *ThresholdSigner) GetProtocolVersion() uint32 {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return 0
+}
+
 type ThresholdSigner_GroupInfo struct {
 	GroupID            string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
 	MemberID           []byte   `protobuf:"bytes,2,opt,name=memberID,proto3" json:"memberID,omitempty"`
@@ -526,46 +542,48 @@ func init() {
 func init() { proto.RegisterFile("pb/signer.proto", fileDescriptor_362f9e86e7c5d639) }
 
 var fileDescriptor_362f9e86e7c5d639 = []byte{
-	// 618 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xeb, 0x74, 0xfd, 0xf5, 0x5a, 0x75, 0xc8, 0x42, 0xc8, 0xaa, 0x90, 0x89, 0x26, 0x98,
-	0x7a, 0x0a, 0x5a, 0x11, 0xd2, 0x24, 0x38, 0xc1, 0x10, 0x4c, 0x1d, 0x53, 0x48, 0x77, 0x98, 0xb8,
-	0x39, 0xad, 0x59, 0xdc, 0xa5, 0x4d, 0x66, 0x67, 0x53, 0x7b, 0xe3, 0x3f, 0x80, 0x2b, 0xff, 0x01,
-	0xff, 0x00, 0x67, 0xae, 0x1c, 0x77, 0xdc, 0x91, 0x65, 0x17, 0x8e, 0xfb, 0x13, 0x50, 0x1c, 0xa7,
-	0xdb, 0xca, 0xcf, 0x9b, 0xbf, 0x9f, 0xbc, 0xf7, 0xfc, 0xfc, 0x7d, 0x76, 0x60, 0x35, 0xf6, 0x1f,
-	0x2a, 0x71, 0x30, 0xe5, 0xd2, 0x89, 0x65, 0x94, 0x44, 0xb8, 0x9c, 0x28, 0xb5, 0xf6, 0xc1, 0x82,
-	0xd5, 0xbd, 0x40, 0x72, 0x15, 0x44, 0xe1, 0x68, 0xa0, 0x3f, 0xe3, 0xa7, 0xd0, 0x38, 0x90, 0xd1,
-	0x71, 0xbc, 0x3d, 0x7d, 0x17, 0x11, 0x64, 0xa3, 0x6e, 0xb3, 0x47, 0x9d, 0x44, 0x29, 0x67, 0x29,
-	0xd0, 0x79, 0x59, 0x44, 0x79, 0x57, 0x09, 0x78, 0x0d, 0x5a, 0x49, 0x11, 0xd7, 0xe7, 0x73, 0x62,
-	0xd9, 0xa8, 0xdb, 0xf2, 0x6e, 0xb0, 0xce, 0x27, 0x04, 0x8d, 0x45, 0x32, 0x26, 0x50, 0xcb, 0xd3,
-	0xb7, 0xf4, 0x6e, 0x0d, 0xaf, 0x90, 0xb8, 0x03, 0xf5, 0x09, 0x9f, 0xf8, 0x5c, 0x6e, 0x6f, 0x99,
-	0x3a, 0x0b, 0x8d, 0xd7, 0xa1, 0xad, 0xc3, 0x5e, 0x1b, 0xa0, 0x48, 0xd9, 0x2e, 0x77, 0x5b, 0xde,
-	0x12, 0xc5, 0x0e, 0xe0, 0x91, 0x50, 0x41, 0x34, 0xe5, 0x2a, 0x59, 0x1c, 0x80, 0xac, 0xd8, 0xa8,
-	0x5b, 0xf1, 0x7e, 0xf3, 0x65, 0xed, 0x4b, 0x15, 0xf0, 0x4e, 0x34, 0x64, 0xa1, 0xcb, 0x64, 0x32,
-	0x1f, 0xb0, 0x13, 0xbe, 0xc5, 0x12, 0x86, 0x77, 0xa1, 0x1d, 0x6a, 0x2a, 0xb9, 0xcb, 0x24, 0x9b,
-	0x28, 0xe3, 0xcc, 0xba, 0x76, 0xe6, 0xd7, 0x04, 0x83, 0x8a, 0x68, 0x6f, 0x29, 0x1b, 0xbf, 0x82,
-	0x96, 0x26, 0x03, 0x3e, 0x94, 0x3c, 0x51, 0xfa, 0x78, 0xcd, 0xde, 0xfd, 0xbf, 0x56, 0x33, 0xb1,
-	0xde, 0x8d, 0x4c, 0xdc, 0x06, 0xeb, 0xb0, 0x38, 0xbc, 0x75, 0xa8, 0x32, 0x3b, 0xa7, 0x7b, 0x22,
-	0x1c, 0xf1, 0x31, 0x59, 0xd1, 0xb0, 0x90, 0xf8, 0x16, 0x94, 0x83, 0x8d, 0x31, 0xa9, 0x68, 0x9a,
-	0x2d, 0x35, 0xe9, 0x8d, 0x49, 0xd5, 0x90, 0xde, 0x18, 0x3f, 0x86, 0x8a, 0x2f, 0x0e, 0xf6, 0xc7,
-	0xa4, 0x66, 0x97, 0xbb, 0xcd, 0xde, 0xbd, 0x3f, 0x35, 0xf4, 0xe2, 0xb9, 0x1b, 0x89, 0x69, 0xe2,
-	0xe5, 0xd1, 0xd8, 0x86, 0x66, 0xcc, 0x44, 0x18, 0x0a, 0x2e, 0xdd, 0xbe, 0x22, 0x75, 0x5d, 0xf0,
-	0x3a, 0xc2, 0x4f, 0xa0, 0xce, 0x87, 0x23, 0xc5, 0xdc, 0x63, 0x9f, 0x34, 0xf4, 0x61, 0xff, 0x59,
-	0x7b, 0x91, 0xd0, 0xf9, 0x6a, 0x41, 0xfb, 0xa6, 0xa1, 0xf8, 0x0d, 0x40, 0x51, 0x7e, 0xd0, 0x37,
-	0xc3, 0xd8, 0xf8, 0xbf, 0x61, 0x38, 0xae, 0x14, 0x27, 0x2c, 0xe1, 0x7d, 0x3e, 0xf7, 0xae, 0x15,
-	0xc1, 0x77, 0xa0, 0x9a, 0x5b, 0x65, 0x2e, 0x9b, 0x51, 0xb9, 0x6f, 0x82, 0x94, 0x35, 0xcc, 0x96,
-	0xb9, 0x6f, 0x42, 0xdf, 0x22, 0xed, 0x9b, 0xc0, 0xb7, 0xa1, 0xc2, 0xc2, 0x38, 0x60, 0xa4, 0xa2,
-	0x59, 0x2e, 0x30, 0x86, 0x15, 0x9f, 0x27, 0x8c, 0x54, 0x35, 0xd4, 0x6b, 0xdc, 0x02, 0x14, 0x93,
-	0x9a, 0x06, 0x28, 0xce, 0xd4, 0x11, 0xa9, 0xe7, 0xea, 0xa8, 0xb3, 0x0f, 0x70, 0xd5, 0x1b, 0xbe,
-	0x0b, 0x8d, 0xf8, 0xd8, 0x0f, 0xc5, 0x30, 0x7b, 0x47, 0x48, 0xc7, 0x5c, 0x81, 0x6c, 0xce, 0x21,
-	0x9b, 0xf8, 0x23, 0xb6, 0x6b, 0xda, 0x2d, 0x64, 0xb6, 0x6b, 0x1c, 0x88, 0x5d, 0xd3, 0xb0, 0x5e,
-	0x77, 0x36, 0xa1, 0xb5, 0xb3, 0x74, 0x6b, 0x66, 0xc2, 0x14, 0xb5, 0x66, 0x22, 0xab, 0xa6, 0x02,
-	0x26, 0xf9, 0xe2, 0xa5, 0x15, 0xb2, 0xf3, 0x00, 0x6a, 0x66, 0x20, 0x59, 0xb3, 0x33, 0x93, 0x83,
-	0x66, 0x99, 0x2a, 0x9e, 0x37, 0x9a, 0x3f, 0xdb, 0x3c, 0x3d, 0xa7, 0xa5, 0xb3, 0x73, 0x5a, 0xba,
-	0x3c, 0xa7, 0xe8, 0x7d, 0x4a, 0xd1, 0xe7, 0x94, 0xa2, 0x6f, 0x29, 0x45, 0xa7, 0x29, 0x45, 0xdf,
-	0x53, 0x8a, 0x7e, 0xa4, 0xb4, 0x74, 0x99, 0x52, 0xf4, 0xf1, 0x82, 0x96, 0x4e, 0x2f, 0x68, 0xe9,
-	0xec, 0x82, 0x96, 0xde, 0x5a, 0xb1, 0xef, 0x57, 0xf5, 0xff, 0xe8, 0xd1, 0xcf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x2a, 0x91, 0x3c, 0xcc, 0xa2, 0x04, 0x00, 0x00,
+	// 651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x14, 0xc5, 0x33, 0xce, 0xf7, 0x4d, 0xfe, 0xe9, 0x5f, 0x23, 0x84, 0x46, 0x11, 0x1a, 0xac, 0x0a,
+	0x2a, 0xaf, 0x8c, 0x1a, 0x84, 0x54, 0x09, 0x56, 0xd0, 0x0a, 0xaa, 0x94, 0x2a, 0x38, 0x15, 0xaa,
+	0xd8, 0x8d, 0x93, 0xa1, 0x9e, 0xd4, 0x89, 0x5d, 0x8f, 0x5b, 0x25, 0x3b, 0x1e, 0x81, 0x2d, 0x6f,
+	0xc0, 0x0b, 0xb0, 0x66, 0xcb, 0xb2, 0x1b, 0xa4, 0x2e, 0xa9, 0xbb, 0x61, 0xd9, 0x47, 0x40, 0x1e,
+	0x8f, 0x93, 0x26, 0x7c, 0xa8, 0xbb, 0x39, 0x3f, 0xdf, 0x7b, 0x7d, 0x7c, 0x66, 0x3c, 0xb0, 0x16,
+	0xba, 0x8f, 0xa4, 0x38, 0x9a, 0xf0, 0xc8, 0x0e, 0xa3, 0x20, 0x0e, 0x70, 0x31, 0x96, 0x72, 0xfd,
+	0xbb, 0x01, 0x6b, 0x07, 0x5e, 0xc4, 0xa5, 0x17, 0xf8, 0xc3, 0xbe, 0x7a, 0x8c, 0x9f, 0x41, 0xfd,
+	0x28, 0x0a, 0x4e, 0xc3, 0xdd, 0xc9, 0xfb, 0x80, 0x20, 0x13, 0x59, 0x8d, 0x0e, 0xb5, 0x63, 0x29,
+	0xed, 0x95, 0x42, 0xfb, 0x65, 0x5e, 0xe5, 0x2c, 0x1a, 0xf0, 0x3a, 0x34, 0xe3, 0xbc, 0xae, 0xcb,
+	0x67, 0xc4, 0x30, 0x91, 0xd5, 0x74, 0x96, 0x18, 0x6e, 0x43, 0xed, 0x98, 0xcf, 0x76, 0xc2, 0x60,
+	0xe0, 0x91, 0xa2, 0x89, 0xac, 0x92, 0x33, 0xd7, 0xd8, 0x82, 0x35, 0xe5, 0x6f, 0x10, 0xf8, 0x6f,
+	0x79, 0x24, 0x45, 0x30, 0x21, 0x25, 0x13, 0x59, 0xff, 0x39, 0xab, 0xb8, 0xfd, 0x09, 0x41, 0x7d,
+	0x6e, 0x01, 0x13, 0xa8, 0x66, 0x26, 0xb6, 0x95, 0xe7, 0xba, 0x93, 0xcb, 0xf4, 0x6d, 0x63, 0x3e,
+	0x76, 0x79, 0xb4, 0xbb, 0xad, 0xdd, 0xcc, 0x35, 0xde, 0x80, 0x96, 0x2a, 0x7b, 0xad, 0x81, 0x24,
+	0x45, 0xb3, 0x68, 0x35, 0x9d, 0x15, 0x8a, 0x6d, 0xc0, 0x43, 0x21, 0xbd, 0x60, 0xc2, 0x65, 0x3c,
+	0x8f, 0x41, 0x19, 0x2b, 0x3b, 0x7f, 0x78, 0xb2, 0xfe, 0xa5, 0x02, 0x78, 0x2f, 0x18, 0x30, 0xbf,
+	0xc7, 0xa2, 0x78, 0xd6, 0x67, 0x67, 0x7c, 0x9b, 0xc5, 0x0c, 0xef, 0x43, 0xcb, 0x57, 0x34, 0xe2,
+	0x3d, 0x16, 0xb1, 0xb1, 0xd4, 0xf9, 0x6e, 0xa8, 0x7c, 0x7f, 0x6f, 0xb0, 0xf7, 0x96, 0xaa, 0x9d,
+	0x95, 0x6e, 0xfc, 0x0a, 0x9a, 0x8a, 0xf4, 0xf9, 0x20, 0xe2, 0xb1, 0x54, 0x9f, 0xd7, 0xe8, 0x3c,
+	0xf8, 0xe7, 0x34, 0x5d, 0xeb, 0x2c, 0x75, 0xe2, 0x16, 0x18, 0xc7, 0xf9, 0xc7, 0x1b, 0xc7, 0x32,
+	0x8d, 0x73, 0x72, 0x20, 0xfc, 0x21, 0x1f, 0x91, 0x92, 0x82, 0xb9, 0xc4, 0xff, 0x43, 0xd1, 0xdb,
+	0x1c, 0x91, 0xb2, 0xa2, 0xe9, 0x52, 0x91, 0xce, 0x88, 0x54, 0x34, 0xe9, 0x8c, 0xf0, 0x13, 0x28,
+	0xbb, 0xe2, 0xe8, 0x70, 0x44, 0xaa, 0x66, 0xd1, 0x6a, 0x74, 0xee, 0xff, 0xcd, 0xd0, 0xce, 0x8b,
+	0x5e, 0x20, 0x26, 0xb1, 0x93, 0x55, 0x63, 0x13, 0x1a, 0x21, 0x13, 0xbe, 0x2f, 0x78, 0xd4, 0xeb,
+	0x4a, 0x52, 0x53, 0x03, 0x6f, 0x22, 0xfc, 0x14, 0x6a, 0x7c, 0x30, 0x94, 0xac, 0x77, 0xea, 0x92,
+	0xba, 0x89, 0x6e, 0x33, 0x7b, 0xde, 0xd0, 0xfe, 0x6a, 0x40, 0x6b, 0x39, 0x50, 0xfc, 0x06, 0x20,
+	0x1f, 0xdf, 0xef, 0xea, 0xcd, 0xd8, 0xbc, 0xdd, 0x66, 0xd8, 0xbd, 0x48, 0x9c, 0xb1, 0x98, 0x77,
+	0xf9, 0xcc, 0xb9, 0x31, 0x04, 0xdf, 0x85, 0x4a, 0x16, 0x95, 0x3e, 0x6c, 0x5a, 0x65, 0xb9, 0x09,
+	0x75, 0xde, 0x55, 0x6e, 0x22, 0xcb, 0x4d, 0x90, 0x92, 0x26, 0x1d, 0x81, 0xef, 0x40, 0x99, 0xf9,
+	0xa1, 0xc7, 0x48, 0x59, 0xb1, 0x4c, 0x60, 0x0c, 0x25, 0x97, 0xc7, 0x8c, 0x54, 0x14, 0x54, 0x6b,
+	0xdc, 0x04, 0x14, 0x92, 0xaa, 0x02, 0x28, 0x4c, 0xd5, 0x09, 0xa9, 0x65, 0xea, 0xa4, 0x7d, 0x08,
+	0xb0, 0xf0, 0x86, 0xef, 0x41, 0x3d, 0x3c, 0x75, 0x7d, 0x31, 0x48, 0xff, 0x46, 0xa4, 0x6a, 0x16,
+	0x20, 0xdd, 0x67, 0x9f, 0x8d, 0xdd, 0x21, 0xdb, 0xd7, 0x76, 0x73, 0x99, 0xbe, 0x35, 0xf4, 0xc4,
+	0xbe, 0x36, 0xac, 0xd6, 0xed, 0x2d, 0x68, 0xee, 0xad, 0x9c, 0x9a, 0xa9, 0xd0, 0x43, 0x8d, 0xa9,
+	0x48, 0xa7, 0x49, 0x8f, 0x45, 0x7c, 0xfe, 0xa7, 0xe5, 0xb2, 0xfd, 0x10, 0xaa, 0x7a, 0x43, 0x52,
+	0xb3, 0x53, 0xdd, 0x83, 0xa6, 0xa9, 0xca, 0x2f, 0x09, 0x34, 0x7b, 0xbe, 0x75, 0x7e, 0x49, 0x0b,
+	0x17, 0x97, 0xb4, 0x70, 0x7d, 0x49, 0xd1, 0x87, 0x84, 0xa2, 0xcf, 0x09, 0x45, 0xdf, 0x12, 0x8a,
+	0xce, 0x13, 0x8a, 0x7e, 0x24, 0x14, 0xfd, 0x4c, 0x68, 0xe1, 0x3a, 0xa1, 0xe8, 0xe3, 0x15, 0x2d,
+	0x9c, 0x5f, 0xd1, 0xc2, 0xc5, 0x15, 0x2d, 0xbc, 0x33, 0x42, 0xd7, 0xad, 0xa8, 0xeb, 0xe1, 0xf1,
+	0xaf, 0x01, 0x00, 0xe6, 0x55, 0x98, 0x61, 0xe8, 0x04, 0x00, 0x00,
 }
 
 func (this *ThresholdSigner) Equal(that interface{}) bool {
@@ -593,6 +611,12 @@ func (this *ThresholdSigner) Equal(that interface{}) bool {
 	if !bytes.Equal(this.ThresholdKey, that1.ThresholdKey) {
 		return false
 	}
+	if this.KeyEpoch != that1.KeyEpoch {
+		return false
+	}
+	if this.ProtocolVersion != that1.ProtocolVersion {
+		return false
+	}
 	return true
 }
 func (this *ThresholdSigner_GroupInfo) Equal(that interface{}) bool {
@@ -844,12 +868,14 @@ func (this *ThresholdSigner) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&pb.ThresholdSigner{")
 	if this.GroupInfo != nil {
 		s = append(s, "GroupInfo: "+fmt.Sprintf("%#v", this.GroupInfo)+",\n")
 	}
 	s = append(s, "ThresholdKey: "+fmt.Sprintf("%#v", this.ThresholdKey)+",\n")
+	s = append(s, "KeyEpoch: "+fmt.Sprintf("%#v", this.KeyEpoch)+",\n")
+	s = append(s, "ProtocolVersion: "+fmt.Sprintf("%#v", this.ProtocolVersion)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -973,6 +999,16 @@ func (m *ThresholdSigner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProtocolVersion != 0 {
+		i = encodeVarintSigner(dAtA, i, uint64(m.ProtocolVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.KeyEpoch != 0 {
+		i = encodeVarintSigner(dAtA, i, uint64(m.KeyEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.ThresholdKey) > 0 {
 		i -= len(m.ThresholdKey)
 		copy(dAtA[i:], m.ThresholdKey)
@@ -1391,6 +1427,12 @@ func (m *ThresholdSigner) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSigner(uint64(l))
 	}
+	if m.KeyEpoch != 0 {
+		n += 1 + sovSigner(uint64(m.KeyEpoch))
+	}
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovSigner(uint64(m.ProtocolVersion))
+	}
 	return n
 }
 
@@ -1586,6 +1628,8 @@ func (this *ThresholdSigner) String() string {
 	s := strings.Join([]string{`&ThresholdSigner{`,
 		`GroupInfo:` + strings.Replace(fmt.Sprintf("%v", this.GroupInfo), "ThresholdSigner_GroupInfo", "ThresholdSigner_GroupInfo", 1) + `,`,
 		`ThresholdKey:` + fmt.Sprintf("%v", this.ThresholdKey) + `,`,
+		`KeyEpoch:` + fmt.Sprintf("%v", this.KeyEpoch) + `,`,
+		`ProtocolVersion:` + fmt.Sprintf("%v", this.ProtocolVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1784,6 +1828,44 @@ func (m *ThresholdSigner) Unmarshal(dAtA []byte) error {
 				m.ThresholdKey = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyEpoch", wireType)
+			}
+			m.KeyEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSigner(dAtA[iNdEx:])
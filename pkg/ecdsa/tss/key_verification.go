@@ -0,0 +1,94 @@
+package tss
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	tssLib "github.com/binance-chain/tss-lib/tss"
+)
+
+// VerifyKey checks if the signer's threshold key is internally consistent.
+// It validates that the public share of the signer matches its private share
+// and that the group public key is the one interpolated from public shares
+// of all group members.
+func (s *ThresholdSigner) VerifyKey() error {
+	key := s.thresholdKey
+
+	if key.Xi == nil || key.ShareID == nil || key.ECDSAPub == nil {
+		return fmt.Errorf("threshold key is incomplete")
+	}
+
+	if len(key.Ks) == 0 || len(key.Ks) != len(key.BigXj) {
+		return fmt.Errorf(
+			"threshold key has [%d] share indices and [%d] public shares",
+			len(key.Ks),
+			len(key.BigXj),
+		)
+	}
+
+	curve := tssLib.EC()
+
+	shareIndex := -1
+	for i, k := range key.Ks {
+		if k != nil && k.Cmp(key.ShareID) == 0 {
+			shareIndex = i
+			break
+		}
+	}
+	if shareIndex < 0 {
+		return fmt.Errorf("share index of the signer not found")
+	}
+
+	publicShare := key.BigXj[shareIndex]
+	if publicShare == nil ||
+		!publicShare.Equals(crypto.ScalarBaseMult(curve, key.Xi)) {
+		return fmt.Errorf("public share does not match private share")
+	}
+
+	modN := common.ModInt(curve.Params().N)
+
+	var publicKey *crypto.ECPoint
+	for i, k := range key.Ks {
+		if k == nil || key.BigXj[i] == nil {
+			return fmt.Errorf("public share [%d] is missing", i)
+		}
+
+		// Lagrange coefficient of the share evaluated at zero.
+		coefficient := big.NewInt(1)
+		for j, otherK := range key.Ks {
+			if j == i {
+				continue
+			}
+			if otherK == nil || otherK.Cmp(k) == 0 {
+				return fmt.Errorf("share indices are not unique")
+			}
+
+			coefficient = modN.Mul(
+				coefficient,
+				modN.Mul(otherK, modN.ModInverse(modN.Sub(otherK, k))),
+			)
+		}
+
+		term := key.BigXj[i].ScalarMult(coefficient)
+		if publicKey == nil {
+			publicKey = term
+			continue
+		}
+
+		var err error
+		publicKey, err = publicKey.Add(term)
+		if err != nil {
+			return fmt.Errorf("failed to interpolate group public key: [%v]", err)
+		}
+	}
+
+	if !publicKey.Equals(key.ECDSAPub) {
+		return fmt.Errorf(
+			"group public key does not match public shares of members",
+		)
+	}
+
+	return nil
+}
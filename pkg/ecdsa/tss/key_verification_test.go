@@ -0,0 +1,81 @@
+package tss
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/binance-chain/tss-lib/crypto"
+	tssLib "github.com/binance-chain/tss-lib/tss"
+
+	"github.com/keep-network/keep-ecdsa/internal/testdata"
+)
+
+func TestVerifyKey(t *testing.T) {
+	groupSize := 5
+
+	var tests = map[string]struct {
+		corruptKey    func(key *ThresholdKey)
+		expectedError bool
+	}{
+		"valid key": {
+			corruptKey:    func(key *ThresholdKey) {},
+			expectedError: false,
+		},
+		"private share does not match public share": {
+			corruptKey: func(key *ThresholdKey) {
+				key.Xi = new(big.Int).Add(key.Xi, big.NewInt(1))
+			},
+			expectedError: true,
+		},
+		"public share of other member corrupted": {
+			corruptKey: func(key *ThresholdKey) {
+				for i, k := range key.Ks {
+					if k.Cmp(key.ShareID) != 0 {
+						key.BigXj[i] = crypto.ScalarBaseMult(
+							tssLib.EC(),
+							big.NewInt(1),
+						)
+						return
+					}
+				}
+			},
+			expectedError: true,
+		},
+		"group public key corrupted": {
+			corruptKey: func(key *ThresholdKey) {
+				key.ECDSAPub = crypto.ScalarBaseMult(tssLib.EC(), big.NewInt(1))
+			},
+			expectedError: true,
+		},
+		"share index missing": {
+			corruptKey: func(key *ThresholdKey) {
+				key.ShareID = big.NewInt(1)
+			},
+			expectedError: true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			testData, err := testdata.LoadKeygenTestFixtures(groupSize)
+			if err != nil {
+				t.Fatalf("failed to load test data: [%v]", err)
+			}
+
+			for i := range testData {
+				key := ThresholdKey(testData[i])
+				test.corruptKey(&key)
+
+				signer := &ThresholdSigner{thresholdKey: key}
+
+				err := signer.VerifyKey()
+				if test.expectedError && err == nil {
+					t.Errorf("expected error for signer [%d]", i)
+				}
+				if !test.expectedError && err != nil {
+					t.Errorf("unexpected error for signer [%d]: [%v]", i, err)
+				}
+			}
+		})
+	}
+}
@@ -75,7 +75,8 @@ type member struct {
 // generateKey executes the protocol to generate a signing key. This function
 // needs to be executed only after all members finished the initialization stage.
 // As a result it will return a Signer who has completed key generation, or error
-// if the key generation failed.
+// if the key generation failed. If tss-lib blamed some members for sending
+// invalid messages, they are reported in the error as culprits.
 func (s *member) generateKey(ctx context.Context) (*ThresholdSigner, error) {
 	if err := s.keygenParty.Start(); err != nil {
 		return nil, fmt.Errorf(
@@ -112,7 +113,10 @@ func (s *member) generateKey(ctx context.Context) (*ThresholdSigner, error) {
 				}
 			}
 
-			return nil, timeoutError{KeyGenerationProtocolTimeout, "key generation", memberIDs}
+			return nil, s.networkBridge.withCulprits(fmt.Errorf(
+				"failed to generate key: [%v]",
+				timeoutError{KeyGenerationProtocolTimeout, "key generation", memberIDs},
+			))
 		}
 	}
 }
@@ -33,8 +33,10 @@ func (s *ThresholdSigner) Marshal() ([]byte, error) {
 	}
 
 	return (&pb.ThresholdSigner{
-		GroupInfo:    group,
-		ThresholdKey: keygenData,
+		GroupInfo:       group,
+		ThresholdKey:    keygenData,
+		KeyEpoch:        s.keyEpoch,
+		ProtocolVersion: s.protocolVersion,
 	}).Marshal()
 }
 
@@ -68,6 +70,9 @@ func (s *ThresholdSigner) Unmarshal(bytes []byte) error {
 		dishonestThreshold: int(pbGroupInfo.GetDishonestThreshold()),
 	}
 
+	s.keyEpoch = pbSigner.GetKeyEpoch()
+	s.protocolVersion = pbSigner.GetProtocolVersion()
+
 	return nil
 }
 
@@ -238,7 +243,11 @@ func (m *TSSProtocolMessage) Unmarshal(bytes []byte) error {
 // Marshal converts this message to a byte array suitable for network communication.
 func (m *ReadyMessage) Marshal() ([]byte, error) {
 	return (&pb.ReadyMessage{
-		SenderID: m.SenderID,
+		SenderID:            m.SenderID,
+		SessionNonce:        m.SessionNonce,
+		ConfirmedNonces:     m.ConfirmedNonces,
+		KeyEpochFingerprint: m.KeyEpochFingerprint,
+		ProtocolVersion:     m.ProtocolVersion,
 	}).Marshal()
 }
 
@@ -250,6 +259,10 @@ func (m *ReadyMessage) Unmarshal(bytes []byte) error {
 	}
 
 	m.SenderID = pbMsg.SenderID
+	m.SessionNonce = pbMsg.SessionNonce
+	m.ConfirmedNonces = pbMsg.ConfirmedNonces
+	m.KeyEpochFingerprint = pbMsg.KeyEpochFingerprint
+	m.ProtocolVersion = pbMsg.ProtocolVersion
 
 	return nil
 }
@@ -33,7 +33,9 @@ func TestSignerMarshalling(t *testing.T) {
 			groupMemberIDs:     groupMembersIDs,
 			dishonestThreshold: dishonestThreshold,
 		},
-		thresholdKey: ThresholdKey(testData[signerIndex]),
+		thresholdKey:    ThresholdKey(testData[signerIndex]),
+		keyEpoch:        2,
+		protocolVersion: currentProtocolVersion,
 	}
 
 	unmarshaled := &ThresholdSigner{}
@@ -111,7 +113,13 @@ func TestFuzzTSSProtocolMessageUnmarshaler(t *testing.T) {
 
 func TestReadyMessageMarshalling(t *testing.T) {
 	msg := &ReadyMessage{
-		SenderID: MemberID([]byte("member-1")),
+		SenderID:     MemberID([]byte("member-1")),
+		SessionNonce: []byte("nonce-1"),
+		ConfirmedNonces: map[string][]byte{
+			MemberID([]byte("member-1")).String(): []byte("nonce-1"),
+			MemberID([]byte("member-2")).String(): []byte("nonce-2"),
+		},
+		ProtocolVersion: currentProtocolVersion,
 	}
 
 	unmarshaled := &ReadyMessage{}
@@ -19,9 +19,23 @@ func (m *TSSProtocolMessage) Type() string {
 }
 
 // ReadyMessage is a network message used to notify peer members about readiness
-// to start protocol execution.
+// to start protocol execution. Session nonce is a random value generated by
+// the sender for each protocol attempt. Nonces of members selected to
+// participate in the protocol determine the session identifier. Confirmed
+// nonces are the most recent session nonces the sender received from group
+// members, including its own one, by member ID string. A member accepts
+// the sender's nonce only if the sender confirmed the member's current nonce.
+// Members running the legacy client version send the message without a nonce.
+// Key epoch fingerprint identifies key shares the sender is going to use in
+// the protocol. It is empty if the protocol does not use existing key shares.
+// Protocol version is the version of the protocol run by the sender, it is
+// zero for members running the legacy client version.
 type ReadyMessage struct {
-	SenderID MemberID
+	SenderID            MemberID
+	SessionNonce        []byte
+	ConfirmedNonces     map[string][]byte
+	KeyEpochFingerprint []byte
+	ProtocolVersion     uint32
 }
 
 // Type returns a string type of the `ReadyMessage`.
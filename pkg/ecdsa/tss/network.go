@@ -4,9 +4,11 @@ import (
 	"context"
 	cecdsa "crypto/ecdsa"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
+	"github.com/binance-chain/tss-lib/ecdsa/resharing"
 	"github.com/binance-chain/tss-lib/tss"
 	"github.com/keep-network/keep-core/pkg/net"
 )
@@ -23,16 +25,44 @@ type networkBridge struct {
 
 	groupInfo *groupInfo
 
-	channelsMutex    *sync.Mutex
-	broadcastChannel net.BroadcastChannel
-	unicastChannels  map[net.TransportIdentifier]net.UnicastChannel
+	channelsMutex       *sync.Mutex
+	channelsInitialized bool
+	broadcastChannel    net.BroadcastChannel
+	unicastChannels     map[net.TransportIdentifier]net.UnicastChannel
 
 	tssMessageHandlersMutex *sync.Mutex
 	tssMessageHandlers      []tssMessageHandler
+	// Protocol messages received before any handler has been registered or
+	// before the session has been established, by sender member ID. They are
+	// passed to handlers once both happen. At most
+	// `maxPendingTSSMessagesPerSender` most recent messages are kept for each
+	// group member.
+	pendingTSSMessages map[string][]*TSSProtocolMessage
+
+	// Identifier of the protocol session agreed by members in the readiness
+	// signalling protocol. Protocol messages of other sessions or received
+	// after the session completed are dropped. The identifier is empty if
+	// the protocol is executed with members running the legacy client version
+	// which does not support sessions. Guarded by the mutex of protocol
+	// message handlers.
+	sessionID          string
+	sessionEstablished bool
+	sessionCompleted   bool
+	droppedMessages    int
+
+	// Members blamed by tss-lib for sending invalid protocol messages.
+	culpritsMutex *sync.Mutex
+	culprits      map[string]*Culprit
 }
 
 type tssMessageHandler func(netMsg *TSSProtocolMessage) error
 
+// maxPendingTSSMessagesPerSender is the maximum number of protocol messages
+// from a single sender kept until the session is established. It is well above
+// the number of messages a member sends to another member in all rounds of any
+// protocol so that honest members' messages are never dropped.
+const maxPendingTSSMessagesPerSender = 32
+
 // newNetworkBridge initializes a new network bridge for the given network provider.
 func newNetworkBridge(
 	groupInfo *groupInfo,
@@ -47,6 +77,10 @@ func newNetworkBridge(
 
 		tssMessageHandlersMutex: &sync.Mutex{},
 		tssMessageHandlers:      []tssMessageHandler{},
+		pendingTSSMessages:      make(map[string][]*TSSProtocolMessage),
+
+		culpritsMutex: &sync.Mutex{},
+		culprits:      make(map[string]*Culprit),
 	}
 
 	return networkBridge, nil
@@ -58,34 +92,81 @@ func (b *networkBridge) connect(
 	party tss.Party,
 	sortedPartyIDs tss.SortedPartyIDs,
 ) error {
-	netInChan := make(chan *TSSProtocolMessage, len(b.groupInfo.groupMemberIDs))
+	if err := b.initializeChannels(ctx); err != nil {
+		return fmt.Errorf("failed to initialize channels: [%v]", err)
+	}
 
-	if err := b.initializeChannels(ctx, netInChan); err != nil {
+	b.forwardOutgoingMessages(ctx, tssOutChan)
+	b.registerProtocolMessageHandler(party, sortedPartyIDs)
+
+	return nil
+}
+
+// connectResharing connects the bridge with both parties of the current member
+// executing the key resharing protocol; the party of the old committee holding
+// the current key share and the party of the new committee receiving the new
+// key share. Both parties write their outgoing messages to the same channel.
+func (b *networkBridge) connectResharing(
+	ctx context.Context,
+	tssOutChan <-chan tss.Message,
+	oldParty tss.Party,
+	newParty tss.Party,
+	partyIDs tss.SortedPartyIDs,
+) error {
+	if err := b.initializeChannels(ctx); err != nil {
 		return fmt.Errorf("failed to initialize channels: [%v]", err)
 	}
 
+	b.forwardOutgoingMessages(ctx, tssOutChan)
+	b.registerResharingMessageHandler(oldParty, newParty, partyIDs)
+
+	return nil
+}
+
+func (b *networkBridge) forwardOutgoingMessages(
+	ctx context.Context,
+	tssOutChan <-chan tss.Message,
+) {
 	go func() {
 		for {
 			select {
 			case tssLibMsg := <-tssOutChan:
 				go b.sendTSSMessage(ctx, tssLibMsg)
-			case msg := <-netInChan:
-				go b.handleTSSProtocolMessage(msg)
 			case <-ctx.Done():
 				return
 			}
 		}
 	}()
+}
 
-	b.registerProtocolMessageHandler(party, sortedPartyIDs)
+// initializeChannels starts receiving protocol messages from broadcast and
+// unicast channels of all group members. Channels are initialized only once,
+// subsequent calls have no effect. Messages received before a protocol message
+// handler is registered are kept and passed to the handler once it gets
+// registered.
+func (b *networkBridge) initializeChannels(ctx context.Context) error {
+	b.channelsMutex.Lock()
+	initialized := b.channelsInitialized
+	b.channelsInitialized = true
+	b.channelsMutex.Unlock()
 
-	return nil
-}
+	if initialized {
+		return nil
+	}
+
+	netInChan := make(chan *TSSProtocolMessage, len(b.groupInfo.groupMemberIDs))
+
+	go func() {
+		for {
+			select {
+			case msg := <-netInChan:
+				go b.handleTSSProtocolMessage(msg)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
 
-func (b *networkBridge) initializeChannels(
-	ctx context.Context,
-	netInChan chan *TSSProtocolMessage,
-) error {
 	handleFn := func(msg net.Message) {
 		switch protocolMessage := msg.Payload().(type) {
 		case *TSSProtocolMessage:
@@ -101,6 +182,24 @@ func (b *networkBridge) initializeChannels(
 
 	broadcastChannel.Recv(ctx, handleFn)
 
+	// Unicast channel with a peer is shared by all bridges communicating with
+	// that peer, for example by signing of different digests executed
+	// concurrently. Messages of sessions of other groups are ignored, so they
+	// do not occupy the space of messages pending for the session of this
+	// group. Messages without a session are sent by members running the legacy
+	// client version and are accepted.
+	handleUnicastFn := func(msg net.Message) {
+		switch protocolMessage := msg.Payload().(type) {
+		case *TSSProtocolMessage:
+			if protocolMessage.SessionID == "" || isSessionOfGroup(
+				protocolMessage.SessionID,
+				b.groupInfo.groupID,
+			) {
+				netInChan <- protocolMessage
+			}
+		}
+	}
+
 	// Initialize unicast channels.
 	for _, peerMemberID := range b.groupInfo.groupMemberIDs {
 		if peerMemberID.Equal(b.groupInfo.memberID) {
@@ -121,7 +220,7 @@ func (b *networkBridge) initializeChannels(
 			return fmt.Errorf("failed to get unicast channel: [%v]", err)
 		}
 
-		unicastChannel.Recv(ctx, handleFn)
+		unicastChannel.Recv(ctx, handleUnicastFn)
 	}
 
 	return nil
@@ -259,7 +358,7 @@ func (b *networkBridge) sendTSSMessage(
 		SenderID:    routing.From.GetKey(),
 		Payload:     bytes,
 		IsBroadcast: routing.IsBroadcast,
-		SessionID:   b.groupInfo.groupID,
+		SessionID:   b.currentSessionID(),
 	}
 
 	if routing.To == nil {
@@ -268,13 +367,30 @@ func (b *networkBridge) sendTSSMessage(
 			logger.Errorf("could not broadcast message: [%v]", err)
 		}
 	} else {
+		// Parties of the same member share the identifier, so when the message
+		// is addressed to more than one party of the member, it is sent only
+		// once.
+		sentTo := make(map[string]bool, len(routing.To))
+
 		for _, destination := range routing.To {
+			if sentTo[destination.GetId()] {
+				continue
+			}
+			sentTo[destination.GetId()] = true
+
 			destinationMemberID, err := MemberIDFromString(destination.GetId())
 			if err != nil {
 				logger.Errorf("failed to get destination member id: [%v]", err)
 				return
 			}
 
+			// Message addressed to another party of the current member is
+			// delivered locally.
+			if destinationMemberID.Equal(b.groupInfo.memberID) {
+				go b.handleTSSProtocolMessage(protocolMessage)
+				continue
+			}
+
 			destinationTransportID, err := b.getTransportIdentifier(destinationMemberID)
 			if err != nil {
 				logger.Errorf("failed to get transport identifier: [%v]", err)
@@ -342,12 +458,13 @@ func (b *networkBridge) registerProtocolMessageHandler(
 	sortedPartyIDs tss.SortedPartyIDs,
 ) {
 	handler := func(protocolMessage *TSSProtocolMessage) error {
-		if protocolMessage.SessionID != b.groupInfo.groupID {
+		senderPartyID := sortedPartyIDs.FindByKey(protocolMessage.SenderID.bigInt())
+
+		// Sender does not participate in this protocol execution.
+		if senderPartyID == nil {
 			return nil
 		}
 
-		senderPartyID := sortedPartyIDs.FindByKey(protocolMessage.SenderID.bigInt())
-
 		if senderPartyID == party.PartyID() {
 			return nil
 		}
@@ -358,22 +475,297 @@ func (b *networkBridge) registerProtocolMessageHandler(
 			protocolMessage.IsBroadcast,
 		)
 		if err != nil {
+			b.recordCulprits(err)
 			return fmt.Errorf("failed to update party: [%v]", party.WrapError(err))
 		}
 
 		return nil
 	}
 
+	b.addTSSMessageHandler(handler)
+}
+
+// registerResharingMessageHandler registers a handler passing protocol
+// messages to the party of the old or the new committee, depending on the
+// committee the message is addressed to.
+func (b *networkBridge) registerResharingMessageHandler(
+	oldParty tss.Party,
+	newParty tss.Party,
+	partyIDs tss.SortedPartyIDs,
+) {
+	handler := func(protocolMessage *TSSProtocolMessage) error {
+		senderPartyID := partyIDs.FindByKey(protocolMessage.SenderID.bigInt())
+
+		// Sender does not participate in this protocol execution.
+		if senderPartyID == nil {
+			return nil
+		}
+
+		parsedMessage, err := tss.ParseWireMessage(
+			protocolMessage.Payload,
+			senderPartyID,
+			protocolMessage.IsBroadcast,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to parse protocol message: [%v]", err)
+		}
+
+		// Committee flags of the message routing are not transmitted in the
+		// wire bytes, the recipient committee is determined by the message
+		// type instead.
+		recipients := []tss.Party{}
+		switch parsedMessage.Content().(type) {
+		case *resharing.DGRound2Message2:
+			recipients = append(recipients, oldParty)
+		case *resharing.DGRound4Message:
+			recipients = append(recipients, oldParty, newParty)
+		default:
+			recipients = append(recipients, newParty)
+		}
+
+		for _, party := range recipients {
+			// Member who does not hold a share of the key yet has no party
+			// in the old committee.
+			if party == nil || senderPartyID == party.PartyID() {
+				continue
+			}
+
+			if _, err := party.Update(parsedMessage); err != nil {
+				return fmt.Errorf(
+					"failed to update party: [%v]",
+					party.WrapError(err),
+				)
+			}
+		}
+
+		return nil
+	}
+
+	b.addTSSMessageHandler(handler)
+}
+
+// recordCulprits records members blamed by tss-lib in the error returned from
+// the party update, together with the evidence of their misbehaviour.
+// The current member is never recorded as a culprit.
+func (b *networkBridge) recordCulprits(tssErr *tss.Error) {
+	b.culpritsMutex.Lock()
+	defer b.culpritsMutex.Unlock()
+
+	evidence := fmt.Sprintf(
+		"task [%s], round [%d]: [%v]",
+		tssErr.Task(),
+		tssErr.Round(),
+		tssErr.Cause(),
+	)
+
+	for _, culprit := range tssErr.Culprits() {
+		if culprit == nil {
+			continue
+		}
+
+		memberID, err := MemberIDFromString(culprit.GetId())
+		if err != nil {
+			logger.Errorf(
+				"cannot get member id from string [%v]: [%v]",
+				culprit.GetId(),
+				err,
+			)
+			continue
+		}
+
+		if memberID.Equal(b.groupInfo.memberID) {
+			continue
+		}
+
+		logger.Warningf(
+			"member [%s] of group [%s] blamed for invalid protocol message; %s",
+			memberID,
+			b.groupInfo.groupID,
+			evidence,
+		)
+
+		culprit, ok := b.culprits[memberID.String()]
+		if !ok {
+			culprit = &Culprit{MemberID: memberID}
+			b.culprits[memberID.String()] = culprit
+		}
+
+		culprit.Evidence = append(culprit.Evidence, evidence)
+	}
+}
+
+// withCulprits returns an error carrying members blamed by tss-lib for sending
+// invalid protocol messages, if any were blamed. Otherwise, it returns
+// the provided error.
+func (b *networkBridge) withCulprits(err error) error {
+	b.culpritsMutex.Lock()
+	defer b.culpritsMutex.Unlock()
+
+	if len(b.culprits) == 0 {
+		return err
+	}
+
+	culprits := make([]*Culprit, 0, len(b.culprits))
+	for _, culprit := range b.culprits {
+		culprits = append(culprits, &Culprit{
+			MemberID: culprit.MemberID,
+			Evidence: append([]string{}, culprit.Evidence...),
+		})
+	}
+
+	sort.Slice(culprits, func(i, j int) bool {
+		return culprits[i].MemberID.bigInt().Cmp(
+			culprits[j].MemberID.bigInt(),
+		) < 0
+	})
+
+	return culpritsError{err: err, culprits: culprits}
+}
+
+func (b *networkBridge) addTSSMessageHandler(handler tssMessageHandler) {
 	b.tssMessageHandlersMutex.Lock()
 	defer b.tssMessageHandlersMutex.Unlock()
 
 	b.tssMessageHandlers = append(b.tssMessageHandlers, handler)
+
+	b.handlePendingTSSMessages()
+}
+
+// establishSession sets the identifier of the protocol session agreed by
+// members in the readiness signalling protocol. Only protocol messages of
+// this session are passed to handlers from now on. The empty identifier
+// establishes the session with members running the legacy client version.
+func (b *networkBridge) establishSession(sessionID string) {
+	b.tssMessageHandlersMutex.Lock()
+	defer b.tssMessageHandlersMutex.Unlock()
+
+	b.sessionID = sessionID
+	b.sessionEstablished = true
+
+	b.handlePendingTSSMessages()
+}
+
+// completeSession marks the protocol session as completed, so all protocol
+// messages received later are dropped, and reports the number of messages
+// dropped during the session.
+func (b *networkBridge) completeSession() {
+	b.tssMessageHandlersMutex.Lock()
+	defer b.tssMessageHandlersMutex.Unlock()
+
+	if !b.sessionEstablished || b.sessionCompleted {
+		return
+	}
+
+	b.sessionCompleted = true
+
+	if b.droppedMessages > 0 {
+		logger.Infof(
+			"dropped [%d] protocol messages not belonging to session [%s]",
+			b.droppedMessages,
+			b.sessionID,
+		)
+	}
+}
+
+func (b *networkBridge) currentSessionID() string {
+	b.tssMessageHandlersMutex.Lock()
+	defer b.tssMessageHandlersMutex.Unlock()
+
+	return b.sessionID
+}
+
+// handlePendingTSSMessages passes messages received before the session has
+// been established and a handler has been registered to handlers. It has to
+// be called with the mutex of protocol message handlers locked.
+func (b *networkBridge) handlePendingTSSMessages() {
+	if !b.sessionEstablished || len(b.tssMessageHandlers) == 0 {
+		return
+	}
+
+	pendingMessages := b.pendingTSSMessages
+	b.pendingTSSMessages = make(map[string][]*TSSProtocolMessage)
+
+	for _, senderMessages := range pendingMessages {
+		for _, protocolMessage := range senderMessages {
+			b.dispatchTSSProtocolMessage(protocolMessage)
+		}
+	}
 }
 
 func (b *networkBridge) handleTSSProtocolMessage(protocolMessage *TSSProtocolMessage) {
 	b.tssMessageHandlersMutex.Lock()
 	defer b.tssMessageHandlersMutex.Unlock()
 
+	if !b.sessionEstablished || len(b.tssMessageHandlers) == 0 {
+		b.addPendingTSSMessage(protocolMessage)
+		return
+	}
+
+	b.dispatchTSSProtocolMessage(protocolMessage)
+}
+
+// addPendingTSSMessage keeps the message until the session is established.
+// Messages of senders who are not members of the group are dropped. If the
+// sender exceeded the limit of pending messages, their oldest message is
+// dropped, as it most likely belongs to a previous session. It has to be
+// called with the mutex of protocol message handlers locked.
+func (b *networkBridge) addPendingTSSMessage(
+	protocolMessage *TSSProtocolMessage,
+) {
+	isGroupMember := false
+	for _, memberID := range b.groupInfo.groupMemberIDs {
+		if protocolMessage.SenderID.Equal(memberID) {
+			isGroupMember = true
+			break
+		}
+	}
+	if !isGroupMember {
+		b.droppedMessages++
+		logger.Debugf(
+			"dropped protocol message from [%s] not being a member of group [%s]",
+			protocolMessage.SenderID,
+			b.groupInfo.groupID,
+		)
+		return
+	}
+
+	sender := protocolMessage.SenderID.String()
+
+	senderMessages := append(b.pendingTSSMessages[sender], protocolMessage)
+	if len(senderMessages) > maxPendingTSSMessagesPerSender {
+		b.droppedMessages++
+		logger.Debugf(
+			"dropped pending protocol message of session [%s] from member [%s]; "+
+				"member exceeded the limit of [%d] pending messages",
+			senderMessages[0].SessionID,
+			sender,
+			maxPendingTSSMessagesPerSender,
+		)
+		senderMessages = senderMessages[1:]
+	}
+
+	b.pendingTSSMessages[sender] = senderMessages
+}
+
+// dispatchTSSProtocolMessage passes the message to all handlers if it belongs
+// to the current session which has not completed yet. Otherwise, the message
+// is dropped. It has to be called with the mutex of protocol message handlers
+// locked.
+func (b *networkBridge) dispatchTSSProtocolMessage(
+	protocolMessage *TSSProtocolMessage,
+) {
+	if b.sessionCompleted || protocolMessage.SessionID != b.sessionID {
+		b.droppedMessages++
+		logger.Debugf(
+			"dropped protocol message of session [%s] from member [%s]; "+
+				"current session is [%s]",
+			protocolMessage.SessionID,
+			protocolMessage.SenderID,
+			b.sessionID,
+		)
+		return
+	}
+
 	for _, handler := range b.tssMessageHandlers {
 		if err := handler(protocolMessage); err != nil {
 			logger.Errorf("failed to handle protocol message: [%v]", err)
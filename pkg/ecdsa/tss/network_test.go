@@ -0,0 +1,279 @@
+package tss
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func TestNetworkBridgeCulprits(t *testing.T) {
+	groupMembers, err := generateMemberKeys(3)
+	if err != nil {
+		t.Fatalf("failed to generate members keys: [%v]", err)
+	}
+
+	bridge, err := newNetworkBridge(
+		&groupInfo{
+			groupID:        "test-group-1",
+			memberID:       groupMembers[0],
+			groupMemberIDs: groupMembers,
+		},
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	protocolErr := fmt.Errorf("protocol failed")
+
+	if err := bridge.withCulprits(protocolErr); err != protocolErr {
+		t.Errorf("unexpected error without culprits: [%v]", err)
+	}
+	if culprits := Culprits(protocolErr); culprits != nil {
+		t.Errorf("unexpected culprits: [%v]", culprits)
+	}
+
+	_, partyIDs, err := generatePartiesIDs(groupMembers[0], groupMembers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The current member is never blamed and the member blamed twice is
+	// reported once with evidence of both invalid messages.
+	bridge.recordCulprits(tss.NewError(
+		fmt.Errorf("invalid share"),
+		"keygen",
+		2,
+		partyIDs[0],
+		partyIDs[0],
+		partyIDs[2],
+	))
+	bridge.recordCulprits(tss.NewError(
+		fmt.Errorf("invalid commitment"),
+		"keygen",
+		3,
+		partyIDs[0],
+		partyIDs[2],
+	))
+
+	expectedCulprits := []*Culprit{
+		{
+			MemberID: groupMembers[2],
+			Evidence: []string{
+				"task [keygen], round [2]: [invalid share]",
+				"task [keygen], round [3]: [invalid commitment]",
+			},
+		},
+	}
+	if culprits := Culprits(bridge.withCulprits(protocolErr)); !reflect.DeepEqual(
+		expectedCulprits,
+		culprits,
+	) {
+		t.Errorf(
+			"unexpected culprits\nexpected: [%+v]\nactual:   [%+v]",
+			expectedCulprits,
+			culprits,
+		)
+	}
+}
+
+func TestNetworkBridgeSession(t *testing.T) {
+	groupMembers, err := generateMemberKeys(2)
+	if err != nil {
+		t.Fatalf("failed to generate members keys: [%v]", err)
+	}
+
+	bridge, err := newNetworkBridge(
+		&groupInfo{
+			groupID:        "test-group-1",
+			memberID:       groupMembers[0],
+			groupMemberIDs: groupMembers,
+		},
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var handledMessages []*TSSProtocolMessage
+	bridge.addTSSMessageHandler(func(msg *TSSProtocolMessage) error {
+		handledMessages = append(handledMessages, msg)
+		return nil
+	})
+
+	previousSessionMessage := &TSSProtocolMessage{
+		SenderID:  groupMembers[1],
+		SessionID: "session-1",
+	}
+	currentSessionMessage := &TSSProtocolMessage{
+		SenderID:  groupMembers[1],
+		SessionID: "session-2",
+	}
+
+	// Messages received before the session has been established are kept
+	// until the session is known.
+	bridge.handleTSSProtocolMessage(previousSessionMessage)
+	bridge.handleTSSProtocolMessage(currentSessionMessage)
+	if len(handledMessages) != 0 {
+		t.Fatalf("messages handled before the session has been established")
+	}
+
+	bridge.establishSession("session-2")
+
+	bridge.handleTSSProtocolMessage(previousSessionMessage)
+	bridge.handleTSSProtocolMessage(currentSessionMessage)
+
+	bridge.completeSession()
+
+	// Messages received after the session completed are dropped.
+	bridge.handleTSSProtocolMessage(currentSessionMessage)
+
+	expectedMessages := []*TSSProtocolMessage{
+		currentSessionMessage,
+		currentSessionMessage,
+	}
+	if !reflect.DeepEqual(expectedMessages, handledMessages) {
+		t.Errorf(
+			"unexpected handled messages\nexpected: [%v]\nactual:   [%v]",
+			expectedMessages,
+			handledMessages,
+		)
+	}
+
+	expectedDroppedMessages := 3
+	if bridge.droppedMessages != expectedDroppedMessages {
+		t.Errorf(
+			"unexpected number of dropped messages\n"+
+				"expected: [%d]\nactual:   [%d]",
+			expectedDroppedMessages,
+			bridge.droppedMessages,
+		)
+	}
+}
+
+func TestNetworkBridgeLegacySession(t *testing.T) {
+	groupMembers, err := generateMemberKeys(2)
+	if err != nil {
+		t.Fatalf("failed to generate members keys: [%v]", err)
+	}
+
+	bridge, err := newNetworkBridge(
+		&groupInfo{
+			groupID:        "test-group-1",
+			memberID:       groupMembers[0],
+			groupMemberIDs: groupMembers,
+		},
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var handledMessages []*TSSProtocolMessage
+	bridge.addTSSMessageHandler(func(msg *TSSProtocolMessage) error {
+		handledMessages = append(handledMessages, msg)
+		return nil
+	})
+
+	// Members running the legacy client version send messages without
+	// a session.
+	legacyMessage := &TSSProtocolMessage{SenderID: groupMembers[1]}
+
+	bridge.handleTSSProtocolMessage(legacyMessage)
+	if len(handledMessages) != 0 {
+		t.Fatalf("message handled before the session has been established")
+	}
+
+	bridge.establishSession("")
+
+	bridge.handleTSSProtocolMessage(legacyMessage)
+	bridge.handleTSSProtocolMessage(&TSSProtocolMessage{
+		SenderID:  groupMembers[1],
+		SessionID: "session-1",
+	})
+
+	expectedMessages := []*TSSProtocolMessage{legacyMessage, legacyMessage}
+	if !reflect.DeepEqual(expectedMessages, handledMessages) {
+		t.Errorf(
+			"unexpected handled messages\nexpected: [%v]\nactual:   [%v]",
+			expectedMessages,
+			handledMessages,
+		)
+	}
+}
+
+func TestNetworkBridgePendingMessagesLimit(t *testing.T) {
+	groupMembers, err := generateMemberKeys(2)
+	if err != nil {
+		t.Fatalf("failed to generate members keys: [%v]", err)
+	}
+
+	outsiders, err := generateMemberKeys(1)
+	if err != nil {
+		t.Fatalf("failed to generate outsider keys: [%v]", err)
+	}
+
+	bridge, err := newNetworkBridge(
+		&groupInfo{
+			groupID:        "test-group-1",
+			memberID:       groupMembers[0],
+			groupMemberIDs: groupMembers,
+		},
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var handledMessages []*TSSProtocolMessage
+	bridge.addTSSMessageHandler(func(msg *TSSProtocolMessage) error {
+		handledMessages = append(handledMessages, msg)
+		return nil
+	})
+
+	// Messages of a sender not being a group member are not kept.
+	bridge.handleTSSProtocolMessage(&TSSProtocolMessage{
+		SenderID:  outsiders[0],
+		SessionID: "session-1",
+	})
+
+	staleMessage := &TSSProtocolMessage{
+		SenderID:  groupMembers[1],
+		SessionID: "session-0",
+	}
+	bridge.handleTSSProtocolMessage(staleMessage)
+	for i := 0; i < maxPendingTSSMessagesPerSender; i++ {
+		bridge.handleTSSProtocolMessage(&TSSProtocolMessage{
+			SenderID:  groupMembers[1],
+			SessionID: "session-1",
+		})
+	}
+
+	expectedDroppedMessages := 2
+	if bridge.droppedMessages != expectedDroppedMessages {
+		t.Errorf(
+			"unexpected number of dropped messages\n"+
+				"expected: [%d]\nactual:   [%d]",
+			expectedDroppedMessages,
+			bridge.droppedMessages,
+		)
+	}
+
+	bridge.establishSession("session-1")
+
+	if len(handledMessages) != maxPendingTSSMessagesPerSender {
+		t.Errorf(
+			"unexpected number of handled messages\n"+
+				"expected: [%d]\nactual:   [%d]",
+			maxPendingTSSMessagesPerSender,
+			len(handledMessages),
+		)
+	}
+	for _, message := range handledMessages {
+		if message == staleMessage {
+			t.Errorf("the oldest message exceeding the limit has been handled")
+		}
+	}
+}
@@ -21,7 +21,8 @@ import (
 // around and consume its content for any calculations needed between retried
 // key-generation attempts.
 type Box struct {
-	params *keygen.LocalPreParams
+	params    *keygen.LocalPreParams
+	onDestroy func()
 }
 
 // NewBox creates a new PreParamsBox with the provided key generation pre-params
@@ -32,6 +33,20 @@ func NewBox(params *keygen.LocalPreParams) *Box {
 	}
 }
 
+// NewBoxWithDestroyHandler creates a new PreParamsBox with the provided key
+// generation pre-params inside. The handler is called once, when the box
+// content is destroyed. It lets to remove all other copies of pre-parameters,
+// like the persisted ones, so they are never reused.
+func NewBoxWithDestroyHandler(
+	params *keygen.LocalPreParams,
+	handler func(),
+) *Box {
+	return &Box{
+		params:    params,
+		onDestroy: handler,
+	}
+}
+
 // Content gets the box content or error if the content has been previously
 // destroyed.
 func (b *Box) Content() (*keygen.LocalPreParams, error) {
@@ -49,7 +64,12 @@ func (b *Box) IsEmpty() bool {
 }
 
 // DestroyContent destroys the box content so that all further calls to
-// Content() function will fail.
+// Content() function will fail. If the box has a destroy handler, it is
+// called when the content is destroyed for the first time.
 func (b *Box) DestroyContent() {
+	if b.params != nil && b.onDestroy != nil {
+		b.onDestroy()
+	}
+
 	b.params = nil
 }
@@ -67,3 +67,30 @@ func TestIsEmpty(t *testing.T) {
 		t.Fatal("box should be empty")
 	}
 }
+
+func TestDestroyHandler(t *testing.T) {
+	params := &keygen.LocalPreParams{
+		P: big.NewInt(1),
+		Q: big.NewInt(2),
+	}
+
+	handlerCalls := 0
+	box := NewBoxWithDestroyHandler(params, func() {
+		handlerCalls++
+	})
+
+	if handlerCalls != 0 {
+		t.Fatalf("handler should not be called before the content is destroyed")
+	}
+
+	box.DestroyContent()
+	box.DestroyContent()
+
+	if handlerCalls != 1 {
+		t.Fatalf(
+			"unexpected number of handler calls\nexpected: [%v]\nactual:   [%v]",
+			1,
+			handlerCalls,
+		)
+	}
+}
@@ -111,6 +111,7 @@ func AnnounceProtocol(
 
 	switch ctx.Err() {
 	case context.DeadlineExceeded:
+		missingMembers := []string{}
 		for _, member := range keepMemberAddresses {
 			if !hasAnnounced(member) {
 				logger.Errorf(
@@ -120,12 +121,13 @@ func AnnounceProtocol(
 					member,
 					keepAddress,
 				)
+				missingMembers = append(missingMembers, member)
 			}
 		}
-		return nil, fmt.Errorf(
-			"waiting for announcements timed out after: [%v]",
-			protocolAnnounceTimeout,
-		)
+		return nil, announceTimeoutError{
+			timeout:        protocolAnnounceTimeout,
+			missingMembers: missingMembers,
+		}
 	case context.Canceled:
 		logger.Infof("announce protocol completed successfully")
 
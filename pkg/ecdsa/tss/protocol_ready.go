@@ -1,10 +1,16 @@
 package tss
 
 import (
+	"bytes"
 	"context"
 	cecdsa "crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/keep-network/keep-core/pkg/net"
@@ -15,20 +21,93 @@ import (
 // execution. If the time limit is reached the ready protocol stage fails.
 const protocolReadyTimeout = 2 * time.Minute
 
+// protocolReadyGracePeriod defines a period for which the member waits for
+// the rest of peer members once enough members to execute the protocol
+// are ready.
+const protocolReadyGracePeriod = 10 * time.Second
+
+// sessionNonceLength is the length of a random nonce generated by each member
+// for every protocol attempt.
+const sessionNonceLength = 32
+
+// readySession describes the protocol session agreed by members in
+// the readiness signalling protocol.
+type readySession struct {
+	// Identifiers of members selected to participate in the protocol.
+	memberIDs []MemberID
+	// Identifier of the session, empty if the protocol is executed with
+	// members running the legacy client version.
+	sessionID string
+	// The lowest protocol version announced by the selected members.
+	protocolVersion uint32
+}
+
 // readyProtocol exchanges messages with peer members about readiness to start
 // the protocol execution. The member keeps sending the message in intervals
 // until they receive messages from all peer members. Function exits without an
-// error if messages were received from all peer members. If the timeout is
-// reached before receiving messages from all peer members the function returns
-// an error.
+// error as soon as messages of all peer members were received and confirmed
+// messages of each other.
+//
+// If at least `requiredReadyCount` members (including the current one)
+// signalled their readiness and confirmed messages of each other, the member
+// waits for the rest of peer members only for the grace period and then
+// the protocol succeeds. Otherwise, if the timeout is reached, the function
+// returns an error.
+//
+// Each member attaches a random nonce to its readiness message together with
+// the nonces it received from other members. A member is considered ready
+// only once its message confirms the current nonce of this member, so
+// a delayed message from a previous attempt can not replace the nonce of
+// the current attempt.
+//
+// As a result, the function returns identifiers of exactly `requiredReadyCount`
+// ready members selected to participate in the protocol execution. Members are
+// selected deterministically, in ascending order of their identifiers, among
+// members who confirmed nonces of each other, so that all members who observed
+// the same confirmations select the same participants. The function returns
+// also the identifier of the protocol session derived from nonces of
+// the selected members. The identifier is unique for each protocol attempt
+// and is the same for all selected members. Each member announces also
+// the protocol version it runs and the function returns the lowest version
+// announced by the selected members.
+//
+// If the protocol uses existing key shares, the member attaches the fingerprint
+// of its key epoch to the message. Members holding key shares of another
+// epoch, for example because they have not completed the key refresh, are
+// never considered ready. If the function times out, fingerprints of their
+// epochs are returned in the error, so the member can recover key shares of
+// the epoch used by other members.
+//
+// Members running a client version without session nonces send readiness
+// messages without a nonce and consider a member ready on any message from it.
+// They execute the protocol with all group members and without a session. If
+// any peer member sent a message without a nonce, the function falls back to
+// the same rules; it waits for all group members regardless of
+// `requiredReadyCount`, selects all of them and returns an empty session
+// identifier and the legacy protocol version.
 func readyProtocol(
 	parentCtx context.Context,
 	group *groupInfo,
 	broadcastChannel net.BroadcastChannel,
 	publicKeyToAddressFn func(cecdsa.PublicKey) []byte,
-) error {
+	requiredReadyCount int,
+	keyEpochFingerprint []byte,
+) (*readySession, error) {
 	logger.Infof("signalling readiness")
 
+	if requiredReadyCount < 1 || requiredReadyCount > len(group.groupMemberIDs) {
+		return nil, fmt.Errorf(
+			"required ready members count [%d] must be between 1 and group size [%d]",
+			requiredReadyCount,
+			len(group.groupMemberIDs),
+		)
+	}
+
+	sessionNonce := make([]byte, sessionNonceLength)
+	if _, err := rand.Read(sessionNonce); err != nil {
+		return nil, fmt.Errorf("failed to generate session nonce: [%v]", err)
+	}
+
 	ctx, cancel := context.WithTimeout(parentCtx, protocolReadyTimeout)
 	defer cancel()
 
@@ -41,12 +120,17 @@ func readyProtocol(
 	}
 	broadcastChannel.Recv(ctx, handleReadyMessage)
 
-	readyMembers := make(map[string]bool) // member address -> ready?
+	state := newReadyState(group, sessionNonce, keyEpochFingerprint)
+
 	go func() {
+		var gracePeriodTimer *time.Timer
 
 		for {
 			select {
 			case <-ctx.Done():
+				if gracePeriodTimer != nil {
+					gracePeriodTimer.Stop()
+				}
 				return
 			case msg := <-readyInChan:
 				for _, memberID := range group.groupMemberIDs {
@@ -64,20 +148,54 @@ func readyProtocol(
 							)
 							break
 						}
-						readyMembers[memberAddress] = true
 
-						logger.Infof(
-							"member [%s] from keep [%s] announced its readiness",
-							memberAddress,
-							group.groupID,
-						)
+						if state.accept(memberAddress, msg) {
+							logger.Infof(
+								"member [%s] from keep [%s] announced its readiness",
+								memberAddress,
+								group.groupID,
+							)
+						}
 
 						break
 					}
 				}
 
-				if len(readyMembers) == len(group.groupMemberIDs) {
+				// Members running the legacy client version execute
+				// the protocol only with all group members, so there is no
+				// point in waiting for the grace period.
+				if isLegacy, readyCount := state.legacyReadiness(); isLegacy {
+					if gracePeriodTimer != nil {
+						gracePeriodTimer.Stop()
+					}
+					if readyCount == len(group.groupMemberIDs) {
+						cancel()
+					}
+					continue
+				}
+
+				// Members finish once all of them confirmed nonces of each
+				// other so that they select participants based on the same
+				// confirmations.
+				confirmedCount := len(state.selectMembers())
+				if confirmedCount == len(group.groupMemberIDs) {
 					cancel()
+					continue
+				}
+
+				if gracePeriodTimer == nil &&
+					confirmedCount >= requiredReadyCount {
+					logger.Infof(
+						"required [%d] members of keep [%s] are ready; "+
+							"waiting [%v] for the rest of members",
+						requiredReadyCount,
+						group.groupID,
+						protocolReadyGracePeriod,
+					)
+					gracePeriodTimer = time.AfterFunc(
+						protocolReadyGracePeriod,
+						cancel,
+					)
 				}
 			}
 		}
@@ -86,7 +204,13 @@ func readyProtocol(
 	go func() {
 		sendMessage := func() {
 			if err := broadcastChannel.Send(ctx,
-				&ReadyMessage{SenderID: group.memberID},
+				&ReadyMessage{
+					SenderID:            group.memberID,
+					SessionNonce:        sessionNonce,
+					ConfirmedNonces:     state.confirmedNonces(),
+					KeyEpochFingerprint: keyEpochFingerprint,
+					ProtocolVersion:     currentProtocolVersion,
+				},
 			); err != nil {
 				logger.Errorf("failed to send readiness notification: [%v]", err)
 			}
@@ -94,9 +218,19 @@ func readyProtocol(
 
 		// Send the message first time. It will be periodically retransmitted
 		// by the broadcast channel for the entire lifetime of the context.
+		// The message is sent again each time a new nonce is received so
+		// that the member confirms it.
 		sendMessage()
 
-		<-ctx.Done()
+	sendLoop:
+		for {
+			select {
+			case <-state.nonceReceivedChan:
+				sendMessage()
+			case <-ctx.Done():
+				break sendLoop
+			}
+		}
 		// Send the message once again as the member received messages
 		// from all peer members but not all peer members could receive
 		// the message from the member as some peer member could join
@@ -107,39 +241,444 @@ func readyProtocol(
 
 	<-ctx.Done()
 
-	switch ctx.Err() {
-	case context.DeadlineExceeded:
-		for _, memberID := range group.groupMemberIDs {
-			memberAddress, err := memberIDToAddress(memberID, publicKeyToAddressFn)
-			if err != nil {
-				logger.Errorf(
-					"could not convert member ID to address for a member of "+
-						"keep [%s]: [%v]",
-					group.groupID,
-					err,
-				)
-				continue
-			}
-			if !readyMembers[memberAddress] {
-				logger.Errorf(
-					"member [%s] has not announced its readiness for keep [%s]; "+
-						"check if keep client for that operator is active and "+
-						"connected",
-					memberAddress,
-					group.groupID,
-				)
+	if parentCtx.Err() != nil {
+		return nil, fmt.Errorf(
+			"readiness signalling interrupted: [%v]",
+			parentCtx.Err(),
+		)
+	}
+
+	state.mutex.Lock()
+	defer state.mutex.Unlock()
+
+	if len(state.legacyMembers) > 0 {
+		if len(state.readyMembers) < len(group.groupMemberIDs) {
+			return nil, readyTimeoutError{
+				timeout:        protocolReadyTimeout,
+				missingMembers: state.missingMembers(publicKeyToAddressFn),
 			}
 		}
-		return fmt.Errorf(
-			"waiting for readiness timed out after: [%v]", protocolReadyTimeout,
+
+		logger.Infof(
+			"all [%d] members of keep [%s] are ready; executing the protocol "+
+				"without a session as not all members support session nonces",
+			len(group.groupMemberIDs),
+			group.groupID,
 		)
+
+		return &readySession{
+			memberIDs:       group.groupMemberIDs,
+			sessionID:       "",
+			protocolVersion: legacyProtocolVersion,
+		}, nil
+	}
+
+	switch ctx.Err() {
+	case context.DeadlineExceeded:
+		if len(state.readyMembers) >= requiredReadyCount {
+			logger.Infof(
+				"[%d] out of [%d] members of keep [%s] signalled readiness; "+
+					"required [%d] members are ready",
+				len(state.readyMembers),
+				len(group.groupMemberIDs),
+				group.groupID,
+				requiredReadyCount,
+			)
+
+			return state.selectSession(requiredReadyCount), nil
+		}
+
+		return nil, readyTimeoutError{
+			timeout:              protocolReadyTimeout,
+			missingMembers:       state.missingMembers(publicKeyToAddressFn),
+			keyEpochFingerprints: state.peerKeyEpochFingerprints(),
+		}
 	case context.Canceled:
-		logger.Infof("successfully signalled readiness")
+		logger.Infof(
+			"successfully signalled readiness; [%d] out of [%d] members "+
+				"of keep [%s] are ready",
+			len(state.readyMembers),
+			len(group.groupMemberIDs),
+			group.groupID,
+		)
 
-		return nil
+		return state.selectSession(requiredReadyCount), nil
 	default:
-		return fmt.Errorf("unexpected context error: [%v]", ctx.Err())
+		return nil, fmt.Errorf("unexpected context error: [%v]", ctx.Err())
+	}
+}
+
+// readyState holds readiness of group members observed by the member during
+// the execution of the readiness signalling protocol.
+type readyState struct {
+	group               *groupInfo
+	sessionNonce        []byte
+	keyEpochFingerprint []byte
+
+	mutex        sync.Mutex
+	readyMembers map[string]MemberID // member address -> member ID
+	// Member ID -> the most recent session nonce received from the member.
+	receivedNonces map[string][]byte
+	// Member ID -> the session nonce of the member who confirmed the nonce
+	// of the current member.
+	sessionNonces map[string][]byte
+	// Member ID -> nonces confirmed in the most recent message of the ready
+	// member.
+	memberConfirmations map[string]map[string][]byte
+	// Member ID -> the fingerprint of the key epoch of the member holding
+	// key shares of another epoch than the current member.
+	peerKeyEpochs map[string][]byte
+	// Member IDs of members running the legacy client version, sending
+	// readiness messages without a session nonce.
+	legacyMembers map[string]bool
+	// Member ID -> the protocol version announced by the ready member.
+	protocolVersions map[string]uint32
+
+	// Signals the readiness message has to be sent again as it does not
+	// confirm the most recent nonces received from members.
+	nonceReceivedChan chan struct{}
+}
+
+func newReadyState(
+	group *groupInfo,
+	sessionNonce []byte,
+	keyEpochFingerprint []byte,
+) *readyState {
+	return &readyState{
+		group:               group,
+		sessionNonce:        sessionNonce,
+		keyEpochFingerprint: keyEpochFingerprint,
+		readyMembers:        make(map[string]MemberID),
+		receivedNonces:      map[string][]byte{group.memberID.String(): sessionNonce},
+		sessionNonces:       map[string][]byte{group.memberID.String(): sessionNonce},
+		memberConfirmations: make(map[string]map[string][]byte),
+		peerKeyEpochs:       make(map[string][]byte),
+		legacyMembers:       make(map[string]bool),
+		protocolVersions: map[string]uint32{
+			group.memberID.String(): currentProtocolVersion,
+		},
+		nonceReceivedChan: make(chan struct{}, 1),
+	}
+}
+
+// accept records the session nonce of the sender of the readiness message and
+// returns true if the sender became ready with this message, that is,
+// the sender confirmed the current nonce of this member. Once accepted,
+// the sender's nonce is not replaced anymore, so delayed messages of previous
+// attempts are ignored. If a new nonce has been received, the readiness
+// message of this member has to be sent again to confirm it. Senders holding
+// key shares of another epoch are recorded and never accepted. Senders running
+// the legacy client version, not sending a nonce, are accepted with any
+// message.
+func (rs *readyState) accept(memberAddress string, msg *ReadyMessage) bool {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	senderID := msg.SenderID.String()
+
+	if len(rs.keyEpochFingerprint) > 0 &&
+		len(msg.KeyEpochFingerprint) > 0 &&
+		!bytes.Equal(rs.keyEpochFingerprint, msg.KeyEpochFingerprint) {
+		if _, isRecorded := rs.peerKeyEpochs[senderID]; !isRecorded {
+			logger.Warningf(
+				"member [%s] of group [%s] holds key shares of another epoch "+
+					"[%x]; current epoch is [%x]",
+				memberAddress,
+				rs.group.groupID,
+				msg.KeyEpochFingerprint,
+				rs.keyEpochFingerprint,
+			)
+		}
+		rs.peerKeyEpochs[senderID] = msg.KeyEpochFingerprint
+		return false
+	}
+
+	if len(msg.SessionNonce) == 0 && !msg.SenderID.Equal(rs.group.memberID) {
+		if !rs.legacyMembers[senderID] {
+			logger.Warningf(
+				"member [%s] of group [%s] does not support session nonces; "+
+					"falling back to readiness signalling without a session",
+				memberAddress,
+				rs.group.groupID,
+			)
+		}
+		rs.legacyMembers[senderID] = true
+
+		_, wasReady := rs.readyMembers[memberAddress]
+		rs.readyMembers[memberAddress] = msg.SenderID
+		return !wasReady
+	}
+
+	if msg.SenderID.Equal(rs.group.memberID) {
+		if !bytes.Equal(msg.SessionNonce, rs.sessionNonce) {
+			return false
+		}
+
+		_, wasReady := rs.readyMembers[memberAddress]
+		rs.readyMembers[memberAddress] = msg.SenderID
+		return !wasReady
+	}
+
+	if acceptedNonce, isAccepted := rs.sessionNonces[senderID]; isAccepted {
+		if bytes.Equal(acceptedNonce, msg.SessionNonce) && bytes.Equal(
+			msg.ConfirmedNonces[rs.group.memberID.String()],
+			rs.sessionNonce,
+		) {
+			rs.memberConfirmations[senderID] = msg.ConfirmedNonces
+		}
+		return false
+	}
+
+	if !bytes.Equal(rs.receivedNonces[senderID], msg.SessionNonce) {
+		rs.receivedNonces[senderID] = msg.SessionNonce
+
+		select {
+		case rs.nonceReceivedChan <- struct{}{}:
+		default:
+			// The message is going to be sent again anyway.
+		}
+	}
+
+	if !bytes.Equal(
+		msg.ConfirmedNonces[rs.group.memberID.String()],
+		rs.sessionNonce,
+	) {
+		return false
+	}
+
+	rs.sessionNonces[senderID] = msg.SessionNonce
+	rs.memberConfirmations[senderID] = msg.ConfirmedNonces
+	rs.protocolVersions[senderID] = msg.ProtocolVersion
+	rs.readyMembers[memberAddress] = msg.SenderID
+
+	return true
+}
+
+// missingMembers returns identifiers of group members who have not signalled
+// their readiness, skipping members holding key shares of another epoch. It
+// has to be called with the mutex locked.
+func (rs *readyState) missingMembers(
+	publicKeyToAddressFn func(cecdsa.PublicKey) []byte,
+) []MemberID {
+	missingMembers := []MemberID{}
+	for _, memberID := range rs.group.groupMemberIDs {
+		memberAddress, err := memberIDToAddress(memberID, publicKeyToAddressFn)
+		if err != nil {
+			logger.Errorf(
+				"could not convert member ID to address for a member of "+
+					"keep [%s]: [%v]",
+				rs.group.groupID,
+				err,
+			)
+			continue
+		}
+		if _, isOtherEpoch := rs.peerKeyEpochs[memberID.String()]; isOtherEpoch {
+			continue
+		}
+		if _, isReady := rs.readyMembers[memberAddress]; !isReady {
+			logger.Errorf(
+				"member [%s] has not announced its readiness for keep [%s]; "+
+					"check if keep client for that operator is active and "+
+					"connected",
+				memberAddress,
+				rs.group.groupID,
+			)
+			missingMembers = append(missingMembers, memberID)
+		}
+	}
+
+	return missingMembers
+}
+
+// confirmedNonces returns the most recent session nonces received from group
+// members, to be confirmed in the readiness message of this member.
+func (rs *readyState) confirmedNonces() map[string][]byte {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	confirmedNonces := make(map[string][]byte, len(rs.receivedNonces))
+	for memberID, nonce := range rs.receivedNonces {
+		confirmedNonces[memberID] = nonce
+	}
+
+	return confirmedNonces
+}
+
+// peerKeyEpochFingerprints returns distinct fingerprints of key epochs of
+// members holding key shares of another epoch than the current member. It has
+// to be called with the mutex locked.
+func (rs *readyState) peerKeyEpochFingerprints() [][]byte {
+	memberIDs := make([]string, 0, len(rs.peerKeyEpochs))
+	for memberID := range rs.peerKeyEpochs {
+		memberIDs = append(memberIDs, memberID)
+	}
+	sort.Strings(memberIDs)
+
+	fingerprints := [][]byte{}
+	for _, memberID := range memberIDs {
+		fingerprint := rs.peerKeyEpochs[memberID]
+
+		isDistinct := true
+		for _, other := range fingerprints {
+			if bytes.Equal(other, fingerprint) {
+				isDistinct = false
+				break
+			}
+		}
+		if isDistinct {
+			fingerprints = append(fingerprints, fingerprint)
+		}
+	}
+
+	return fingerprints
+}
+
+// legacyReadiness returns true if any group member runs the legacy client
+// version together with the number of ready members.
+func (rs *readyState) legacyReadiness() (bool, int) {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	return len(rs.legacyMembers) > 0, len(rs.readyMembers)
+}
+
+func (rs *readyState) selectMembers() []MemberID {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	return rs.selectConfirmedMembers()
+}
+
+// selectConfirmedMembers selects ready members in ascending order of their
+// identifiers, skipping members who did not confirm session nonces of already
+// selected members or whose nonces were not confirmed by them. It has to be
+// called with the mutex locked.
+func (rs *readyState) selectConfirmedMembers() []MemberID {
+	readyMemberIDs := sortMemberIDs(rs.readyMembers)
+
+	// Nonces confirmed by the current member are the ones it received.
+	confirmations := map[string]map[string][]byte{
+		rs.group.memberID.String(): rs.receivedNonces,
+	}
+	for memberID, memberConfirmations := range rs.memberConfirmations {
+		confirmations[memberID] = memberConfirmations
+	}
+
+	isConfirmedBy := func(memberID, confirmingMemberID MemberID) bool {
+		return bytes.Equal(
+			confirmations[confirmingMemberID.String()][memberID.String()],
+			rs.sessionNonces[memberID.String()],
+		)
+	}
+
+	selectedMembers := []MemberID{}
+	for _, candidate := range readyMemberIDs {
+		isMutuallyConfirmed := true
+		for _, selectedMember := range selectedMembers {
+			if !isConfirmedBy(candidate, selectedMember) ||
+				!isConfirmedBy(selectedMember, candidate) {
+				isMutuallyConfirmed = false
+				break
+			}
+		}
+
+		if isMutuallyConfirmed {
+			selectedMembers = append(selectedMembers, candidate)
+		}
+	}
+
+	return selectedMembers
+}
+
+// selectSession selects the given number of ready members and derives
+// the identifier of the protocol session they are going to execute and
+// the lowest protocol version they announced. If not enough ready members
+// confirmed nonces of each other, ready members with the lowest identifiers
+// are selected. It has to be called with the mutex locked.
+func (rs *readyState) selectSession(count int) *readySession {
+	selectedMembers := rs.selectConfirmedMembers()
+	if len(selectedMembers) >= count {
+		selectedMembers = selectedMembers[:count]
+	} else {
+		selectedMembers = selectReadyMembers(rs.readyMembers, count)
+	}
+
+	sessionID := deriveSessionID(
+		rs.group.groupID,
+		selectedMembers,
+		rs.sessionNonces,
+	)
+
+	protocolVersion := currentProtocolVersion
+	for _, memberID := range selectedMembers {
+		if memberVersion := rs.protocolVersions[memberID.String()]; memberVersion < protocolVersion {
+			protocolVersion = memberVersion
+		}
+	}
+
+	logger.Infof(
+		"agreed on session [%s] with [%d] members of group [%s] "+
+			"running protocol version [%d]",
+		sessionID,
+		len(selectedMembers),
+		rs.group.groupID,
+		protocolVersion,
+	)
+
+	return &readySession{
+		memberIDs:       selectedMembers,
+		sessionID:       sessionID,
+		protocolVersion: protocolVersion,
+	}
+}
+
+// deriveSessionID derives the protocol session identifier from the group
+// identifier and session nonces of members participating in the session.
+// Members are expected to be sorted, so that all participants derive the same
+// identifier.
+func deriveSessionID(
+	groupID string,
+	memberIDs []MemberID,
+	sessionNonces map[string][]byte,
+) string {
+	hash := sha256.New()
+	hash.Write([]byte(groupID))
+	for _, memberID := range memberIDs {
+		hash.Write(memberID)
+		hash.Write(sessionNonces[memberID.String()])
+	}
+
+	return groupID + "-" + hex.EncodeToString(hash.Sum(nil))
+}
+
+// isSessionOfGroup returns true if the protocol session identifier has been
+// derived for the group with the given identifier.
+func isSessionOfGroup(sessionID string, groupID string) bool {
+	separatorIndex := strings.LastIndex(sessionID, "-")
+	return separatorIndex >= 0 && sessionID[:separatorIndex] == groupID
+}
+
+// selectReadyMembers selects the given number of ready members with the lowest
+// identifiers.
+func selectReadyMembers(
+	readyMembers map[string]MemberID,
+	count int,
+) []MemberID {
+	return sortMemberIDs(readyMembers)[:count]
+}
+
+// sortMemberIDs returns identifiers of the given members in ascending order.
+func sortMemberIDs(members map[string]MemberID) []MemberID {
+	memberIDs := make([]MemberID, 0, len(members))
+	for _, memberID := range members {
+		memberIDs = append(memberIDs, memberID)
 	}
+
+	sort.Slice(memberIDs, func(i, j int) bool {
+		return memberIDs[i].bigInt().Cmp(memberIDs[j].bigInt()) < 0
+	})
+
+	return memberIDs
 }
 
 func memberIDToAddress(
@@ -4,6 +4,8 @@ import (
 	"context"
 	cecdsa "crypto/ecdsa"
 	"crypto/elliptic"
+	"fmt"
+	"reflect"
 	"sync"
 	"testing"
 	"time"
@@ -40,6 +42,7 @@ func TestReadyProtocol(t *testing.T) {
 
 	mutex := &sync.RWMutex{}
 	readyCount := 0
+	sessionIDs := make(map[string]bool)
 
 	for _, memberID := range groupMembers {
 		go func(memberID MemberID) {
@@ -70,18 +73,40 @@ func TestReadyProtocol(t *testing.T) {
 
 			defer waitGroup.Done()
 
-			if err := readyProtocol(
+			session, err := readyProtocol(
 				ctx,
 				groupInfo,
 				broadcastChannel,
 				pubKeyToAddressFn,
-			); err != nil {
+				groupSize,
+				nil,
+			)
+			if err != nil {
 				errChan <- err
 				return
 			}
 
+			if len(session.memberIDs) != groupSize {
+				errChan <- fmt.Errorf(
+					"invalid number of ready members\nexpected: [%d]\nactual:   [%d]",
+					groupSize,
+					len(session.memberIDs),
+				)
+				return
+			}
+
+			if session.protocolVersion != currentProtocolVersion {
+				errChan <- fmt.Errorf(
+					"unexpected protocol version\nexpected: [%d]\nactual:   [%d]",
+					currentProtocolVersion,
+					session.protocolVersion,
+				)
+				return
+			}
+
 			mutex.Lock()
 			readyCount++
+			sessionIDs[session.sessionID] = true
 			mutex.Unlock()
 		}(memberID)
 	}
@@ -100,8 +125,492 @@ func TestReadyProtocol(t *testing.T) {
 				readyCount,
 			)
 		}
+		if len(sessionIDs) != 1 {
+			t.Errorf(
+				"members have not agreed on the session\nsessions: [%v]",
+				sessionIDs,
+			)
+		}
 	case err := <-errChan:
 		t.Fatal(err)
 	}
 
 }
+
+func TestReadyProtocolWithMissingMember(t *testing.T) {
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		protocolReadyGracePeriod+5*time.Second,
+	)
+	defer cancel()
+
+	groupSize := 3
+	readyMembersCount := 2
+
+	groupMembers, err := generateMemberKeys(groupSize)
+	if err != nil {
+		t.Fatalf("failed to generate members keys: [%v]", err)
+	}
+
+	pubKeyToAddressFn := func(publicKey cecdsa.PublicKey) []byte {
+		return elliptic.Marshal(publicKey.Curve, publicKey.X, publicKey.Y)
+	}
+
+	type result struct {
+		session *readySession
+		err     error
+	}
+	resultChan := make(chan *result, readyMembersCount)
+
+	// The last member does not signal its readiness.
+	for _, memberID := range groupMembers[:readyMembersCount] {
+		go func(memberID MemberID) {
+			groupInfo := &groupInfo{
+				groupID:        "test-group-1",
+				memberID:       memberID,
+				groupMemberIDs: groupMembers,
+			}
+
+			memberPublicKey, err := memberID.PublicKey()
+			if err != nil {
+				resultChan <- &result{err: err}
+				return
+			}
+
+			memberNetworkKey := key.NetworkPublic(*memberPublicKey)
+			networkProvider := newTestNetProvider(&memberNetworkKey)
+
+			broadcastChannel, err := networkProvider.BroadcastChannelFor("test-group-1")
+			if err != nil {
+				resultChan <- &result{err: err}
+				return
+			}
+
+			broadcastChannel.SetUnmarshaler(func() net.TaggedUnmarshaler {
+				return &ReadyMessage{}
+			})
+
+			session, err := readyProtocol(
+				ctx,
+				groupInfo,
+				broadcastChannel,
+				pubKeyToAddressFn,
+				readyMembersCount,
+				nil,
+			)
+			resultChan <- &result{session, err}
+		}(memberID)
+	}
+
+	var results []*result
+	for i := 0; i < readyMembersCount; i++ {
+		result := <-resultChan
+		if result.err != nil {
+			t.Fatal(result.err)
+		}
+		results = append(results, result)
+	}
+
+	if !reflect.DeepEqual(
+		results[0].session.memberIDs,
+		results[1].session.memberIDs,
+	) {
+		t.Errorf(
+			"members selected different participants\nfirst: [%v]\nsecond: [%v]",
+			results[0].session.memberIDs,
+			results[1].session.memberIDs,
+		)
+	}
+	if results[0].session.sessionID != results[1].session.sessionID {
+		t.Errorf(
+			"members have not agreed on the session\nfirst: [%v]\nsecond: [%v]",
+			results[0].session.sessionID,
+			results[1].session.sessionID,
+		)
+	}
+}
+
+func TestReadyProtocolWithLegacyMember(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	groupSize := 3
+	requiredReadyCount := 2
+
+	groupMembers, err := generateMemberKeys(groupSize)
+	if err != nil {
+		t.Fatalf("failed to generate members keys: [%v]", err)
+	}
+
+	pubKeyToAddressFn := func(publicKey cecdsa.PublicKey) []byte {
+		return elliptic.Marshal(publicKey.Curve, publicKey.X, publicKey.Y)
+	}
+
+	newBroadcastChannel := func(memberID MemberID) (net.BroadcastChannel, error) {
+		memberPublicKey, err := memberID.PublicKey()
+		if err != nil {
+			return nil, err
+		}
+
+		memberNetworkKey := key.NetworkPublic(*memberPublicKey)
+		networkProvider := newTestNetProvider(&memberNetworkKey)
+
+		broadcastChannel, err := networkProvider.BroadcastChannelFor("test-group-1")
+		if err != nil {
+			return nil, err
+		}
+
+		broadcastChannel.SetUnmarshaler(func() net.TaggedUnmarshaler {
+			return &ReadyMessage{}
+		})
+
+		return broadcastChannel, nil
+	}
+
+	// The last member runs the legacy client version sending readiness
+	// messages without a session nonce.
+	legacyMember := groupMembers[groupSize-1]
+	legacyChannel, err := newBroadcastChannel(legacyMember)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := legacyChannel.Send(
+					ctx,
+					&ReadyMessage{SenderID: legacyMember},
+				); err != nil {
+					t.Errorf("failed to send legacy message: [%v]", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	type result struct {
+		session *readySession
+		err     error
+	}
+	resultChan := make(chan *result, groupSize-1)
+
+	for _, memberID := range groupMembers[:groupSize-1] {
+		go func(memberID MemberID) {
+			broadcastChannel, err := newBroadcastChannel(memberID)
+			if err != nil {
+				resultChan <- &result{err: err}
+				return
+			}
+
+			session, err := readyProtocol(
+				ctx,
+				&groupInfo{
+					groupID:        "test-group-1",
+					memberID:       memberID,
+					groupMemberIDs: groupMembers,
+				},
+				broadcastChannel,
+				pubKeyToAddressFn,
+				requiredReadyCount,
+				nil,
+			)
+			resultChan <- &result{session, err}
+		}(memberID)
+	}
+
+	for i := 0; i < groupSize-1; i++ {
+		result := <-resultChan
+		if result.err != nil {
+			t.Fatal(result.err)
+		}
+
+		// All group members execute the protocol without a session, as
+		// the legacy client version does.
+		if !reflect.DeepEqual(groupMembers, result.session.memberIDs) {
+			t.Errorf(
+				"unexpected ready members\nexpected: [%v]\nactual:   [%v]",
+				groupMembers,
+				result.session.memberIDs,
+			)
+		}
+		if result.session.sessionID != "" {
+			t.Errorf("unexpected session [%s]", result.session.sessionID)
+		}
+		if result.session.protocolVersion != legacyProtocolVersion {
+			t.Errorf(
+				"unexpected protocol version\nexpected: [%d]\nactual:   [%d]",
+				legacyProtocolVersion,
+				result.session.protocolVersion,
+			)
+		}
+	}
+}
+
+func TestSelectReadyMembers(t *testing.T) {
+	memberIDs := []MemberID{
+		MemberID{0x05},
+		MemberID{0x01},
+		MemberID{0x04},
+		MemberID{0x02},
+	}
+
+	readyMembers := make(map[string]MemberID)
+	for _, memberID := range memberIDs {
+		readyMembers[memberID.String()] = memberID
+	}
+
+	expectedMembers := []MemberID{
+		MemberID{0x01},
+		MemberID{0x02},
+		MemberID{0x04},
+	}
+
+	selectedMembers := selectReadyMembers(readyMembers, 3)
+
+	if !reflect.DeepEqual(expectedMembers, selectedMembers) {
+		t.Errorf(
+			"unexpected selected members\nexpected: [%v]\nactual:   [%v]",
+			expectedMembers,
+			selectedMembers,
+		)
+	}
+}
+
+func TestDeriveSessionID(t *testing.T) {
+	memberIDs := []MemberID{MemberID{0x01}, MemberID{0x02}}
+	sessionNonces := map[string][]byte{
+		MemberID{0x01}.String(): {0x0A},
+		MemberID{0x02}.String(): {0x0B},
+	}
+
+	sessionID := deriveSessionID("test-group-1", memberIDs, sessionNonces)
+
+	if sessionID != deriveSessionID("test-group-1", memberIDs, sessionNonces) {
+		t.Errorf("session identifier is not deterministic")
+	}
+
+	otherNonces := map[string][]byte{
+		MemberID{0x01}.String(): {0x0A},
+		MemberID{0x02}.String(): {0x0C},
+	}
+	if sessionID == deriveSessionID("test-group-1", memberIDs, otherNonces) {
+		t.Errorf("sessions with different nonces have the same identifier")
+	}
+
+	if sessionID == deriveSessionID("test-group-2", memberIDs, sessionNonces) {
+		t.Errorf("sessions of different groups have the same identifier")
+	}
+	if !isSessionOfGroup(sessionID, "test-group-1") {
+		t.Errorf("session does not belong to its group")
+	}
+
+	signingSessionID := deriveSessionID(
+		"test-group-1"+signingGroupSuffix([]byte{0x01}),
+		memberIDs,
+		sessionNonces,
+	)
+	if isSessionOfGroup(signingSessionID, "test-group-1") {
+		t.Errorf("signing session belongs to the group of the keep")
+	}
+	if isSessionOfGroup(
+		signingSessionID,
+		"test-group-1"+signingGroupSuffix([]byte{0x02}),
+	) {
+		t.Errorf("signing session belongs to the group of another digest")
+	}
+}
+
+func TestReadyStateAccept(t *testing.T) {
+	member := MemberID{0x01}
+	peer := MemberID{0x02}
+
+	group := &groupInfo{
+		groupID:        "test-group-1",
+		memberID:       member,
+		groupMemberIDs: []MemberID{member, peer},
+	}
+
+	sessionNonce := []byte{0x0A}
+	state := newReadyState(group, sessionNonce, nil)
+
+	// The peer has not received the nonce of the member yet.
+	if state.accept("peer", &ReadyMessage{
+		SenderID:     peer,
+		SessionNonce: []byte{0x0B},
+	}) {
+		t.Errorf("peer not confirming the member's nonce has been accepted")
+	}
+	select {
+	case <-state.nonceReceivedChan:
+	default:
+		t.Errorf("new nonce of the peer has not been signalled")
+	}
+
+	if !state.accept("peer", &ReadyMessage{
+		SenderID:        peer,
+		SessionNonce:    []byte{0x0B},
+		ConfirmedNonces: map[string][]byte{member.String(): sessionNonce},
+	}) {
+		t.Errorf("peer confirming the member's nonce has not been accepted")
+	}
+
+	// A delayed message of a previous attempt confirming the previous nonce
+	// of the member does not replace the accepted nonce.
+	state.accept("peer", &ReadyMessage{
+		SenderID:        peer,
+		SessionNonce:    []byte{0x0C},
+		ConfirmedNonces: map[string][]byte{member.String(): {0x0D}},
+	})
+
+	if !reflect.DeepEqual(state.sessionNonces[peer.String()], []byte{0x0B}) {
+		t.Errorf(
+			"unexpected session nonce of the peer\nexpected: [%x]\nactual:   [%x]",
+			[]byte{0x0B},
+			state.sessionNonces[peer.String()],
+		)
+	}
+
+	if len(state.readyMembers) != 1 {
+		t.Errorf(
+			"unexpected number of ready members\nexpected: [1]\nactual:   [%d]",
+			len(state.readyMembers),
+		)
+	}
+}
+
+func TestReadyStateAcceptLegacyMember(t *testing.T) {
+	member := MemberID{0x01}
+	peer := MemberID{0x02}
+
+	group := &groupInfo{
+		groupID:        "test-group-1",
+		memberID:       member,
+		groupMemberIDs: []MemberID{member, peer},
+	}
+
+	state := newReadyState(group, []byte{0x0A}, nil)
+
+	if isLegacy, _ := state.legacyReadiness(); isLegacy {
+		t.Errorf("legacy readiness without a legacy member")
+	}
+
+	// The peer running the legacy client version does not send a nonce and
+	// is ready with any message.
+	if !state.accept("peer", &ReadyMessage{SenderID: peer}) {
+		t.Errorf("peer not sending a nonce has not been accepted")
+	}
+	if state.accept("peer", &ReadyMessage{SenderID: peer}) {
+		t.Errorf("peer has been accepted twice")
+	}
+
+	isLegacy, readyCount := state.legacyReadiness()
+	if !isLegacy {
+		t.Errorf("no legacy readiness with a legacy member")
+	}
+	if readyCount != 1 {
+		t.Errorf(
+			"unexpected number of ready members\nexpected: [1]\nactual:   [%d]",
+			readyCount,
+		)
+	}
+}
+
+func TestReadyStateAcceptOtherKeyEpoch(t *testing.T) {
+	member := MemberID{0x01}
+	peers := []MemberID{MemberID{0x02}, MemberID{0x03}}
+
+	group := &groupInfo{
+		groupID:        "test-group-1",
+		memberID:       member,
+		groupMemberIDs: append([]MemberID{member}, peers...),
+	}
+
+	sessionNonce := []byte{0x0A}
+	state := newReadyState(group, sessionNonce, []byte{0xE1})
+
+	if state.accept("peer-0", &ReadyMessage{
+		SenderID:            peers[0],
+		SessionNonce:        []byte{0x0B},
+		ConfirmedNonces:     map[string][]byte{member.String(): sessionNonce},
+		KeyEpochFingerprint: []byte{0xE2},
+	}) {
+		t.Errorf("peer holding key shares of another epoch has been accepted")
+	}
+
+	// Peers not sending the fingerprint are not checked.
+	if !state.accept("peer-1", &ReadyMessage{
+		SenderID:        peers[1],
+		SessionNonce:    []byte{0x0C},
+		ConfirmedNonces: map[string][]byte{member.String(): sessionNonce},
+	}) {
+		t.Errorf("peer not sending key epoch fingerprint has not been accepted")
+	}
+
+	expectedFingerprints := [][]byte{{0xE2}}
+	if !reflect.DeepEqual(expectedFingerprints, state.peerKeyEpochFingerprints()) {
+		t.Errorf(
+			"unexpected key epoch fingerprints\nexpected: [%x]\nactual:   [%x]",
+			expectedFingerprints,
+			state.peerKeyEpochFingerprints(),
+		)
+	}
+}
+
+func TestReadyStateSelectMembers(t *testing.T) {
+	member := MemberID{0x03}
+	peers := []MemberID{MemberID{0x01}, MemberID{0x02}, MemberID{0x04}}
+
+	group := &groupInfo{
+		groupID:        "test-group-1",
+		memberID:       member,
+		groupMemberIDs: append([]MemberID{member}, peers...),
+	}
+
+	nonces := map[string][]byte{
+		member.String():   {0x0A},
+		peers[0].String(): {0x0B},
+		peers[1].String(): {0x0C},
+		peers[2].String(): {0x0D},
+	}
+
+	state := newReadyState(group, nonces[member.String()], nil)
+	state.accept("member", &ReadyMessage{
+		SenderID:     member,
+		SessionNonce: nonces[member.String()],
+	})
+
+	// The first peer has not received the nonce of the second peer.
+	confirmedNonces := map[string]map[string][]byte{
+		peers[0].String(): {
+			member.String():   nonces[member.String()],
+			peers[0].String(): nonces[peers[0].String()],
+			peers[2].String(): nonces[peers[2].String()],
+		},
+		peers[1].String(): nonces,
+		peers[2].String(): nonces,
+	}
+	for i, peer := range peers {
+		state.accept(fmt.Sprintf("peer-%d", i), &ReadyMessage{
+			SenderID:        peer,
+			SessionNonce:    nonces[peer.String()],
+			ConfirmedNonces: confirmedNonces[peer.String()],
+		})
+	}
+
+	expectedMembers := []MemberID{peers[0], member, peers[2]}
+
+	selectedMembers := state.selectMembers()
+
+	if !reflect.DeepEqual(expectedMembers, selectedMembers) {
+		t.Errorf(
+			"unexpected selected members\nexpected: [%v]\nactual:   [%v]",
+			expectedMembers,
+			selectedMembers,
+		)
+	}
+}
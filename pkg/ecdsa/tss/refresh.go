@@ -0,0 +1,232 @@
+package tss
+
+import (
+	"context"
+	cecdsa "crypto/ecdsa"
+	"fmt"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/net"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss/params"
+)
+
+// KeyRefreshProtocolTimeout defines a period within which the key refresh
+// protocol has to complete.
+const KeyRefreshProtocolTimeout = 8 * time.Minute
+
+const (
+	// Suffixes of group identifiers used by the key refresh protocols. They
+	// make the broadcast channels and sessions of the key refresh separate
+	// from the ones used for signing with the key.
+	keyRefreshGroupSuffix             = "-refresh"
+	keyRefreshConfirmationGroupSuffix = "-refresh-confirmation"
+)
+
+// RefreshKey executes the key resharing protocol with all members of the
+// signing group. As a result all members receive fresh shares of the same
+// group key and previous shares can no longer be combined with the new ones.
+//
+// Each member takes part in the protocol as two parties: a party of the old
+// committee holding the current key share and a party of the new committee
+// receiving the new key share. The signer is not modified, a new signer
+// holding the refreshed key share is returned. It should replace the current
+// signer only after all members confirmed they completed the protocol with
+// ConfirmKeyRefresh.
+//
+// Key refresh requires pre-parameters for the new key share which should be
+// generated prior to running this function.
+func (s *ThresholdSigner) RefreshKey(
+	parentCtx context.Context,
+	networkProvider net.Provider,
+	pubKeyToAddressFn func(cecdsa.PublicKey) []byte,
+	paramsBox *params.Box,
+) (*ThresholdSigner, error) {
+	if s.isSingleSigner() {
+		return nil, fmt.Errorf("key of a single signer cannot be refreshed")
+	}
+
+	group := s.sessionGroupInfo(keyRefreshGroupSuffix)
+
+	netBridge, err := newNetworkBridge(group, networkProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize network bridge: [%v]", err)
+	}
+
+	ctx, cancel := context.WithTimeout(parentCtx, KeyRefreshProtocolTimeout)
+	defer cancel()
+
+	preParams, err := paramsBox.Content()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pre-parameters: [%v]", err)
+	}
+
+	// All members hold the current key share and receive the new one.
+	resharingMember, err := initializeResharing(
+		ctx,
+		group,
+		group.groupMemberIDs,
+		&s.thresholdKey,
+		preParams,
+		netBridge,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize key refresh: [%v]", err)
+	}
+
+	broadcastChannel, err := netBridge.getBroadcastChannel()
+	if err != nil {
+		return nil, err
+	}
+
+	// Key refresh requires all group members to participate.
+	session, err := readyProtocol(
+		ctx,
+		group,
+		broadcastChannel,
+		pubKeyToAddressFn,
+		len(group.groupMemberIDs),
+		s.KeyEpochFingerprint(),
+	)
+	if err != nil {
+		return nil, readyError{err}
+	}
+
+	netBridge.establishSession(session.sessionID)
+	defer netBridge.completeSession()
+
+	// The pre-parameters are going to be used for the new key share, they
+	// cannot be reused later.
+	paramsBox.DestroyContent()
+
+	logger.Infof("[party:%s]: starting key refresh", resharingMember.newParty.PartyID())
+
+	key, err := resharingMember.reshareKey(
+		ctx,
+		"key refresh",
+		KeyRefreshProtocolTimeout,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh key: [%v]", err)
+	}
+
+	refreshedSigner := &ThresholdSigner{
+		groupInfo:       s.groupInfo,
+		thresholdKey:    key,
+		keyEpoch:        s.keyEpoch + 1,
+		protocolVersion: s.protocolVersion,
+	}
+
+	if err := refreshedSigner.VerifyKey(); err != nil {
+		return nil, fmt.Errorf("refreshed key is invalid: [%v]", err)
+	}
+
+	if !key.ECDSAPub.Equals(s.thresholdKey.ECDSAPub) {
+		return nil, fmt.Errorf("refreshed key does not match the group public key")
+	}
+
+	logger.Infof("[party:%s]: completed key refresh", resharingMember.newParty.PartyID())
+
+	return refreshedSigner, nil
+}
+
+// ConfirmKeyRefresh exchanges confirmations of the completed key refresh with
+// all members of the signing group. It should be called on the signer returned
+// from RefreshKey. The function returns an error if not all members confirmed
+// they completed the key refresh, in which case the previous signer should
+// be kept.
+func (s *ThresholdSigner) ConfirmKeyRefresh(
+	ctx context.Context,
+	networkProvider net.Provider,
+	pubKeyToAddressFn func(cecdsa.PublicKey) []byte,
+) error {
+	return s.confirmResharing(
+		ctx,
+		keyRefreshConfirmationGroupSuffix,
+		networkProvider,
+		pubKeyToAddressFn,
+	)
+}
+
+// confirmResharing exchanges confirmations of the completed key resharing
+// with all members of the signing group using a session with the given
+// group identifier suffix.
+func (s *ThresholdSigner) confirmResharing(
+	ctx context.Context,
+	groupSuffix string,
+	networkProvider net.Provider,
+	pubKeyToAddressFn func(cecdsa.PublicKey) []byte,
+) error {
+	group := s.sessionGroupInfo(groupSuffix)
+
+	netBridge, err := newNetworkBridge(group, networkProvider)
+	if err != nil {
+		return fmt.Errorf("failed to initialize network bridge: [%v]", err)
+	}
+
+	broadcastChannel, err := netBridge.getBroadcastChannel()
+	if err != nil {
+		return err
+	}
+
+	if _, err := readyProtocol(
+		ctx,
+		group,
+		broadcastChannel,
+		pubKeyToAddressFn,
+		len(group.groupMemberIDs),
+		s.KeyEpochFingerprint(),
+	); err != nil {
+		return readyError{err}
+	}
+
+	return nil
+}
+
+// OnKeyRefreshRequested registers a handler called when another member of the
+// signing group signals their readiness to refresh the key. The handler is
+// expected to join the key refresh by calling RefreshKey. The handler is
+// called for each received readiness message, so it should ignore requests
+// for a key refresh which is already in progress or has just completed.
+// The handler is unregistered when the context is done.
+func (s *ThresholdSigner) OnKeyRefreshRequested(
+	ctx context.Context,
+	networkProvider net.Provider,
+	handler func(requestingMemberID MemberID),
+) error {
+	if s.isSingleSigner() {
+		return nil
+	}
+
+	group := s.sessionGroupInfo(keyRefreshGroupSuffix)
+
+	netBridge, err := newNetworkBridge(group, networkProvider)
+	if err != nil {
+		return fmt.Errorf("failed to initialize network bridge: [%v]", err)
+	}
+
+	broadcastChannel, err := netBridge.getBroadcastChannel()
+	if err != nil {
+		return err
+	}
+
+	broadcastChannel.Recv(ctx, func(netMsg net.Message) {
+		if msg, ok := netMsg.Payload().(*ReadyMessage); ok {
+			if !msg.SenderID.Equal(s.memberID) {
+				handler(msg.SenderID)
+			}
+		}
+	})
+
+	return nil
+}
+
+// sessionGroupInfo returns a copy of the group information with the group
+// identifier extended with the given suffix.
+func (g *groupInfo) sessionGroupInfo(suffix string) *groupInfo {
+	return &groupInfo{
+		groupID:            g.groupID + suffix,
+		memberID:           g.memberID,
+		groupMemberIDs:     g.groupMemberIDs,
+		dishonestThreshold: g.dishonestThreshold,
+	}
+}
@@ -0,0 +1,209 @@
+package tss
+
+import (
+	"bytes"
+	"context"
+	cecdsa "crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/net"
+	"github.com/keep-network/keep-ecdsa/internal/testdata"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss/params"
+)
+
+func TestRefreshKeyAndSign(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 180*time.Second)
+	defer cancel()
+
+	groupSize := 3
+	dishonestThreshold := uint(1)
+	groupID := fmt.Sprintf("tss-test-%d", rand.Int())
+
+	pubKeyToAddressFn := func(publicKey cecdsa.PublicKey) []byte {
+		return elliptic.Marshal(publicKey.Curve, publicKey.X, publicKey.Y)
+	}
+
+	groupMemberIDs, err := generateMemberKeys(groupSize)
+	if err != nil {
+		t.Fatalf("failed to generate members keys: [%v]", err)
+	}
+
+	signers, networkProviders := generateTestSigners(
+		ctx,
+		t,
+		groupID,
+		groupMemberIDs,
+		dishonestThreshold,
+		pubKeyToAddressFn,
+	)
+
+	testData, err := testdata.LoadKeygenTestFixtures(groupSize)
+	if err != nil {
+		t.Fatalf("failed to load test data: [%v]", err)
+	}
+
+	type refreshResult struct {
+		signer *ThresholdSigner
+		err    error
+	}
+
+	resultsChan := make(chan *refreshResult, groupSize)
+
+	for i, memberID := range groupMemberIDs {
+		go func(signer *ThresholdSigner, index int) {
+			value, _ := networkProviders.Load(signer.MemberID().String())
+			networkProvider := value.(net.Provider)
+
+			preParams := testData[index].LocalPreParams
+
+			refreshedSigner, err := signer.RefreshKey(
+				ctx,
+				networkProvider,
+				pubKeyToAddressFn,
+				params.NewBox(&preParams),
+			)
+			if err != nil {
+				resultsChan <- &refreshResult{nil, err}
+				return
+			}
+
+			err = refreshedSigner.ConfirmKeyRefresh(
+				ctx,
+				networkProvider,
+				pubKeyToAddressFn,
+			)
+
+			resultsChan <- &refreshResult{refreshedSigner, err}
+		}(signers[memberID.String()], i)
+	}
+
+	refreshedSigners := make(map[string]*ThresholdSigner)
+	for i := 0; i < groupSize; i++ {
+		select {
+		case result := <-resultsChan:
+			if result.err != nil {
+				t.Fatalf("unexpected error on key refresh: [%v]", result.err)
+			}
+			refreshedSigners[result.signer.MemberID().String()] = result.signer
+		case <-ctx.Done():
+			t.Fatal(ctx.Err())
+		}
+	}
+
+	publicKey := signers[groupMemberIDs[0].String()].PublicKey()
+
+	for memberID, refreshedSigner := range refreshedSigners {
+		signer := signers[memberID]
+
+		if refreshedSigner.GroupID() != groupID {
+			t.Errorf(
+				"unexpected group ID\nexpected: [%s]\nactual:   [%s]",
+				groupID,
+				refreshedSigner.GroupID(),
+			)
+		}
+
+		refreshedPublicKey := refreshedSigner.PublicKey()
+		if refreshedPublicKey.X.Cmp(publicKey.X) != 0 ||
+			refreshedPublicKey.Y.Cmp(publicKey.Y) != 0 {
+			t.Errorf(
+				"public key doesn't match expected\nexpected: [%v]\nactual:   [%v]",
+				publicKey,
+				refreshedPublicKey,
+			)
+		}
+
+		if refreshedSigner.thresholdKey.Xi.Cmp(signer.thresholdKey.Xi) == 0 {
+			t.Errorf("key share of member [%s] has not been refreshed", memberID)
+		}
+
+		if refreshedSigner.thresholdKey.ShareID.Cmp(signer.thresholdKey.ShareID) != 0 {
+			t.Errorf("share ID of member [%s] has changed", memberID)
+		}
+
+		if refreshedSigner.KeyEpoch() != signer.KeyEpoch()+1 {
+			t.Errorf(
+				"unexpected key epoch\nexpected: [%d]\nactual:   [%d]",
+				signer.KeyEpoch()+1,
+				refreshedSigner.KeyEpoch(),
+			)
+		}
+
+		if bytes.Equal(
+			refreshedSigner.KeyEpochFingerprint(),
+			signer.KeyEpochFingerprint(),
+		) {
+			t.Errorf("key epoch fingerprint of member [%s] has not changed", memberID)
+		}
+
+		// All members holding refreshed key shares are in the same epoch.
+		if !bytes.Equal(
+			refreshedSigner.KeyEpochFingerprint(),
+			refreshedSigners[groupMemberIDs[0].String()].KeyEpochFingerprint(),
+		) {
+			t.Errorf("key epoch fingerprint of member [%s] differs", memberID)
+		}
+	}
+
+	// Signing with refreshed key shares.
+	digest := sha256.Sum256([]byte("message to sign"))
+
+	type signingResult struct {
+		signer *ThresholdSigner
+		err    error
+	}
+
+	signingResultsChan := make(chan *signingResult, groupSize)
+
+	for _, refreshedSigner := range refreshedSigners {
+		go func(signer *ThresholdSigner) {
+			value, _ := networkProviders.Load(signer.MemberID().String())
+
+			signature, err := signer.CalculateSignature(
+				ctx,
+				digest[:],
+				value.(net.Provider),
+				pubKeyToAddressFn,
+			)
+			if err == nil && !cecdsa.Verify(
+				(*cecdsa.PublicKey)(publicKey),
+				digest[:],
+				signature.R,
+				signature.S,
+			) {
+				err = fmt.Errorf("invalid signature: [%+v]", signature)
+			}
+
+			signingResultsChan <- &signingResult{signer, err}
+		}(refreshedSigner)
+	}
+
+	signaturesCount := 0
+	for i := 0; i < groupSize; i++ {
+		select {
+		case result := <-signingResultsChan:
+			if result.err == ErrNotSigningParticipant {
+				continue
+			}
+			if result.err != nil {
+				t.Fatalf("unexpected error on signing: [%v]", result.err)
+			}
+			signaturesCount++
+		case <-ctx.Done():
+			t.Fatal(ctx.Err())
+		}
+	}
+
+	if signaturesCount != int(dishonestThreshold)+1 {
+		t.Errorf(
+			"invalid number of signatures\nexpected: %d\nactual:   %d",
+			dishonestThreshold+1,
+			signaturesCount,
+		)
+	}
+}
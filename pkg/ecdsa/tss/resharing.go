@@ -0,0 +1,291 @@
+package tss
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/ecdsa/resharing"
+	tssLib "github.com/binance-chain/tss-lib/tss"
+)
+
+const newCommitteePartyMoniker = "new"
+
+// resharingMember represents a member who initialized the key resharing and
+// is ready to start the protocol.
+type resharingMember struct {
+	*groupInfo
+
+	// Network bridge used for messages transport.
+	networkBridge *networkBridge
+	// Party of the old committee holding the current key share. It is nil
+	// if the member does not hold a share of the key yet.
+	oldParty tssLib.Party
+	// Party of the new committee receiving the new key share.
+	newParty tssLib.Party
+	// Channels where results of the protocol execution will be written to.
+	oldEndChan <-chan keygen.LocalPartySaveData
+	newEndChan <-chan keygen.LocalPartySaveData
+}
+
+// initializeResharing initializes parties of the member for the key resharing
+// protocol. Members of the group passed to the function form the new committee
+// receiving shares of the key. Old committee members have to be members of
+// the new committee as well, they hold the current shares of the key. The
+// current key share has to be provided only if the member is a member of
+// the old committee.
+//
+// Parties of the old and the new committee use the same identifiers but
+// their keys have to be different, so the key of the new committee party is
+// the member ID shifted by the curve order. Shares evaluated at the shifted
+// key are the same, so the key is shifted back after the protocol completes.
+func initializeResharing(
+	ctx context.Context,
+	group *groupInfo,
+	oldCommitteeMemberIDs []MemberID,
+	currentKey *ThresholdKey,
+	tssPreParams *keygen.LocalPreParams,
+	bridge *networkBridge,
+) (*resharingMember, error) {
+	curveOrder := tssLib.EC().Params().N
+
+	isOldCommitteeMember := make(map[string]bool, len(oldCommitteeMemberIDs))
+	for _, memberID := range oldCommitteeMemberIDs {
+		isOldCommitteeMember[memberID.String()] = true
+	}
+
+	var oldPartyID, newPartyID *tssLib.PartyID
+	oldPartiesIDs := []*tssLib.PartyID{}
+	newPartiesIDs := []*tssLib.PartyID{}
+
+	for _, memberID := range group.groupMemberIDs {
+		if memberID.bigInt().Cmp(big.NewInt(0)) <= 0 {
+			return nil, fmt.Errorf(
+				"member ID must be greater than 0, but found [%v]",
+				memberID.bigInt(),
+			)
+		}
+
+		newMemberPartyID := tssLib.NewPartyID(
+			memberID.String(),
+			newCommitteePartyMoniker,
+			new(big.Int).Add(memberID.bigInt(), curveOrder),
+		)
+		newPartiesIDs = append(newPartiesIDs, newMemberPartyID)
+
+		if memberID.Equal(group.memberID) {
+			newPartyID = newMemberPartyID
+		}
+
+		if !isOldCommitteeMember[memberID.String()] {
+			continue
+		}
+
+		oldMemberPartyID := tssLib.NewPartyID(
+			memberID.String(),
+			"",
+			memberID.bigInt(),
+		)
+		oldPartiesIDs = append(oldPartiesIDs, oldMemberPartyID)
+
+		if memberID.Equal(group.memberID) {
+			oldPartyID = oldMemberPartyID
+		}
+	}
+
+	if newPartyID == nil {
+		return nil, fmt.Errorf("member is not a member of the group")
+	}
+
+	if len(oldPartiesIDs) != len(oldCommitteeMemberIDs) {
+		return nil, fmt.Errorf("old committee members are not members of the group")
+	}
+
+	if len(oldPartiesIDs) <= group.dishonestThreshold {
+		return nil, fmt.Errorf(
+			"old committee of [%d] members cannot reshare the key with "+
+				"dishonest threshold [%d]",
+			len(oldPartiesIDs),
+			group.dishonestThreshold,
+		)
+	}
+
+	if (oldPartyID != nil) != (currentKey != nil) {
+		return nil, fmt.Errorf(
+			"current key share has to be provided if and only if the " +
+				"member is a member of the old committee",
+		)
+	}
+
+	oldPeerContext := tssLib.NewPeerContext(tssLib.SortPartyIDs(oldPartiesIDs))
+	newPeerContext := tssLib.NewPeerContext(tssLib.SortPartyIDs(newPartiesIDs))
+
+	oldPartiesCount := len(oldPartiesIDs)
+	newPartiesCount := len(newPartiesIDs)
+
+	tssMessageChan := make(
+		chan tssLib.Message,
+		oldPartiesCount+newPartiesCount,
+	)
+	// End channels are buffered as the party of the old committee completes
+	// the protocol while handling messages addressed to the new committee
+	// party, and it cannot block the messages handling.
+	oldEndChan := make(chan keygen.LocalPartySaveData, 1)
+	newEndChan := make(chan keygen.LocalPartySaveData, 1)
+
+	var oldParty tssLib.Party
+	if oldPartyID != nil {
+		// The party of the old committee clears its private key share after
+		// the protocol completes, so it has to operate on a copy of the share.
+		oldKey := keygen.BuildLocalSaveDataSubset(
+			keygen.LocalPartySaveData(*currentKey),
+			oldPeerContext.IDs(),
+		)
+		oldKey.Xi = new(big.Int).Set(currentKey.Xi)
+
+		oldParty = resharing.NewLocalParty(
+			tssLib.NewReSharingParameters(
+				oldPeerContext,
+				newPeerContext,
+				oldPartyID,
+				oldPartiesCount,
+				group.dishonestThreshold,
+				newPartiesCount,
+				group.dishonestThreshold,
+			),
+			oldKey,
+			tssMessageChan,
+			oldEndChan,
+		)
+	}
+
+	newKey := keygen.NewLocalPartySaveData(newPartiesCount)
+	newKey.LocalPreParams = *tssPreParams
+
+	newParty := resharing.NewLocalParty(
+		tssLib.NewReSharingParameters(
+			oldPeerContext,
+			newPeerContext,
+			newPartyID,
+			oldPartiesCount,
+			group.dishonestThreshold,
+			newPartiesCount,
+			group.dishonestThreshold,
+		),
+		newKey,
+		tssMessageChan,
+		newEndChan,
+	)
+
+	if err := bridge.connectResharing(
+		ctx,
+		tssMessageChan,
+		oldParty,
+		newParty,
+		append(oldPeerContext.IDs(), newPeerContext.IDs()...),
+	); err != nil {
+		return nil, fmt.Errorf("failed to connect bridge network: [%v]", err)
+	}
+
+	return &resharingMember{
+		groupInfo:     group,
+		networkBridge: bridge,
+		oldParty:      oldParty,
+		newParty:      newParty,
+		oldEndChan:    oldEndChan,
+		newEndChan:    newEndChan,
+	}, nil
+}
+
+// reshareKey executes the key resharing protocol. This function needs to be
+// executed only after all members finished the initialization stage. As
+// a result it returns the new threshold key of the member, or error if the
+// protocol failed. Protocol name and timeout are used to describe the timeout
+// error.
+func (rm *resharingMember) reshareKey(
+	ctx context.Context,
+	protocolName string,
+	protocolTimeout time.Duration,
+) (ThresholdKey, error) {
+	parties := []tssLib.Party{rm.newParty}
+
+	if err := rm.newParty.Start(); err != nil {
+		return ThresholdKey{}, fmt.Errorf(
+			"failed to start new committee party: [%v]",
+			rm.newParty.WrapError(err),
+		)
+	}
+	if rm.oldParty != nil {
+		parties = append(parties, rm.oldParty)
+
+		if err := rm.oldParty.Start(); err != nil {
+			return ThresholdKey{}, fmt.Errorf(
+				"failed to start old committee party: [%v]",
+				rm.oldParty.WrapError(err),
+			)
+		}
+	}
+
+	var newKey *keygen.LocalPartySaveData
+	oldPartyDone := rm.oldParty == nil
+
+	for newKey == nil || !oldPartyDone {
+		select {
+		case <-rm.oldEndChan:
+			oldPartyDone = true
+		case key := <-rm.newEndChan:
+			newKey = &key
+		case <-ctx.Done():
+			memberIDs := []MemberID{}
+			waitingFor := make(map[string]bool)
+
+			for _, party := range parties {
+				for _, partyID := range party.WaitingFor() {
+					if waitingFor[partyID.GetId()] {
+						continue
+					}
+					waitingFor[partyID.GetId()] = true
+
+					memberID, err := MemberIDFromString(partyID.GetId())
+					if err != nil {
+						logger.Errorf(
+							"cannot get member id from string [%v]: [%v]",
+							partyID.GetId(),
+							err,
+						)
+						continue
+					}
+
+					memberIDs = append(memberIDs, memberID)
+				}
+			}
+
+			return ThresholdKey{}, timeoutError{
+				protocolTimeout,
+				protocolName,
+				memberIDs,
+			}
+		}
+	}
+
+	return normalizeResharedKey(*newKey), nil
+}
+
+// normalizeResharedKey shifts keys of the new committee parties back to
+// member IDs, so the reshared key can be used and reshared again the same
+// way as a key from the key generation.
+func normalizeResharedKey(key keygen.LocalPartySaveData) ThresholdKey {
+	curveOrder := tssLib.EC().Params().N
+
+	ks := make([]*big.Int, len(key.Ks))
+	for i, k := range key.Ks {
+		ks[i] = new(big.Int).Sub(k, curveOrder)
+	}
+
+	key.Ks = ks
+	key.ShareID = new(big.Int).Sub(key.ShareID, curveOrder)
+
+	return ThresholdKey(key)
+}
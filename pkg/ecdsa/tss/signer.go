@@ -1,6 +1,8 @@
 package tss
 
 import (
+	"crypto/sha256"
+
 	"github.com/binance-chain/tss-lib/ecdsa/keygen"
 	tssLib "github.com/binance-chain/tss-lib/tss"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa"
@@ -13,6 +15,16 @@ type ThresholdSigner struct {
 	// thresholdKey contains a signer's key generated for a threshold signing
 	// scheme. This data should be persisted to a local storage.
 	thresholdKey ThresholdKey
+
+	// keyEpoch is the number of key refreshes the key share went through
+	// since the key has been generated.
+	keyEpoch uint64
+
+	// protocolVersion is the lowest protocol version run by group members
+	// during the key generation. It determines the broadcast channel used for
+	// signing, so that members running the legacy client version can take
+	// part in it.
+	protocolVersion uint32
 }
 
 // ThresholdKey contains data of signer's threshold key.
@@ -28,6 +40,36 @@ func (s *ThresholdSigner) GroupID() string {
 	return s.groupID
 }
 
+// GroupMemberIDs returns unique identifiers of all signing group members.
+func (s *ThresholdSigner) GroupMemberIDs() []MemberID {
+	return s.groupMemberIDs
+}
+
+// KeyEpoch returns the number of key refreshes the signer's key share went
+// through since the key has been generated.
+func (s *ThresholdSigner) KeyEpoch() uint64 {
+	return s.keyEpoch
+}
+
+// KeyEpochFingerprint returns the fingerprint of public key shares of all
+// group members. It is the same for all members holding key shares of the same
+// epoch and changes with every key refresh, even though the group public key
+// stays the same.
+func (s *ThresholdSigner) KeyEpochFingerprint() []byte {
+	hash := sha256.New()
+	for i, k := range s.thresholdKey.Ks {
+		if k != nil {
+			hash.Write(k.Bytes())
+		}
+		if i < len(s.thresholdKey.BigXj) && s.thresholdKey.BigXj[i] != nil {
+			hash.Write(s.thresholdKey.BigXj[i].X().Bytes())
+			hash.Write(s.thresholdKey.BigXj[i].Y().Bytes())
+		}
+	}
+
+	return hash.Sum(nil)
+}
+
 // PublicKey returns signer's ECDSA public key which is also the signing group's
 // public key.
 func (s *ThresholdSigner) PublicKey() *ecdsa.PublicKey {
@@ -14,17 +14,30 @@ import (
 )
 
 // initializeSigning initializes a member to run a threshold multi-party signature
-// calculation protocol. Signature will be calculated for provided digest.
+// calculation protocol. Signature will be calculated for provided digest by
+// the given signing members. There should be at least `t + 1` signing members,
+// where `t` is the dishonest threshold of the group.
 func (s *ThresholdSigner) initializeSigning(
 	ctx context.Context,
 	digest []byte,
+	signingMemberIDs []MemberID,
 	netBridge *networkBridge,
 ) (*signingSigner, error) {
+	if len(signingMemberIDs) <= s.dishonestThreshold {
+		return nil, fmt.Errorf(
+			"signing members count [%d] should be greater than "+
+				"dishonest threshold [%d]",
+			len(signingMemberIDs),
+			s.dishonestThreshold,
+		)
+	}
+
 	digestInt := new(big.Int).SetBytes(digest)
 
 	party, endChan, err := s.initializeSigningParty(
 		ctx,
 		digestInt,
+		signingMemberIDs,
 		netBridge,
 	)
 	if err != nil {
@@ -93,7 +106,10 @@ func (s *signingSigner) sign(ctx context.Context) (*ecdsa.Signature, error) {
 				}
 			}
 
-			return nil, timeoutError{SigningProtocolTimeout, "signing", memberIDs}
+			return nil, s.networkBridge.withCulprits(fmt.Errorf(
+				"failed to sign: [%v]",
+				timeoutError{SigningProtocolTimeout, "signing", memberIDs},
+			))
 		}
 	}
 }
@@ -101,18 +117,19 @@ func (s *signingSigner) sign(ctx context.Context) (*ecdsa.Signature, error) {
 func (s *ThresholdSigner) initializeSigningParty(
 	ctx context.Context,
 	digest *big.Int,
+	signingMemberIDs []MemberID,
 	netBridge *networkBridge,
 ) (
 	tssLib.Party,
 	<-chan common.SignatureData,
 	error,
 ) {
-	tssMessageChan := make(chan tss.Message, len(s.groupMemberIDs))
+	tssMessageChan := make(chan tss.Message, len(signingMemberIDs))
 	endChan := make(chan common.SignatureData)
 
 	currentPartyID, groupPartiesIDs, err := generatePartiesIDs(
 		s.memberID,
-		s.groupMemberIDs,
+		signingMemberIDs,
 	)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to generate parties IDs: [%v]", err)
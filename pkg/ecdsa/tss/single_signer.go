@@ -0,0 +1,103 @@
+package tss
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/crypto/paillier"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	tssLib "github.com/binance-chain/tss-lib/tss"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa"
+)
+
+// isSingleSigner returns true if the group consists of only one member.
+// Such a member holds the whole private key and does not need to run any
+// multi-party protocol with other members.
+func (gi *groupInfo) isSingleSigner() bool {
+	return len(gi.groupMemberIDs) == 1
+}
+
+// generateSingleSigner generates an ECDSA key for a group consisting of only
+// one member. The key is generated locally, without running any network
+// protocol. The key is stored in the same threshold key structure as keys
+// generated by multiple parties so it can be persisted in the same format.
+func generateSingleSigner(group *groupInfo) (*ThresholdSigner, error) {
+	privateKey, err := ethcrypto.GenerateKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate private key: [%v]", err)
+	}
+
+	publicKey, err := crypto.NewECPoint(
+		tssLib.EC(),
+		privateKey.PublicKey.X,
+		privateKey.PublicKey.Y,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create public key point: [%v]", err)
+	}
+
+	// There are no other parties the member could run Paillier-based
+	// protocols with, so pre-parameters are left empty.
+	zero := func() *big.Int { return big.NewInt(0) }
+	keygenData := keygen.NewLocalPartySaveData(1)
+	keygenData.LocalPreParams = keygen.LocalPreParams{
+		PaillierSK: &paillier.PrivateKey{
+			PublicKey: paillier.PublicKey{N: zero()},
+			LambdaN:   zero(),
+			PhiN:      zero(),
+		},
+		NTildei: zero(),
+		H1i:     zero(),
+		H2i:     zero(),
+		Alpha:   zero(),
+		Beta:    zero(),
+		P:       zero(),
+		Q:       zero(),
+	}
+	keygenData.LocalSecrets = keygen.LocalSecrets{
+		Xi:      privateKey.D,
+		ShareID: group.memberID.bigInt(),
+	}
+	keygenData.Ks[0] = group.memberID.bigInt()
+	keygenData.NTildej[0] = zero()
+	keygenData.H1j[0] = zero()
+	keygenData.H2j[0] = zero()
+	keygenData.BigXj[0] = publicKey
+	keygenData.PaillierPKs[0] = &paillier.PublicKey{N: zero()}
+	keygenData.ECDSAPub = publicKey
+
+	return &ThresholdSigner{
+		groupInfo:    group,
+		thresholdKey: ThresholdKey(keygenData),
+	}, nil
+}
+
+// calculateSingleSignerSignature calculates a signature over the digest with
+// the private key held by the only member of the group.
+func (s *ThresholdSigner) calculateSingleSignerSignature(
+	digest []byte,
+) (*ecdsa.Signature, error) {
+	privateKey, err := ethcrypto.ToECDSA(
+		math.PaddedBigBytes(s.thresholdKey.Xi, 32),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore private key: [%v]", err)
+	}
+
+	// Signature is returned in `[R || S || V]` format where `V` is a recovery
+	// ID in {0, 1}. The `S` value is always in the lower half of the curve
+	// order.
+	signature, err := ethcrypto.Sign(digest, privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign digest: [%v]", err)
+	}
+
+	return &ecdsa.Signature{
+		R:          new(big.Int).SetBytes(signature[:32]),
+		S:          new(big.Int).SetBytes(signature[32:64]),
+		RecoveryID: int(signature[64]),
+	}, nil
+}
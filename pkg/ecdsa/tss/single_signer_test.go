@@ -0,0 +1,76 @@
+package tss
+
+import (
+	"context"
+	cecdsa "crypto/ecdsa"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss/params"
+	"github.com/keep-network/keep-ecdsa/pkg/utils/testutils"
+)
+
+func TestGenerateSingleSignerAndSign(t *testing.T) {
+	groupMemberIDs, err := generateMemberKeys(1)
+	if err != nil {
+		t.Fatalf("failed to generate members keys: [%v]", err)
+	}
+
+	paramsBox := params.NewBox(&keygen.LocalPreParams{})
+
+	signer, err := GenerateThresholdSigner(
+		context.Background(),
+		"single-signer-test",
+		groupMemberIDs[0],
+		groupMemberIDs,
+		0,
+		nil, // network provider is not used by a single signer
+		nil, // neither is public key to address conversion
+		paramsBox,
+	)
+	if err != nil {
+		t.Fatalf("failed to generate signer: [%v]", err)
+	}
+
+	if paramsBox.IsEmpty() {
+		t.Errorf("pre-parameters should not be destroyed for single signer")
+	}
+
+	// Make sure the signer survives the round trip through its persistent
+	// form before signing.
+	bytes, err := signer.Marshal()
+	if err != nil {
+		t.Fatalf("failed to marshal signer: [%v]", err)
+	}
+
+	unmarshaled := &ThresholdSigner{}
+	if err := unmarshaled.Unmarshal(bytes); err != nil {
+		t.Fatalf("failed to unmarshal signer: [%v]", err)
+	}
+
+	digest := sha256.Sum256([]byte("message to sign"))
+
+	signature, err := unmarshaled.CalculateSignature(
+		context.Background(),
+		digest[:],
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to calculate signature: [%v]", err)
+	}
+
+	publicKey := signer.PublicKey()
+
+	if !cecdsa.Verify(
+		(*cecdsa.PublicKey)(publicKey),
+		digest[:],
+		signature.R,
+		signature.S,
+	) {
+		t.Errorf("invalid signature: [%+v]", signature)
+	}
+
+	testutils.VerifyEthereumSignature(t, digest[:], signature, publicKey)
+}
@@ -9,6 +9,8 @@ package tss
 import (
 	"context"
 	cecdsa "crypto/ecdsa"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"time"
 
@@ -23,8 +25,28 @@ const (
 	SigningProtocolTimeout       = 10 * time.Minute
 )
 
+const (
+	// legacyProtocolVersion is the protocol version of members running
+	// the client version which does not announce any. They execute protocols
+	// with all group members and without sessions, and calculate signatures
+	// over the broadcast channel of the keep.
+	legacyProtocolVersion uint32 = 0
+	// signingChannelsProtocolVersion is the lowest protocol version which
+	// calculates signature of each digest over a separate broadcast channel.
+	signingChannelsProtocolVersion uint32 = 1
+	// currentProtocolVersion is the protocol version run by this client.
+	currentProtocolVersion = signingChannelsProtocolVersion
+)
+
 var logger = log.Logger("keep-tss")
 
+// ErrNotSigningParticipant is returned from signature calculation when enough
+// other members signalled their readiness to sign and the current member has
+// not been selected to participate in the signing protocol execution.
+var ErrNotSigningParticipant = errors.New(
+	"member has not been selected to participate in signing",
+)
+
 // GenerateThresholdSigner executes a threshold multi-party key generation protocol.
 //
 // It expects unique identifiers of the current member as well as identifiers of
@@ -39,6 +61,10 @@ var logger = log.Logger("keep-tss")
 // execution. The parameters should be generated prior to running this function.
 // If not provided they will be generated.
 //
+// If the group consists of only one member, the key is generated locally
+// without running any network protocol and the pre-parameters box is left
+// untouched.
+//
 // As a result a signer will be returned or an error, if key generation failed.
 func GenerateThresholdSigner(
 	parentCtx context.Context,
@@ -50,9 +76,9 @@ func GenerateThresholdSigner(
 	pubKeyToAddressFn func(cecdsa.PublicKey) []byte,
 	paramsBox *params.Box,
 ) (*ThresholdSigner, error) {
-	if len(groupMemberIDs) < 2 {
+	if len(groupMemberIDs) < 1 {
 		return nil, fmt.Errorf(
-			"group should have at least 2 members but got: [%d]",
+			"group should have at least 1 member but got: [%d]",
 			len(groupMemberIDs),
 		)
 	}
@@ -72,6 +98,20 @@ func GenerateThresholdSigner(
 		dishonestThreshold: int(dishonestThreshold),
 	}
 
+	if group.isSingleSigner() {
+		if !group.memberID.Equal(groupMemberIDs[0]) {
+			return nil, fmt.Errorf("member is not the only group member")
+		}
+
+		signer, err := generateSingleSigner(group)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate single signer key: [%v]", err)
+		}
+		logger.Infof("generated single signer key for group [%s]", groupID)
+
+		return signer, nil
+	}
+
 	netBridge, err := newNetworkBridge(group, networkProvider)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize network bridge: [%v]", err)
@@ -101,15 +141,23 @@ func GenerateThresholdSigner(
 		return nil, err
 	}
 
-	if err := readyProtocol(
+	// Key generation requires all group members to participate, so
+	// the protocol version agreed here is supported by all of them.
+	session, err := readyProtocol(
 		ctx,
 		group,
 		broadcastChannel,
 		pubKeyToAddressFn,
-	); err != nil {
-		return nil, fmt.Errorf("readiness signaling protocol failed: [%v]", err)
+		len(group.groupMemberIDs),
+		nil, // key shares do not exist yet
+	)
+	if err != nil {
+		return nil, readyError{err}
 	}
 
+	netBridge.establishSession(session.sessionID)
+	defer netBridge.completeSession()
+
 	// We are begining the communication with other members using pre-parameters
 	// provided inside of this box. It's time to destroy box content so that the
 	// pre-parameters cannot be later reused.
@@ -119,8 +167,9 @@ func GenerateThresholdSigner(
 
 	signer, err := keyGenSigner.generateKey(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate key: [%v]", err)
+		return nil, err
 	}
+	signer.protocolVersion = session.protocolVersion
 	logger.Infof("[party:%s]: completed key generation", keyGenSigner.keygenParty.PartyID())
 
 	return signer, nil
@@ -129,13 +178,45 @@ func GenerateThresholdSigner(
 // CalculateSignature executes a threshold multi-party signature calculation
 // protocol for the given digest. As a result the calculated ECDSA signature will
 // be returned or an error, if the signature generation failed.
+//
+// Signature is calculated by `t + 1` members who signalled their readiness,
+// where `t` is the dishonest threshold of the group. If the current member has
+// not been selected to participate in signing, ErrNotSigningParticipant is
+// returned.
+//
+// If all group members supported separate signing channels at the key
+// generation, signing of each digest is executed over a separate broadcast
+// channel and session. Unicast channels with peers are shared, but protocol
+// messages of other digests received over them are ignored. Signatures for
+// different digests can be thus calculated with the same signer concurrently.
+// Note that the keep contract accepts only one signing request at a time and
+// reverts the next one with "Signer is busy" until the signature is submitted,
+// so concurrent signing happens only when the previous signing is still being
+// executed by some members after the signature has been already submitted.
+//
+// Otherwise, including signers generated before the protocol version has been
+// recorded, signing is executed over the broadcast channel of the keep, so
+// that members running the legacy client version can take part in it.
+// Signatures are calculated there one at a time.
+//
+// If the group consists of only one member, the signature is calculated locally
+// without running any network protocol.
 func (s *ThresholdSigner) CalculateSignature(
 	parentCtx context.Context,
 	digest []byte,
 	networkProvider net.Provider,
 	pubKeyToAddressFn func(cecdsa.PublicKey) []byte,
 ) (*ecdsa.Signature, error) {
-	netBridge, err := newNetworkBridge(s.groupInfo, networkProvider)
+	if s.isSingleSigner() {
+		return s.calculateSingleSignerSignature(digest)
+	}
+
+	group := s.groupInfo
+	if s.protocolVersion >= signingChannelsProtocolVersion {
+		group = s.sessionGroupInfo(signingGroupSuffix(digest))
+	}
+
+	netBridge, err := newNetworkBridge(group, networkProvider)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize network bridge: [%v]", err)
 	}
@@ -143,9 +224,10 @@ func (s *ThresholdSigner) CalculateSignature(
 	ctx, cancel := context.WithTimeout(parentCtx, SigningProtocolTimeout)
 	defer cancel()
 
-	signingSigner, err := s.initializeSigning(ctx, digest[:], netBridge)
-	if err != nil {
-		return nil, fmt.Errorf("failed to initialize signing: [%v]", err)
+	// Channels have to be initialized before signalling readiness so that
+	// messages from members who start signing earlier are not lost.
+	if err := netBridge.initializeChannels(ctx); err != nil {
+		return nil, fmt.Errorf("failed to initialize channels: [%v]", err)
 	}
 
 	broadcastChannel, err := netBridge.getBroadcastChannel()
@@ -153,19 +235,53 @@ func (s *ThresholdSigner) CalculateSignature(
 		return nil, err
 	}
 
-	if err := readyProtocol(
+	session, err := readyProtocol(
 		ctx,
-		s.groupInfo,
+		group,
 		broadcastChannel,
 		pubKeyToAddressFn,
-	); err != nil {
-		return nil, fmt.Errorf("readiness signaling protocol failed: [%v]", err)
+		s.dishonestThreshold+1,
+		s.KeyEpochFingerprint(),
+	)
+	if err != nil {
+		return nil, readyError{err}
+	}
+
+	isSigningParticipant := false
+	for _, memberID := range session.memberIDs {
+		if memberID.Equal(s.memberID) {
+			isSigningParticipant = true
+			break
+		}
+	}
+	if !isSigningParticipant {
+		return nil, ErrNotSigningParticipant
+	}
+
+	netBridge.establishSession(session.sessionID)
+	defer netBridge.completeSession()
+
+	signingSigner, err := s.initializeSigning(
+		ctx,
+		digest[:],
+		session.memberIDs,
+		netBridge,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize signing: [%v]", err)
 	}
 
 	signature, err := signingSigner.sign(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to sign: [%v]", err)
+		return nil, err
 	}
 
 	return signature, err
 }
+
+// signingGroupSuffix returns the suffix of the group identifier used by the
+// signing protocol for the given digest. It makes the broadcast channel and
+// sessions of signing separate from the ones used for signing other digests.
+func signingGroupSuffix(digest []byte) string {
+	return "-signing-" + hex.EncodeToString(digest)
+}
@@ -49,81 +49,14 @@ func TestGenerateKeyAndSign(t *testing.T) {
 		t.Fatalf("failed to generate members keys: [%v]", err)
 	}
 
-	testData, err := testdata.LoadKeygenTestFixtures(groupSize)
-	if err != nil {
-		t.Fatalf("failed to load test data: [%v]", err)
-	}
-
-	networkProviders := &sync.Map{} // < MemberID, net.Provider >
-
-	// Key generation.
-	signersMutex := sync.Mutex{}
-	signers := make(map[string]*ThresholdSigner)
-
-	keyGenDone := make(chan interface{})
-
-	go func() {
-		var keyGenWait sync.WaitGroup
-		keyGenWait.Add(groupSize)
-
-		var providersInitializedWg sync.WaitGroup
-		providersInitializedWg.Add(groupSize)
-		providersInitialized := make(chan struct{})
-
-		go func() {
-			providersInitializedWg.Wait()
-			close(providersInitialized)
-		}()
-
-		for i, memberID := range groupMemberIDs {
-			go func(memberID MemberID, index int) {
-				memberPublicKey, err := memberID.PublicKey()
-				if err != nil {
-					errChan <- err
-					return
-				}
-
-				networkPublicKey := key.NetworkPublic(*memberPublicKey)
-				network := newTestNetProvider(&networkPublicKey)
-				networkProviders.Store(memberID.String(), network)
-				providersInitializedWg.Done()
-				<-providersInitialized
-
-				preParams := testData[index].LocalPreParams
-
-				signer, err := GenerateThresholdSigner(
-					ctx,
-					groupID,
-					memberID,
-					groupMemberIDs,
-					dishonestThreshold,
-					network,
-					pubKeyToAddressFn,
-					params.NewBox(&preParams),
-				)
-				if err != nil {
-					errChan <- fmt.Errorf("failed to generate signer: [%v]", err)
-				}
-
-				signersMutex.Lock()
-				signers[memberID.String()] = signer
-				signersMutex.Unlock()
-
-				keyGenWait.Done()
-			}(memberID, i)
-		}
-
-		keyGenWait.Wait()
-		close(keyGenDone)
-	}()
-
-	select {
-	case <-keyGenDone:
-	case err := <-errChan:
-		t.Fatalf("unexpected error on key generation: [%v]", err)
-	case <-ctx.Done():
-		t.Fatal(ctx.Err())
-	}
+	signers, networkProviders := generateTestSigners(
+		ctx,
+		t,
+		groupID,
+		groupMemberIDs,
+		dishonestThreshold,
+		pubKeyToAddressFn,
+	)
 
 	firstSigner := signers[groupMemberIDs[0].String()]
 	firstPublicKey := firstSigner.PublicKey()
@@ -142,6 +75,14 @@ func TestGenerateKeyAndSign(t *testing.T) {
 				publicKey,
 			)
 		}
+
+		if signer.protocolVersion != currentProtocolVersion {
+			t.Errorf(
+				"unexpected protocol version\nexpected: [%d]\nactual:   [%d]",
+				currentProtocolVersion,
+				signer.protocolVersion,
+			)
+		}
 	}
 
 	// Signing.
@@ -226,6 +167,302 @@ func TestGenerateKeyAndSign(t *testing.T) {
 	testutils.VerifyEthereumSignature(t, digest[:], firstSignature, firstPublicKey)
 }
 
+func TestGenerateKeyAndSignWithHonestThresholdLowerThanGroupSize(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+	defer cancel()
+
+	groupSize := 3
+	dishonestThreshold := uint(1)
+	signingGroupSize := int(dishonestThreshold) + 1
+	groupID := fmt.Sprintf("tss-test-%d", rand.Int())
+
+	pubKeyToAddressFn := func(publicKey cecdsa.PublicKey) []byte {
+		return elliptic.Marshal(publicKey.Curve, publicKey.X, publicKey.Y)
+	}
+
+	groupMemberIDs, err := generateMemberKeys(groupSize)
+	if err != nil {
+		t.Fatalf("failed to generate members keys: [%v]", err)
+	}
+
+	signers, networkProviders := generateTestSigners(
+		ctx,
+		t,
+		groupID,
+		groupMemberIDs,
+		dishonestThreshold,
+		pubKeyToAddressFn,
+	)
+
+	message := []byte("message to sign")
+	digest := sha256.Sum256(message)
+
+	type signingResult struct {
+		signature *ecdsa.Signature
+		err       error
+	}
+
+	resultsChan := make(chan *signingResult, groupSize)
+
+	for _, signer := range signers {
+		go func(signer *ThresholdSigner) {
+			value, _ := networkProviders.Load(signer.MemberID().String())
+
+			signature, err := signer.CalculateSignature(
+				ctx,
+				digest[:],
+				value.(net.Provider),
+				pubKeyToAddressFn,
+			)
+
+			resultsChan <- &signingResult{signature, err}
+		}(signer)
+	}
+
+	signatures := []*ecdsa.Signature{}
+	notParticipatingCount := 0
+
+	for i := 0; i < groupSize; i++ {
+		select {
+		case result := <-resultsChan:
+			if result.err == ErrNotSigningParticipant {
+				notParticipatingCount++
+				continue
+			}
+			if result.err != nil {
+				t.Fatalf("unexpected error on signing: [%v]", result.err)
+			}
+			signatures = append(signatures, result.signature)
+		case <-ctx.Done():
+			t.Fatal(ctx.Err())
+		}
+	}
+
+	if len(signatures) != signingGroupSize {
+		t.Errorf(
+			"invalid number of signatures\nexpected: %d\nactual:   %d",
+			signingGroupSize,
+			len(signatures),
+		)
+	}
+
+	if notParticipatingCount != groupSize-signingGroupSize {
+		t.Errorf(
+			"invalid number of not participating members\nexpected: %d\nactual:   %d",
+			groupSize-signingGroupSize,
+			notParticipatingCount,
+		)
+	}
+
+	publicKey := signers[groupMemberIDs[0].String()].PublicKey()
+	for _, signature := range signatures {
+		if !cecdsa.Verify(
+			(*cecdsa.PublicKey)(publicKey),
+			digest[:],
+			signature.R,
+			signature.S,
+		) {
+			t.Errorf("invalid signature: [%+v]", signature)
+		}
+
+		testutils.VerifyEthereumSignature(t, digest[:], signature, publicKey)
+	}
+}
+
+func TestSignMultipleDigestsConcurrently(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+	defer cancel()
+
+	groupSize := 3
+	dishonestThreshold := uint(groupSize - 1)
+	groupID := fmt.Sprintf("tss-test-%d", rand.Int())
+
+	pubKeyToAddressFn := func(publicKey cecdsa.PublicKey) []byte {
+		return elliptic.Marshal(publicKey.Curve, publicKey.X, publicKey.Y)
+	}
+
+	groupMemberIDs, err := generateMemberKeys(groupSize)
+	if err != nil {
+		t.Fatalf("failed to generate members keys: [%v]", err)
+	}
+
+	signers, networkProviders := generateTestSigners(
+		ctx,
+		t,
+		groupID,
+		groupMemberIDs,
+		dishonestThreshold,
+		pubKeyToAddressFn,
+	)
+
+	digests := [][32]byte{
+		sha256.Sum256([]byte("first message to sign")),
+		sha256.Sum256([]byte("second message to sign")),
+		sha256.Sum256([]byte("third message to sign")),
+	}
+
+	type signingResult struct {
+		digest    [32]byte
+		signature *ecdsa.Signature
+		err       error
+	}
+
+	resultsChan := make(chan *signingResult, groupSize*len(digests))
+
+	// All members sign all digests at the same time using the same signer.
+	for _, signer := range signers {
+		value, _ := networkProviders.Load(signer.MemberID().String())
+
+		for _, digest := range digests {
+			go func(signer *ThresholdSigner, digest [32]byte) {
+				signature, err := signer.CalculateSignature(
+					ctx,
+					digest[:],
+					value.(net.Provider),
+					pubKeyToAddressFn,
+				)
+
+				resultsChan <- &signingResult{digest, signature, err}
+			}(signer, digest)
+		}
+	}
+
+	signatures := make(map[[32]byte][]*ecdsa.Signature)
+
+	for i := 0; i < groupSize*len(digests); i++ {
+		select {
+		case result := <-resultsChan:
+			if result.err != nil {
+				t.Fatalf("unexpected error on signing: [%v]", result.err)
+			}
+			signatures[result.digest] = append(
+				signatures[result.digest],
+				result.signature,
+			)
+		case <-ctx.Done():
+			t.Fatal(ctx.Err())
+		}
+	}
+
+	publicKey := signers[groupMemberIDs[0].String()].PublicKey()
+	for _, digest := range digests {
+		if len(signatures[digest]) != groupSize {
+			t.Errorf(
+				"invalid number of signatures for digest [%x]\n"+
+					"expected: %d\nactual:   %d",
+				digest,
+				groupSize,
+				len(signatures[digest]),
+			)
+		}
+
+		for _, signature := range signatures[digest] {
+			if !cecdsa.Verify(
+				(*cecdsa.PublicKey)(publicKey),
+				digest[:],
+				signature.R,
+				signature.S,
+			) {
+				t.Errorf(
+					"invalid signature for digest [%x]: [%+v]",
+					digest,
+					signature,
+				)
+			}
+		}
+	}
+}
+
+func generateTestSigners(
+	ctx context.Context,
+	t *testing.T,
+	groupID string,
+	groupMemberIDs []MemberID,
+	dishonestThreshold uint,
+	pubKeyToAddressFn func(cecdsa.PublicKey) []byte,
+) (map[string]*ThresholdSigner, *sync.Map) {
+	groupSize := len(groupMemberIDs)
+	errChan := make(chan error)
+
+	testData, err := testdata.LoadKeygenTestFixtures(groupSize)
+	if err != nil {
+		t.Fatalf("failed to load test data: [%v]", err)
+	}
+
+	networkProviders := &sync.Map{} // < MemberID, net.Provider >
+
+	// Key generation.
+	signersMutex := sync.Mutex{}
+	signers := make(map[string]*ThresholdSigner)
+
+	keyGenDone := make(chan interface{})
+
+	go func() {
+		var keyGenWait sync.WaitGroup
+		keyGenWait.Add(groupSize)
+
+		var providersInitializedWg sync.WaitGroup
+		providersInitializedWg.Add(groupSize)
+		providersInitialized := make(chan struct{})
+
+		go func() {
+			providersInitializedWg.Wait()
+			close(providersInitialized)
+		}()
+
+		for i, memberID := range groupMemberIDs {
+			go func(memberID MemberID, index int) {
+				memberPublicKey, err := memberID.PublicKey()
+				if err != nil {
+					errChan <- err
+					return
+				}
+
+				networkPublicKey := key.NetworkPublic(*memberPublicKey)
+				network := newTestNetProvider(&networkPublicKey)
+				networkProviders.Store(memberID.String(), network)
+				providersInitializedWg.Done()
+				<-providersInitialized
+
+				preParams := testData[index].LocalPreParams
+
+				signer, err := GenerateThresholdSigner(
+					ctx,
+					groupID,
+					memberID,
+					groupMemberIDs,
+					dishonestThreshold,
+					network,
+					pubKeyToAddressFn,
+					params.NewBox(&preParams),
+				)
+				if err != nil {
+					errChan <- fmt.Errorf("failed to generate signer: [%v]", err)
+				}
+
+				signersMutex.Lock()
+				signers[memberID.String()] = signer
+				signersMutex.Unlock()
+
+				keyGenWait.Done()
+			}(memberID, i)
+		}
+
+		keyGenWait.Wait()
+		close(keyGenDone)
+	}()
+
+	select {
+	case <-keyGenDone:
+	case err := <-errChan:
+		t.Fatalf("unexpected error on key generation: [%v]", err)
+	case <-ctx.Done():
+		t.Fatal(ctx.Err())
+	}
+
+	return signers, networkProviders
+}
+
 func generateMemberKeys(groupSize int) ([]MemberID, error) {
 	memberIDs := []MemberID{}
 
@@ -21,6 +21,7 @@ import (
 	"github.com/keep-network/keep-common/pkg/chain/chainutil"
 	"github.com/keep-network/keep-common/pkg/subscription"
 	chain "github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/metrics/collector"
 	"github.com/keep-network/keep-ecdsa/pkg/utils"
 )
 
@@ -53,12 +54,26 @@ const (
 	confirmInitialStateTimeout = 30 * time.Second
 )
 
-// TODO: Resume monitoring after client restart
+// KeepsRegistry provides addresses of keeps the operator is a member of.
+type KeepsRegistry interface {
+	GetKeepsAddresses() []common.Address
+}
+
 // Initialize initializes extension specific to the TBTC application.
-func Initialize(ctx context.Context, chain chain.TBTCHandle) {
+// Monitoring of deposits backed by keeps from the registry is resumed in case
+// it was in progress when the client has been stopped. Attempts and failures
+// of actions performed for deposits are recorded in the metrics collector.
+func Initialize(
+	ctx context.Context,
+	chain chain.TBTCHandle,
+	keepsRegistry KeepsRegistry,
+	metrics *collector.Collector,
+) {
 	logger.Infof("initializing tbtc extension")
 
-	tbtc := newTBTC(chain)
+	tbtc := newTBTC(chain, metrics)
+
+	tbtc.loadMemberDeposits(keepsRegistry.GetKeepsAddresses())
 
 	tbtc.monitorRetrievePubKey(
 		ctx,
@@ -88,15 +103,19 @@ type tbtc struct {
 	memberDepositsCache       *cache.TimeCache 
 	notMemberDepositsCache    *cache.TimeCache
 	signerActionDelayStep     time.Duration
+	memberDeposits            []*chain.DepositCreatedEvent
+	resumedMonitorings        sync.Map
+	metrics                   *collector.Collector
 }
 
-func newTBTC(chain chain.TBTCHandle) *tbtc {
+func newTBTC(chain chain.TBTCHandle, metrics *collector.Collector) *tbtc {
 	return &tbtc{
 		chain:                     chain,
 		blockConfirmations:        defaultBlockConfirmations,
 		memberDepositsCache:       cache.NewTimeCache(monitoringCachePeriod),
 		notMemberDepositsCache:    cache.NewTimeCache(monitoringCachePeriod),
 		signerActionDelayStep:     defaultSignerActionDelayStep,
+		metrics:                   metrics,
 	}
 }
 
@@ -110,6 +129,8 @@ func (t *tbtc) monitorRetrievePubKey(
 	monitoringStartFn := func(
 		handler depositEventHandler,
 	) subscription.EventSubscription {
+		t.resumeMonitoring(initialDepositState, handler)
+
 		return t.chain.OnDepositCreated(handler)
 	}
 
@@ -158,17 +179,31 @@ func (t *tbtc) monitorRetrievePubKey(
 	}
 
 	timeoutFn := func(depositAddress string) (time.Duration, error) {
+		// Monitoring resumed after the client restart has been started before
+		// the restart, when the deposit has been created.
+		timeoutShift, err := t.resumedMonitoringTimeoutShift(
+			depositAddress,
+			initialDepositState,
+			func(event *chain.DepositCreatedEvent) (uint64, error) {
+				return event.BlockNumber, nil
+			},
+		)
+		if err != nil {
+			return 0, err
+		}
+
 		actionDelay, err := t.getSignerActionDelay(depositAddress)
 		if err != nil {
 			return 0, err
 		}
 
-		return timeout + actionDelay, nil
+		return (timeout - timeoutShift) + actionDelay, nil
 	}
 
 	monitoringSubscription := t.monitorAndAct(
 		ctx,
 		"retrieve pubkey",
+		initialDepositState,
 		shouldMonitorFn,
 		monitoringStartFn,
 		monitoringStopFn,
@@ -197,6 +232,8 @@ func (t *tbtc) monitorProvideRedemptionSignature(
 	monitoringStartFn := func(
 		handler depositEventHandler,
 	) subscription.EventSubscription {
+		t.resumeMonitoring(initialDepositState, handler)
+
 		// Start right after a redemption has been requested or the redemption
 		// fee has been increased.
 		return t.chain.OnDepositRedemptionRequested(handler)
@@ -334,17 +371,36 @@ func (t *tbtc) monitorProvideRedemptionSignature(
 	}
 
 	timeoutFn := func(depositAddress string) (time.Duration, error) {
+		// Monitoring resumed after the client restart has been started before
+		// the restart, when the latest redemption request has been made.
+		timeoutShift, err := t.resumedMonitoringTimeoutShift(
+			depositAddress,
+			initialDepositState,
+			func(_ *chain.DepositCreatedEvent) (uint64, error) {
+				event, err := t.latestRedemptionRequestedEvent(depositAddress)
+				if err != nil {
+					return 0, err
+				}
+
+				return event.BlockNumber, nil
+			},
+		)
+		if err != nil {
+			return 0, err
+		}
+
 		actionDelay, err := t.getSignerActionDelay(depositAddress)
 		if err != nil {
 			return 0, err
 		}
 
-		return timeout + actionDelay, nil
+		return (timeout - timeoutShift) + actionDelay, nil
 	}
 
 	monitoringSubscription := t.monitorAndAct(
 		ctx,
 		"provide redemption signature",
+		initialDepositState,
 		shouldMonitorFn,
 		monitoringStartFn,
 		monitoringStopFn,
@@ -373,6 +429,11 @@ func (t *tbtc) monitorProvideRedemptionProof(
 	monitoringStartFn := func(
 		handler depositEventHandler,
 	) subscription.EventSubscription {
+		// The timeout is always counted from the latest redemption request
+		// so the monitoring resumed after the client restart does not need
+		// any special treatment.
+		t.resumeMonitoring(initialDepositState, handler)
+
 		// Start right after a redemption signature has been provided.
 		return t.chain.OnDepositGotRedemptionSignature(handler)
 	}
@@ -552,6 +613,7 @@ func (t *tbtc) monitorProvideRedemptionProof(
 	monitoringSubscription := t.monitorAndAct(
 		ctx,
 		"provide redemption proof",
+		initialDepositState,
 		shouldMonitorFn,
 		monitoringStartFn,
 		monitoringStopFn,
@@ -593,6 +655,7 @@ type timeoutFn func(depositAddress string) (time.Duration, error)
 func (t *tbtc) monitorAndAct(
 	ctx context.Context,
 	monitoringName string,
+	initialDepositState chain.DepositState,
 	shouldMonitorFn shouldMonitorDepositFn,
 	monitoringStartFn watchDepositEventFn,
 	monitoringStopFn watchDepositEventFn,
@@ -601,7 +664,17 @@ func (t *tbtc) monitorAndAct(
 	actBackoffFn backoffFn,
 	timeoutFn timeoutFn,
 ) subscription.EventSubscription {
+	actionMetrics := t.metrics.Action(
+		"tbtc_" + strings.ReplaceAll(monitoringName, " ", "_"),
+	)
+
 	handleStartEvent := func(depositAddress string) {
+		// The monitoring resumed for the deposit is no longer needed once
+		// the monitoring is done or has not been started at all.
+		defer t.resumedMonitorings.Delete(
+			resumedMonitoringKey(depositAddress, initialDepositState),
+		)
+
 		if !shouldMonitorFn(depositAddress) {
 			return
 		}
@@ -699,8 +772,12 @@ func (t *tbtc) monitorAndAct(
 					depositAddress,
 				)
 
+				actionMetrics.Attempted()
+
 				err := actFn(depositAddress)
 				if err != nil {
+					actionMetrics.Failed()
+
 					if actionAttempt == maxActAttempts {
 						logger.Errorf(
 							"could not perform action "+
@@ -966,6 +1043,177 @@ func (t *tbtc) waitKeepNotActiveConfirmation(
 	return !isKeepActive
 }
 
+// loadMemberDeposits finds deposits backed by the given keeps. Monitoring of
+// those deposits is resumed by resumeMonitoring once the monitoring for
+// the deposit's current state is initialized.
+func (t *tbtc) loadMemberDeposits(keepsAddresses []common.Address) {
+	if len(keepsAddresses) == 0 {
+		return
+	}
+
+	keepAddresses := make([]string, len(keepsAddresses))
+	for i, keepAddress := range keepsAddresses {
+		keepAddresses[i] = keepAddress.Hex()
+	}
+
+	// Deposits are created in the same transaction in which their keeps
+	// are opened so the lookup starts from the block in which the earliest
+	// of the keeps has been opened.
+	startBlock, err := t.earliestKeepOpenedBlock(keepsAddresses)
+	if err != nil {
+		logger.Errorf(
+			"could not determine block in which the earliest keep has "+
+				"been opened; deposits monitoring will not be resumed: [%v]",
+			err,
+		)
+		return
+	}
+
+	events, err := t.chain.PastDepositCreatedEvents(startBlock, keepAddresses)
+	if err != nil {
+		logger.Errorf(
+			"could not get past deposit created events; "+
+				"deposits monitoring will not be resumed: [%v]",
+			err,
+		)
+		return
+	}
+
+	logger.Infof(
+		"found [%v] deposits backed by keeps the operator is a member of",
+		len(events),
+	)
+
+	t.memberDeposits = events
+}
+
+// earliestKeepOpenedBlock returns the block in which the earliest of the
+// given keeps has been opened.
+func (t *tbtc) earliestKeepOpenedBlock(
+	keepsAddresses []common.Address,
+) (uint64, error) {
+	var earliestOpenedTimestamp time.Time
+	for i, keepAddress := range keepsAddresses {
+		openedTimestamp, err := t.chain.GetOpenedTimestamp(keepAddress)
+		if err != nil {
+			return 0, fmt.Errorf(
+				"could not get opened timestamp of keep [%v]: [%v]",
+				keepAddress.Hex(),
+				err,
+			)
+		}
+
+		if i == 0 || openedTimestamp.Before(earliestOpenedTimestamp) {
+			earliestOpenedTimestamp = openedTimestamp
+		}
+	}
+
+	return chain.FirstBlockAt(t.chain, earliestOpenedTimestamp)
+}
+
+// resumeMonitoring triggers the monitoring start handler for all member
+// deposits which are in the given initial state of the monitoring. It should
+// be called when the monitoring is being initialized to resume the monitoring
+// which was in progress when the client has been stopped.
+func (t *tbtc) resumeMonitoring(
+	initialDepositState chain.DepositState,
+	handler depositEventHandler,
+) {
+	for _, event := range t.memberDeposits {
+		currentState, err := t.chain.CurrentState(event.DepositAddress)
+		if err != nil {
+			logger.Errorf(
+				"could not get current state of deposit [%v]; "+
+					"monitoring will not be resumed: [%v]",
+				event.DepositAddress,
+				err,
+			)
+			continue
+		}
+
+		if currentState != initialDepositState {
+			continue
+		}
+
+		logger.Infof(
+			"resuming monitoring of deposit [%v] in state [%v]",
+			event.DepositAddress,
+			currentState,
+		)
+
+		t.resumedMonitorings.Store(
+			resumedMonitoringKey(event.DepositAddress, initialDepositState),
+			event,
+		)
+
+		handler(event.DepositAddress)
+	}
+}
+
+// resumedMonitoringTimeoutShift returns the time elapsed since the start of
+// the monitoring resumed for the given deposit and initial state. The block at
+// which the monitoring started is determined by startBlockFn. Zero is returned
+// if the monitoring has not been resumed but started by a chain event.
+func (t *tbtc) resumedMonitoringTimeoutShift(
+	depositAddress string,
+	initialDepositState chain.DepositState,
+	startBlockFn func(event *chain.DepositCreatedEvent) (uint64, error),
+) (time.Duration, error) {
+	event, ok := t.resumedMonitorings.Load(
+		resumedMonitoringKey(depositAddress, initialDepositState),
+	)
+	if !ok {
+		return 0, nil
+	}
+
+	startBlock, err := startBlockFn(event.(*chain.DepositCreatedEvent))
+	if err != nil {
+		return 0, err
+	}
+
+	startTimestamp, err := t.chain.BlockTimestamp(
+		new(big.Int).SetUint64(startBlock),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	now := uint64(time.Now().Unix())
+	if now < startTimestamp {
+		return 0, nil
+	}
+
+	return time.Duration(now-startTimestamp) * time.Second, nil
+}
+
+func (t *tbtc) latestRedemptionRequestedEvent(
+	depositAddress string,
+) (*chain.DepositRedemptionRequestedEvent, error) {
+	redemptionRequestedEvents, err := t.chain.PastDepositRedemptionRequestedEvents(
+		t.pastEventsLookupStartBlock(),
+		depositAddress,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(redemptionRequestedEvents) == 0 {
+		return nil, fmt.Errorf(
+			"no redemption requested events found for deposit: [%v]",
+			depositAddress,
+		)
+	}
+
+	return redemptionRequestedEvents[len(redemptionRequestedEvents)-1], nil
+}
+
+func resumedMonitoringKey(
+	depositAddress string,
+	initialDepositState chain.DepositState,
+) string {
+	return fmt.Sprintf("%v-%v", depositAddress, initialDepositState)
+}
+
 func (t *tbtc) pastEventsLookupStartBlock() uint64 {
 	currentBlock, err := t.chain.BlockCounter().CurrentBlock()
 	if err != nil {
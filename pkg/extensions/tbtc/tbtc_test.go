@@ -14,6 +14,7 @@ import (
 	"github.com/keep-network/keep-common/pkg/subscription"
 
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa"
+	"github.com/keep-network/keep-ecdsa/pkg/metrics/collector"
 	"github.com/keep-network/keep-ecdsa/pkg/utils/byteutils"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -28,7 +29,7 @@ const (
 )
 
 func newTestTBTC(chain chain.TBTCHandle) *tbtc {
-	tbtc := newTBTC(chain)
+	tbtc := newTBTC(chain, collector.NewCollector())
 
 	tbtc.blockConfirmations = defaultLocalBlockConfirmations
 
@@ -468,6 +469,108 @@ func TestRetrievePubkey_OperatorNotInSigningGroup(t *testing.T) {
 	}
 }
 
+func TestRetrievePubkey_MonitoringResumed(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	tbtcChain := local.NewTBTCLocalChain(ctx)
+	tbtc := newTestTBTC(tbtcChain)
+
+	signers := append(
+		[]common.Address{tbtcChain.Address()},
+		local.RandomSigningGroup(2)...,
+	)
+
+	// deposit is created before the monitoring is initialized,
+	// as if the client was restarted
+	tbtcChain.CreateDeposit(depositAddress, signers)
+
+	keepAddress, err := tbtcChain.KeepAddress(depositAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = submitKeepPublicKey(depositAddress, tbtcChain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tbtc.loadMemberDeposits(
+		[]common.Address{common.HexToAddress(keepAddress)},
+	)
+
+	tbtc.monitorRetrievePubKey(
+		ctx,
+		constantBackoff,
+		timeout,
+	)
+
+	// wait a bit longer than the monitoring timeout
+	// to make sure the potential transaction completes
+	time.Sleep(2 * timeout)
+
+	expectedRetrieveSignerPubkeyCalls := 1
+	actualRetrieveSignerPubkeyCalls := tbtcChain.Logger().
+		RetrieveSignerPubkeyCalls()
+	if expectedRetrieveSignerPubkeyCalls != actualRetrieveSignerPubkeyCalls {
+		t.Errorf(
+			"unexpected number of RetrieveSignerPubkey calls\n"+
+				"expected: [%v]\n"+
+				"actual:   [%v]",
+			expectedRetrieveSignerPubkeyCalls,
+			actualRetrieveSignerPubkeyCalls,
+		)
+	}
+}
+
+func TestRetrievePubkey_MonitoringNotResumedForOtherKeeps(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	tbtcChain := local.NewTBTCLocalChain(ctx)
+	tbtc := newTestTBTC(tbtcChain)
+
+	signers := append(
+		[]common.Address{tbtcChain.Address()},
+		local.RandomSigningGroup(2)...,
+	)
+
+	tbtcChain.CreateDeposit(depositAddress, signers)
+
+	_, err := submitKeepPublicKey(depositAddress, tbtcChain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the registry does not contain the deposit's keep
+	otherKeepAddress := local.RandomSigningGroup(1)[0]
+	tbtcChain.OpenKeep(otherKeepAddress, signers)
+	tbtc.loadMemberDeposits([]common.Address{otherKeepAddress})
+
+	tbtc.monitorRetrievePubKey(
+		ctx,
+		constantBackoff,
+		timeout,
+	)
+
+	// wait a bit longer than the monitoring timeout
+	// to make sure the potential transaction completes
+	time.Sleep(2 * timeout)
+
+	expectedRetrieveSignerPubkeyCalls := 0
+	actualRetrieveSignerPubkeyCalls := tbtcChain.Logger().
+		RetrieveSignerPubkeyCalls()
+	if expectedRetrieveSignerPubkeyCalls != actualRetrieveSignerPubkeyCalls {
+		t.Errorf(
+			"unexpected number of RetrieveSignerPubkey calls\n"+
+				"expected: [%v]\n"+
+				"actual:   [%v]",
+			expectedRetrieveSignerPubkeyCalls,
+			actualRetrieveSignerPubkeyCalls,
+		)
+	}
+}
+
 func TestProvideRedemptionSignature_TimeoutElapsed(t *testing.T) {
 	ctx, cancelCtx := context.WithCancel(context.Background())
 	defer cancelCtx()
@@ -1098,6 +1201,91 @@ func TestProvideRedemptionSignature_OperatorNotInSigningGroup(
 	}
 }
 
+func TestProvideRedemptionSignature_MonitoringResumed(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	tbtcChain := local.NewTBTCLocalChain(ctx)
+	tbtc := newTestTBTC(tbtcChain)
+
+	signers := append(
+		[]common.Address{tbtcChain.Address()},
+		local.RandomSigningGroup(2)...,
+	)
+
+	tbtcChain.CreateDeposit(depositAddress, signers)
+
+	keepAddress, err := tbtcChain.KeepAddress(depositAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = submitKeepPublicKey(depositAddress, tbtcChain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// redemption is requested before the monitoring is initialized,
+	// as if the client was restarted
+	err = tbtcChain.RedeemDeposit(depositAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keepSignature, err := submitKeepSignature(depositAddress, tbtcChain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tbtc.loadMemberDeposits(
+		[]common.Address{common.HexToAddress(keepAddress)},
+	)
+
+	tbtc.monitorProvideRedemptionSignature(
+		ctx,
+		constantBackoff,
+		timeout,
+	)
+
+	// wait a bit longer than the monitoring timeout
+	// to make sure the potential transaction completes
+	time.Sleep(2 * timeout)
+
+	expectedProvideRedemptionSignatureCalls := 1
+	actualProvideRedemptionSignatureCalls := tbtcChain.Logger().
+		ProvideRedemptionSignatureCalls()
+	if expectedProvideRedemptionSignatureCalls !=
+		actualProvideRedemptionSignatureCalls {
+		t.Errorf(
+			"unexpected number of ProvideRedemptionSignature calls\n"+
+				"expected: [%v]\n"+
+				"actual:   [%v]",
+			expectedProvideRedemptionSignatureCalls,
+			actualProvideRedemptionSignatureCalls,
+		)
+	}
+
+	depositSignature, err := tbtcChain.DepositRedemptionSignature(
+		depositAddress,
+	)
+	if err != nil {
+		t.Errorf(
+			"unexpected error while fetching deposit signature: [%v]",
+			err,
+		)
+	}
+
+	if !areChainSignaturesEqual(keepSignature, depositSignature) {
+		t.Errorf(
+			"unexpected signature\n"+
+				"expected: [%+v]\n"+
+				"actual:   [%+v]",
+			keepSignature,
+			depositSignature,
+		)
+	}
+}
+
 func TestProvideRedemptionProof_TimeoutElapsed(t *testing.T) {
 	ctx, cancelCtx := context.WithCancel(context.Background())
 	defer cancelCtx()
@@ -1907,6 +2095,7 @@ func TestMonitorAndActDeduplication(t *testing.T) {
 	monitoringSubscription := tbtc.monitorAndAct(
 		ctx,
 		monitoringName,
+		chain.AwaitingSignerSetup,
 		shouldMonitorFn,
 		monitoringStartFn,
 		monitoringStopFn,
@@ -1933,6 +2122,76 @@ func TestMonitorAndActDeduplication(t *testing.T) {
 	}
 }
 
+func TestMonitorAndActDiscardsResumedMonitoring(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	tbtcChain := local.NewTBTCLocalChain(ctx)
+	tbtc := newTestTBTC(tbtcChain)
+
+	initialDepositState := chain.AwaitingSignerSetup
+
+	shouldMonitorFn := func(depositAddress string) bool {
+		return depositAddress == "monitored"
+	}
+
+	monitoringStartFn := func(
+		handler depositEventHandler,
+	) subscription.EventSubscription {
+		for _, depositAddress := range []string{"monitored", "not-monitored"} {
+			tbtc.resumedMonitorings.Store(
+				resumedMonitoringKey(depositAddress, initialDepositState),
+				&chain.DepositCreatedEvent{DepositAddress: depositAddress},
+			)
+
+			handler(depositAddress)
+		}
+
+		return subscription.NewEventSubscription(func() {})
+	}
+
+	monitoringStopFn := func(
+		handler depositEventHandler,
+	) subscription.EventSubscription {
+		return subscription.NewEventSubscription(func() {})
+	}
+
+	keepClosedFn := func(depositAddress string) (chan struct{}, func(), error) {
+		return make(chan struct{}), func() {}, nil
+	}
+
+	actFn := func(depositAddress string) error {
+		return nil
+	}
+
+	timeoutFn := func(depositAddress string) (duration time.Duration, e error) {
+		return timeout, nil
+	}
+
+	monitoringSubscription := tbtc.monitorAndAct(
+		ctx,
+		"monitoring",
+		initialDepositState,
+		shouldMonitorFn,
+		monitoringStartFn,
+		monitoringStopFn,
+		keepClosedFn,
+		actFn,
+		constantBackoff,
+		timeoutFn,
+	)
+	defer monitoringSubscription.Unsubscribe()
+
+	// wait a bit longer than the monitoring timeout
+	// to make sure the monitoring completes
+	time.Sleep(2 * timeout)
+
+	tbtc.resumedMonitorings.Range(func(key, _ interface{}) bool {
+		t.Errorf("resumed monitoring [%v] has not been discarded", key)
+		return true
+	})
+}
+
 func TestAcquireMonitoringLock(t *testing.T) {
 	ctx, cancelCtx := context.WithCancel(context.Background())
 	defer cancelCtx()
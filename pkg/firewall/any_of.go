@@ -0,0 +1,38 @@
+package firewall
+
+import (
+	"crypto/ecdsa"
+
+	coreNet "github.com/keep-network/keep-core/pkg/net"
+)
+
+// AnyOf is a firewall policy letting the remote peer to connect if it
+// satisfies at least one of the provided policies. It is used when the client
+// serves keeps of more than one factory and the remote peer may be a valid
+// member candidate of any of them.
+func AnyOf(policies ...coreNet.Firewall) coreNet.Firewall {
+	return &anyOfPolicy{policies}
+}
+
+type anyOfPolicy struct {
+	policies []coreNet.Firewall
+}
+
+// Validate returns nil if any of the policies validates the remote peer
+// successfully. Otherwise, it returns the error of the first policy.
+func (aop *anyOfPolicy) Validate(remotePeerPublicKey *ecdsa.PublicKey) error {
+	var firstErr error
+
+	for _, policy := range aop.policies {
+		err := policy.Validate(remotePeerPublicKey)
+		if err == nil {
+			return nil
+		}
+
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
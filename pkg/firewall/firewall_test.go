@@ -729,6 +729,45 @@ func TestGetKeepMembersCaching(t *testing.T) {
 	}
 }
 
+// Does not meet the criteria of the first policy but meets the criteria
+// of the second one.
+// Should allow to connect.
+func TestAnyOfOnePolicySatisfied(t *testing.T) {
+	_, remotePeerPublicKey, err := key.GenerateStaticNetworkKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	firstFirewall := newMockCoreFirewall()
+	secondFirewall := newMockCoreFirewall()
+	secondFirewall.updatePeer(remotePeerPublicKey, true)
+
+	policy := AnyOf(firstFirewall, secondFirewall)
+
+	if err := policy.Validate(
+		key.NetworkKeyToECDSAKey(remotePeerPublicKey),
+	); err != nil {
+		t.Fatalf("validation should pass: [%v]", err)
+	}
+}
+
+// Does not meet the criteria of any policy.
+// Should NOT allow to connect.
+func TestAnyOfNoPolicySatisfied(t *testing.T) {
+	_, remotePeerPublicKey, err := key.GenerateStaticNetworkKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	policy := AnyOf(newMockCoreFirewall(), newMockCoreFirewall())
+
+	if err := policy.Validate(
+		key.NetworkKeyToECDSAKey(remotePeerPublicKey),
+	); err == nil {
+		t.Fatal("validation should fail")
+	}
+}
+
 func createNewPolicy(
 	chain eth.Handle,
 	coreFirewall coreNet.Firewall,
@@ -0,0 +1,353 @@
+// Package collector contains in-memory collectors of key generation, signing
+// and extension action metrics. Collectors are updated by the components
+// executing the protocols and exposed by the metrics package.
+package collector
+
+import (
+	"sync"
+	"time"
+)
+
+// FailureCause is the cause of a failed protocol attempt.
+type FailureCause string
+
+// Causes of failed protocol attempts.
+const (
+	// AnnounceTimeout means the signer presence announcement has not
+	// completed on time.
+	AnnounceTimeout FailureCause = "announce_timeout"
+	// ReadyTimeout means the readiness signaling protocol executed before
+	// the TSS protocol has not completed on time.
+	ReadyTimeout FailureCause = "ready_timeout"
+	// TSSError means the TSS protocol itself failed.
+	TSSError FailureCause = "tss_error"
+	// SubmissionError means the protocol result could not be submitted
+	// on-chain.
+	SubmissionError FailureCause = "submission_error"
+)
+
+// FailureCauses lists all causes of failed protocol attempts.
+var FailureCauses = []FailureCause{
+	AnnounceTimeout,
+	ReadyTimeout,
+	TSSError,
+	SubmissionError,
+}
+
+// DurationBuckets are upper bounds in seconds of duration histograms buckets.
+var DurationBuckets = []float64{10, 30, 60, 120, 300, 600, 1800, 3600}
+
+// Histogram counts observed durations in cumulative buckets.
+type Histogram struct {
+	mutex   sync.RWMutex
+	buckets []uint64
+	count   uint64
+	sum     float64
+}
+
+func newHistogram() *Histogram {
+	return &Histogram{
+		buckets: make([]uint64, len(DurationBuckets)),
+	}
+}
+
+// Observe adds the duration to the histogram.
+func (h *Histogram) Observe(duration time.Duration) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	seconds := duration.Seconds()
+	for i, upperBound := range DurationBuckets {
+		if seconds <= upperBound {
+			h.buckets[i]++
+		}
+	}
+
+	h.count++
+	h.sum += seconds
+}
+
+// Bucket returns the number of observations not greater than the upper bound
+// of the bucket with the given index in DurationBuckets.
+func (h *Histogram) Bucket(index int) uint64 {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	return h.buckets[index]
+}
+
+// Count returns the number of all observations.
+func (h *Histogram) Count() uint64 {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	return h.count
+}
+
+// Sum returns the sum of all observed durations in seconds.
+func (h *Histogram) Sum() float64 {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	return h.sum
+}
+
+// Protocol collects metrics of a protocol executed by the client.
+type Protocol struct {
+	mutex     sync.RWMutex
+	attempts  uint64
+	successes uint64
+	failures  map[FailureCause]uint64
+	inFlight  int64
+
+	// Duration is the histogram of durations of protocol executions
+	// completed with success, measured from the start of the first attempt.
+	Duration *Histogram
+}
+
+func newProtocol() *Protocol {
+	return &Protocol{
+		failures: make(map[FailureCause]uint64),
+		Duration: newHistogram(),
+	}
+}
+
+// Started records the protocol execution has been started. Execution may
+// consist of multiple attempts. Finished should be called when the execution
+// ends, no matter if it succeeded or not.
+func (p *Protocol) Started() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.inFlight++
+}
+
+// Attempted records a new attempt of the protocol execution.
+func (p *Protocol) Attempted() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.attempts++
+}
+
+// Failed records a failed attempt of the protocol execution.
+func (p *Protocol) Failed(cause FailureCause) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.failures[cause]++
+}
+
+// Succeeded records the protocol execution started at the given time has
+// completed with success.
+func (p *Protocol) Succeeded(startedAt time.Time) {
+	p.mutex.Lock()
+	p.successes++
+	p.mutex.Unlock()
+
+	p.Duration.Observe(time.Since(startedAt))
+}
+
+// Finished records the protocol execution has ended, no matter if it
+// succeeded or not.
+func (p *Protocol) Finished() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.inFlight--
+}
+
+// Attempts returns the number of all protocol attempts.
+func (p *Protocol) Attempts() uint64 {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.attempts
+}
+
+// Successes returns the number of protocol executions completed with success.
+func (p *Protocol) Successes() uint64 {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.successes
+}
+
+// Failures returns the number of failed protocol attempts with the given
+// cause.
+func (p *Protocol) Failures(cause FailureCause) uint64 {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.failures[cause]
+}
+
+// InFlight returns the number of protocol executions currently in progress.
+func (p *Protocol) InFlight() int64 {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.inFlight
+}
+
+// Action collects metrics of an action executed by an extension or by
+// the client itself.
+type Action struct {
+	mutex    sync.RWMutex
+	attempts uint64
+	failures uint64
+}
+
+// Attempted records the action has been attempted.
+func (a *Action) Attempted() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.attempts++
+}
+
+// Failed records the action attempt has failed.
+func (a *Action) Failed() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.failures++
+}
+
+// Attempts returns the number of all action attempts.
+func (a *Action) Attempts() uint64 {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	return a.attempts
+}
+
+// Failures returns the number of failed action attempts.
+func (a *Action) Failures() uint64 {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	return a.failures
+}
+
+// Authorization collects metrics of signing requests checked against signing
+// authorization policies.
+type Authorization struct {
+	mutex    sync.RWMutex
+	checks   uint64
+	refusals uint64
+	errors   uint64
+}
+
+// Checked records the signing request has been checked.
+func (a *Authorization) Checked() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.checks++
+}
+
+// Refused records the signing request has been refused by a policy.
+func (a *Authorization) Refused() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.refusals++
+}
+
+// Errored records a policy could not decide if the signing request is
+// authorized.
+func (a *Authorization) Errored() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.errors++
+}
+
+// Checks returns the number of checked signing requests.
+func (a *Authorization) Checks() uint64 {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	return a.checks
+}
+
+// Refusals returns the number of refused signing requests.
+func (a *Authorization) Refusals() uint64 {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	return a.refusals
+}
+
+// Errors returns the number of checks in which a policy could not decide.
+func (a *Authorization) Errors() uint64 {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	return a.errors
+}
+
+// Collector groups metrics of all protocols and actions executed by
+// the client.
+type Collector struct {
+	KeyGeneration *Protocol
+	Signing       *Protocol
+
+	// SignatureConfirmation is the histogram of durations from the block
+	// in which the signature has been requested until the signature is
+	// confirmed on-chain.
+	SignatureConfirmation *Histogram
+
+	// PreParamsGeneration collects attempts and failures of TSS
+	// pre-parameters generation.
+	PreParamsGeneration *Action
+
+	// SigningAuthorization collects signing requests checked against
+	// signing authorization policies.
+	SigningAuthorization *Authorization
+
+	actionsMutex sync.Mutex
+	actions      map[string]*Action
+}
+
+// NewCollector creates a new collector with no metrics recorded.
+func NewCollector() *Collector {
+	return &Collector{
+		KeyGeneration:         newProtocol(),
+		Signing:               newProtocol(),
+		SignatureConfirmation: newHistogram(),
+		PreParamsGeneration:   &Action{},
+		SigningAuthorization:  &Authorization{},
+		actions:               make(map[string]*Action),
+	}
+}
+
+// Action returns the collector of the action with the given name. The
+// collector is created if the action has not been recorded before. Actions
+// should be created before the metrics are exposed to be included in them.
+func (c *Collector) Action(name string) *Action {
+	c.actionsMutex.Lock()
+	defer c.actionsMutex.Unlock()
+
+	action, ok := c.actions[name]
+	if !ok {
+		action = &Action{}
+		c.actions[name] = action
+	}
+
+	return action
+}
+
+// Actions returns collectors of all actions by their names.
+func (c *Collector) Actions() map[string]*Action {
+	c.actionsMutex.Lock()
+	defer c.actionsMutex.Unlock()
+
+	actions := make(map[string]*Action, len(c.actions))
+	for name, action := range c.actions {
+		actions[name] = action
+	}
+
+	return actions
+}
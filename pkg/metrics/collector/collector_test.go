@@ -0,0 +1,139 @@
+package collector
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHistogramObserve(t *testing.T) {
+	histogram := newHistogram()
+
+	histogram.Observe(5 * time.Second)
+	histogram.Observe(45 * time.Second)
+	histogram.Observe(2 * time.Hour)
+
+	// Buckets are cumulative so each observation is counted in all buckets
+	// with upper bound not lower than the observed value.
+	expectedBuckets := []uint64{1, 1, 2, 2, 2, 2, 2, 2}
+	for i, expected := range expectedBuckets {
+		if actual := histogram.Bucket(i); actual != expected {
+			t.Errorf(
+				"unexpected value of bucket [%v]\nexpected: [%v]\nactual:   [%v]",
+				DurationBuckets[i],
+				expected,
+				actual,
+			)
+		}
+	}
+
+	if histogram.Count() != 3 {
+		t.Errorf(
+			"unexpected count\nexpected: [%v]\nactual:   [%v]",
+			3,
+			histogram.Count(),
+		)
+	}
+
+	if histogram.Sum() != 7250 {
+		t.Errorf(
+			"unexpected sum\nexpected: [%v]\nactual:   [%v]",
+			7250,
+			histogram.Sum(),
+		)
+	}
+}
+
+func TestProtocolExecution(t *testing.T) {
+	protocol := newProtocol()
+
+	protocol.Started()
+	protocol.Attempted()
+	protocol.Failed(ReadyTimeout)
+
+	if protocol.InFlight() != 1 {
+		t.Errorf(
+			"unexpected executions in flight\nexpected: [%v]\nactual:   [%v]",
+			1,
+			protocol.InFlight(),
+		)
+	}
+
+	protocol.Attempted()
+	protocol.Succeeded(time.Now())
+	protocol.Finished()
+
+	if protocol.Attempts() != 2 {
+		t.Errorf(
+			"unexpected attempts\nexpected: [%v]\nactual:   [%v]",
+			2,
+			protocol.Attempts(),
+		)
+	}
+
+	if protocol.Successes() != 1 {
+		t.Errorf(
+			"unexpected successes\nexpected: [%v]\nactual:   [%v]",
+			1,
+			protocol.Successes(),
+		)
+	}
+
+	if protocol.Failures(ReadyTimeout) != 1 {
+		t.Errorf(
+			"unexpected ready timeout failures\nexpected: [%v]\nactual:   [%v]",
+			1,
+			protocol.Failures(ReadyTimeout),
+		)
+	}
+
+	if protocol.Failures(TSSError) != 0 {
+		t.Errorf(
+			"unexpected tss error failures\nexpected: [%v]\nactual:   [%v]",
+			0,
+			protocol.Failures(TSSError),
+		)
+	}
+
+	if protocol.InFlight() != 0 {
+		t.Errorf(
+			"unexpected executions in flight\nexpected: [%v]\nactual:   [%v]",
+			0,
+			protocol.InFlight(),
+		)
+	}
+
+	if protocol.Duration.Count() != 1 {
+		t.Errorf(
+			"unexpected durations count\nexpected: [%v]\nactual:   [%v]",
+			1,
+			protocol.Duration.Count(),
+		)
+	}
+}
+
+func TestCollectorAction(t *testing.T) {
+	collector := NewCollector()
+
+	collector.Action("tbtc_retrieve_pubkey").Attempted()
+	collector.Action("tbtc_retrieve_pubkey").Failed()
+
+	actions := collector.Actions()
+	if len(actions) != 1 {
+		t.Fatalf(
+			"unexpected number of actions\nexpected: [%v]\nactual:   [%v]",
+			1,
+			len(actions),
+		)
+	}
+
+	action := actions["tbtc_retrieve_pubkey"]
+	if action.Attempts() != 1 || action.Failures() != 1 {
+		t.Errorf(
+			"unexpected action metrics\nexpected: [%v/%v]\nactual:   [%v/%v]",
+			1,
+			1,
+			action.Attempts(),
+			action.Failures(),
+		)
+	}
+}
@@ -2,10 +2,15 @@ package metrics
 
 import (
 	"context"
+	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/ipfs/go-log"
+	"github.com/keep-network/keep-ecdsa/pkg/bond"
 	"github.com/keep-network/keep-ecdsa/pkg/client"
+	"github.com/keep-network/keep-ecdsa/pkg/metrics/collector"
+	"github.com/keep-network/keep-ecdsa/pkg/reliability"
 
 	"github.com/keep-network/keep-common/pkg/metrics"
 )
@@ -19,13 +24,16 @@ const (
 )
 
 // ObserveTSSPreParamsPoolSize triggers an observation process of the
-// tss_pre_params_pool_size metric.
+// tss_pre_params_pool_size, tss_pre_params_generation_attempts_total and
+// tss_pre_params_generation_failures_total metrics.
 func ObserveTSSPreParamsPoolSize(
 	ctx context.Context,
 	registry *metrics.Registry,
 	clientHandle *client.Handle,
 	tick time.Duration,
 ) {
+	tick = validateTick(tick, DefaultClientMetricsTick)
+
 	input := func() float64 {
 		return float64(clientHandle.TSSPreParamsPoolSize())
 	}
@@ -35,10 +43,367 @@ func ObserveTSSPreParamsPoolSize(
 		"tss_pre_params_pool_size",
 		input,
 		registry,
+		tick,
+	)
+
+	generation := clientHandle.MetricsCollector().PreParamsGeneration
+
+	observe(
+		ctx,
+		"tss_pre_params_generation_attempts_total",
+		func() float64 { return float64(generation.Attempts()) },
+		registry,
+		tick,
+	)
+
+	observe(
+		ctx,
+		"tss_pre_params_generation_failures_total",
+		func() float64 { return float64(generation.Failures()) },
+		registry,
+		tick,
+	)
+}
+
+// ObserveRewardsWithdrawals triggers an observation process of the
+// rewards_withdrawals_count and rewards_withdrawn_value_wei metrics.
+func ObserveRewardsWithdrawals(
+	ctx context.Context,
+	registry *metrics.Registry,
+	clientHandle *client.Handle,
+	tick time.Duration,
+) {
+	countInput := func() float64 {
+		return float64(clientHandle.RewardsWithdrawalsCount())
+	}
+
+	observe(
+		ctx,
+		"rewards_withdrawals_count",
+		countInput,
+		registry,
+		validateTick(tick, DefaultClientMetricsTick),
+	)
+
+	valueInput := func() float64 {
+		return toFloat(clientHandle.RewardsWithdrawnValue())
+	}
+
+	observe(
+		ctx,
+		"rewards_withdrawn_value_wei",
+		valueInput,
+		registry,
+		validateTick(tick, DefaultClientMetricsTick),
+	)
+}
+
+// ObserveBondStatus triggers an observation process of the
+// bond_available_unbonded_value_wei, bond_total_bonded_value_wei and
+// bond_alerts_count metrics. The available unbonded value is the lowest one
+// among all monitored applications as it is the first to require attention.
+func ObserveBondStatus(
+	ctx context.Context,
+	registry *metrics.Registry,
+	bondMonitor *bond.Monitor,
+	tick time.Duration,
+) {
+	unbondedValueInput := func() float64 {
+		var lowest *big.Int
+		for _, application := range bondMonitor.Status().Applications {
+			if lowest == nil ||
+				application.AvailableUnbondedValue.Cmp(lowest) < 0 {
+				lowest = application.AvailableUnbondedValue
+			}
+		}
+
+		if lowest == nil {
+			return 0
+		}
+
+		return toFloat(lowest)
+	}
+
+	observe(
+		ctx,
+		"bond_available_unbonded_value_wei",
+		unbondedValueInput,
+		registry,
+		validateTick(tick, DefaultClientMetricsTick),
+	)
+
+	bondedValueInput := func() float64 {
+		return toFloat(bondMonitor.Status().TotalBondedValue)
+	}
+
+	observe(
+		ctx,
+		"bond_total_bonded_value_wei",
+		bondedValueInput,
+		registry,
+		validateTick(tick, DefaultClientMetricsTick),
+	)
+
+	alertsInput := func() float64 {
+		return float64(bondMonitor.Status().AlertsCount())
+	}
+
+	observe(
+		ctx,
+		"bond_alerts_count",
+		alertsInput,
+		registry,
+		validateTick(tick, DefaultClientMetricsTick),
+	)
+}
+
+// ObserveActiveKeeps triggers an observation process of the
+// active_keeps_count metric.
+func ObserveActiveKeeps(
+	ctx context.Context,
+	registry *metrics.Registry,
+	clientHandle *client.Handle,
+	tick time.Duration,
+) {
+	input := func() float64 {
+		return float64(len(clientHandle.KeepsRegistry().GetKeepsAddresses()))
+	}
+
+	observe(
+		ctx,
+		"active_keeps_count",
+		input,
+		registry,
 		validateTick(tick, DefaultClientMetricsTick),
 	)
 }
 
+// ObserveProtocols triggers an observation process of key generation and
+// signing metrics. For each protocol, the number of attempts, successes,
+// failures by cause, executions in flight and the histogram of durations
+// are observed. The histogram of durations from the signature request until
+// its on-chain confirmation is observed as well.
+//
+// Registry supports only gauges with unique names so counters are exposed as
+// gauges with the `_total` suffix and each histogram bucket is exposed as
+// a separate gauge with the bucket upper bound in seconds in its name.
+func ObserveProtocols(
+	ctx context.Context,
+	registry *metrics.Registry,
+	clientHandle *client.Handle,
+	tick time.Duration,
+) {
+	tick = validateTick(tick, DefaultClientMetricsTick)
+	metricsCollector := clientHandle.MetricsCollector()
+
+	observeProtocol(ctx, registry, "keygen", metricsCollector.KeyGeneration, tick)
+	observeProtocol(ctx, registry, "signing", metricsCollector.Signing, tick)
+
+	observeHistogram(
+		ctx,
+		registry,
+		"signature_confirmation_seconds",
+		metricsCollector.SignatureConfirmation,
+		tick,
+	)
+}
+
+func observeProtocol(
+	ctx context.Context,
+	registry *metrics.Registry,
+	name string,
+	protocol *collector.Protocol,
+	tick time.Duration,
+) {
+	observe(
+		ctx,
+		name+"_attempts_total",
+		func() float64 { return float64(protocol.Attempts()) },
+		registry,
+		tick,
+	)
+
+	observe(
+		ctx,
+		name+"_successes_total",
+		func() float64 { return float64(protocol.Successes()) },
+		registry,
+		tick,
+	)
+
+	for _, cause := range collector.FailureCauses {
+		cause := cause
+		observe(
+			ctx,
+			fmt.Sprintf("%s_failures_%s_total", name, cause),
+			func() float64 { return float64(protocol.Failures(cause)) },
+			registry,
+			tick,
+		)
+	}
+
+	observe(
+		ctx,
+		name+"_in_flight",
+		func() float64 { return float64(protocol.InFlight()) },
+		registry,
+		tick,
+	)
+
+	observeHistogram(
+		ctx,
+		registry,
+		name+"_duration_seconds",
+		protocol.Duration,
+		tick,
+	)
+}
+
+func observeHistogram(
+	ctx context.Context,
+	registry *metrics.Registry,
+	name string,
+	histogram *collector.Histogram,
+	tick time.Duration,
+) {
+	for i, upperBound := range collector.DurationBuckets {
+		i := i
+		observe(
+			ctx,
+			fmt.Sprintf("%s_bucket_%v", name, upperBound),
+			func() float64 { return float64(histogram.Bucket(i)) },
+			registry,
+			tick,
+		)
+	}
+
+	observe(
+		ctx,
+		name+"_count",
+		func() float64 { return float64(histogram.Count()) },
+		registry,
+		tick,
+	)
+
+	observe(
+		ctx,
+		name+"_sum",
+		histogram.Sum,
+		registry,
+		tick,
+	)
+}
+
+// ObserveSigningAuthorization triggers an observation process of
+// the signing_authorization_checks_total,
+// signing_authorization_refusals_total and
+// signing_authorization_errors_total metrics.
+func ObserveSigningAuthorization(
+	ctx context.Context,
+	registry *metrics.Registry,
+	clientHandle *client.Handle,
+	tick time.Duration,
+) {
+	tick = validateTick(tick, DefaultClientMetricsTick)
+
+	authorization := clientHandle.MetricsCollector().SigningAuthorization
+
+	observe(
+		ctx,
+		"signing_authorization_checks_total",
+		func() float64 { return float64(authorization.Checks()) },
+		registry,
+		tick,
+	)
+
+	observe(
+		ctx,
+		"signing_authorization_refusals_total",
+		func() float64 { return float64(authorization.Refusals()) },
+		registry,
+		tick,
+	)
+
+	observe(
+		ctx,
+		"signing_authorization_errors_total",
+		func() float64 { return float64(authorization.Errors()) },
+		registry,
+		tick,
+	)
+}
+
+// ObservePeerReliability triggers an observation process of the number of
+// recorded faults of peer members by their kind and the number of peers with
+// at least one recorded fault. Faults are exposed in total, for all peers,
+// as the registry does not support gauges created for peers discovered at
+// runtime. Faults of individual peers are available in diagnostics.
+func ObservePeerReliability(
+	ctx context.Context,
+	registry *metrics.Registry,
+	clientHandle *client.Handle,
+	tick time.Duration,
+) {
+	tick = validateTick(tick, DefaultClientMetricsTick)
+
+	tracker := clientHandle.PeerReliability()
+
+	for _, fault := range reliability.Faults {
+		fault := fault
+		observe(
+			ctx,
+			fmt.Sprintf("peer_faults_%s_total", fault),
+			func() float64 { return float64(tracker.Total(fault)) },
+			registry,
+			tick,
+		)
+	}
+
+	observe(
+		ctx,
+		"peers_with_faults_count",
+		func() float64 { return float64(len(tracker.Peers())) },
+		registry,
+		tick,
+	)
+}
+
+// ObserveExtensionActions triggers an observation process of attempts and
+// failures of actions performed by extensions. Only actions registered
+// in the client's metrics collector before the call are observed.
+func ObserveExtensionActions(
+	ctx context.Context,
+	registry *metrics.Registry,
+	clientHandle *client.Handle,
+	tick time.Duration,
+) {
+	tick = validateTick(tick, DefaultClientMetricsTick)
+
+	for name, action := range clientHandle.MetricsCollector().Actions() {
+		action := action
+		observe(
+			ctx,
+			name+"_attempts_total",
+			func() float64 { return float64(action.Attempts()) },
+			registry,
+			tick,
+		)
+
+		observe(
+			ctx,
+			name+"_failures_total",
+			func() float64 { return float64(action.Failures()) },
+			registry,
+			tick,
+		)
+	}
+}
+
+func toFloat(value *big.Int) float64 {
+	result, _ := new(big.Float).SetInt(value).Float64()
+	return result
+}
+
 func observe(
 	ctx context.Context,
 	name string,
@@ -0,0 +1,167 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-ecdsa/pkg/audit"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa"
+	"github.com/keep-network/keep-ecdsa/pkg/utils"
+)
+
+// recordSignatureCalculated records the signature calculated with the key
+// share of the operator in the audit log. It must succeed before the
+// signature is published, so no signature leaves the node without being
+// recorded.
+func (n *Node) recordSignatureCalculated(
+	keepAddress common.Address,
+	digest [32]byte,
+	requestBlockNumber uint64,
+	protocolStartedAt time.Time,
+	protocolEndedAt time.Time,
+	signature *ecdsa.Signature,
+) error {
+	if n.auditLog == nil {
+		return nil
+	}
+
+	return n.auditLog.RecordSignatureCalculated(
+		keepAddress,
+		digest,
+		&audit.SignatureRecord{
+			RequestBlock:  requestBlockNumber,
+			ProtocolStart: protocolStartedAt.UTC(),
+			ProtocolEnd:   protocolEndedAt.UTC(),
+			R:             signature.R.Text(16),
+			S:             signature.S.Text(16),
+			RecoveryID:    signature.RecoveryID,
+		},
+	)
+}
+
+// recordSignaturePublished records the publication of the signature in the
+// audit log. The publication is read from the signature submitted event, so
+// the record names the transaction and the account which submitted it,
+// whichever member of the keep it was. The signature is already on-chain, so
+// failure of the audit log is only logged.
+func (n *Node) recordSignaturePublished(
+	ctx context.Context,
+	keepAddress common.Address,
+	digest [32]byte,
+	requestBlockNumber uint64,
+) {
+	if n.auditLog == nil {
+		return
+	}
+
+	publication, err := n.signaturePublication(
+		ctx,
+		keepAddress,
+		digest,
+		requestBlockNumber,
+	)
+	if err != nil {
+		logger.Errorf(
+			"failed to find publication of signature of digest [%+x] "+
+				"for keep [%s] to record it in the audit log: [%v]",
+			digest,
+			keepAddress.String(),
+			err,
+		)
+		return
+	}
+
+	if err := n.auditLog.RecordSignaturePublished(
+		keepAddress,
+		digest,
+		publication,
+	); err != nil {
+		logger.Errorf(
+			"failed to record published signature of digest [%+x] "+
+				"for keep [%s] in the audit log: [%v]",
+			digest,
+			keepAddress.String(),
+			err,
+		)
+	}
+}
+
+// signaturePublication finds the latest signature submitted event for the
+// digest emitted since the block in which the signature has been requested
+// and resolves the account which sent its transaction. Chain reads are retried
+// until the context is done.
+func (n *Node) signaturePublication(
+	ctx context.Context,
+	keepAddress common.Address,
+	digest [32]byte,
+	requestBlockNumber uint64,
+) (*audit.PublicationRecord, error) {
+	chainBackoff := utils.NewBackoff(n.retryPolicies.Chain)
+
+	for {
+		publication, err := n.readSignaturePublication(
+			keepAddress,
+			digest,
+			requestBlockNumber,
+		)
+		if err == nil {
+			return publication, nil
+		}
+
+		logger.Warningf(
+			"failed to read publication of signature of digest [%+x] "+
+				"for keep [%s]: [%v]; will retry after backoff",
+			digest,
+			keepAddress.String(),
+			err,
+		)
+
+		if ctxErr := chainBackoff.Wait(ctx); ctxErr != nil {
+			return nil, err
+		}
+	}
+}
+
+func (n *Node) readSignaturePublication(
+	keepAddress common.Address,
+	digest [32]byte,
+	requestBlockNumber uint64,
+) (*audit.PublicationRecord, error) {
+	events, err := n.ethereumChain.PastSignatureSubmittedEvents(
+		keepAddress.Hex(),
+		requestBlockNumber,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"could not get signature submitted events: [%v]",
+			err,
+		)
+	}
+
+	// Events are sorted by block number in ascending order.
+	for i := len(events) - 1; i >= 0; i-- {
+		event := events[i]
+		if event.Digest != digest {
+			continue
+		}
+
+		sender, err := n.ethereumChain.TransactionSender(event.TransactionHash)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not get sender of transaction [%s]: [%v]",
+				event.TransactionHash.String(),
+				err,
+			)
+		}
+
+		return &audit.PublicationRecord{
+			TransactionHash: event.TransactionHash,
+			BlockNumber:     event.BlockNumber,
+			PublishedBy:     sender,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no signature submitted event for the digest")
+}
@@ -0,0 +1,220 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
+	"github.com/keep-network/keep-ecdsa/pkg/reliability"
+)
+
+// RefreshSignerForKeep refreshes key shares of the keep's signer with all
+// other members of the keep. The group public key does not change so nothing
+// is submitted on-chain.
+//
+// The current signer is snapshotted before the protocol starts and the
+// refreshed signer is snapshotted once the protocol completes. The refreshed
+// signer replaces the current one in the registry only after all members
+// confirmed they completed the key refresh and snapshots of the previous
+// signer are deleted then. Otherwise, the current signer is kept and an error
+// is returned.
+//
+// Key refresh is refused while the node calculates a signature for the keep,
+// as signing started with the current key share could not be completed once
+// other members start using their refreshed key shares. If other members hold
+// key shares of a newer epoch, the signer is replaced with its snapshot
+// holding key shares of that epoch and an error is returned, so the key
+// refresh can be retried with the recovered signer.
+func (n *Node) RefreshSignerForKeep(
+	ctx context.Context,
+	keepAddress common.Address,
+	keepsRegistry *registry.Keeps,
+) (*tss.ThresholdSigner, error) {
+	signer, err := keepsRegistry.GetSigner(keepAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(signer.GroupMemberIDs()) == 1 {
+		return nil, fmt.Errorf("key of a single-member keep cannot be refreshed")
+	}
+
+	if n.isSigning(keepAddress) {
+		return nil, fmt.Errorf(
+			"keep [%s] is signing at the moment",
+			keepAddress.String(),
+		)
+	}
+
+	preParamsBox := n.tssParamsPool.get()
+	// Pre-parameters are shared with other members only once all of them are
+	// ready for the key refresh. If the key refresh failed earlier, they are
+	// returned to the pool, so that key refreshes requested by other members
+	// but never executed do not drain the pool.
+	defer n.tssParamsPool.put(preParamsBox)
+
+	// Keep the current signer in the storage no matter what happens during
+	// the key refresh, so that it can be always recovered.
+	if err := keepsRegistry.SnapshotSigner(keepAddress, signer); err != nil {
+		return nil, fmt.Errorf(
+			"could not make snapshot of signer for keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+	}
+
+	refreshedSigner, err := signer.RefreshKey(
+		ctx,
+		n.networkProvider,
+		n.ethereumChain.Signing().PublicKeyToAddress,
+		preParamsBox,
+	)
+	if err != nil {
+		n.recordPeerFaults(keepAddress, err, reliability.KeyGenerationCulprit)
+		n.recoverKeyEpoch(keepAddress, signer, keepsRegistry, err)
+		return nil, fmt.Errorf("failed to refresh key: [%v]", err)
+	}
+
+	// The refreshed key share is persisted before the key refresh is
+	// confirmed to other members. Once other members receive the
+	// confirmation, they may start using their refreshed key shares.
+	if err := keepsRegistry.SnapshotSigner(keepAddress, refreshedSigner); err != nil {
+		return nil, fmt.Errorf(
+			"could not make snapshot of refreshed signer for keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+	}
+
+	if err := refreshedSigner.ConfirmKeyRefresh(
+		ctx,
+		n.networkProvider,
+		n.ethereumChain.Signing().PublicKeyToAddress,
+	); err != nil {
+		return nil, fmt.Errorf(
+			"key refresh has not been confirmed by all members: [%v]",
+			err,
+		)
+	}
+
+	if err := keepsRegistry.ReplaceSigner(keepAddress, refreshedSigner); err != nil {
+		return nil, fmt.Errorf(
+			"could not replace signer for keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+	}
+
+	// The previous key share must not outlive the key refresh, otherwise
+	// leaking the storage would still leak it.
+	if err := keepsRegistry.DeleteSignerSnapshots(keepAddress, signer); err != nil {
+		logger.Warningf(
+			"could not delete previous signer snapshots for keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+	}
+
+	return refreshedSigner, nil
+}
+
+// OnKeyRefreshRequested registers a handler called when another member of
+// the keep requests a key refresh. The handler is unregistered when the
+// context is done.
+func (n *Node) OnKeyRefreshRequested(
+	ctx context.Context,
+	signer *tss.ThresholdSigner,
+	handler func(),
+) error {
+	return signer.OnKeyRefreshRequested(
+		ctx,
+		n.networkProvider,
+		func(tss.MemberID) {
+			handler()
+		},
+	)
+}
+
+// recoverKeyEpoch replaces the signer of the keep with its snapshot holding
+// key shares of the epoch used by other members, if the error returned from
+// the failed protocol execution reports members holding key shares of another
+// epoch. It returns true if the signer has been replaced.
+//
+// Only snapshots of a newer epoch than the current signer are considered.
+// Members start using refreshed key shares only once all members confirmed
+// they persisted them, so a member who has not received all confirmations
+// always holds the snapshot of the refreshed signer used by other members.
+func (n *Node) recoverKeyEpoch(
+	keepAddress common.Address,
+	signer *tss.ThresholdSigner,
+	keepsRegistry *registry.Keeps,
+	err error,
+) bool {
+	fingerprints := tss.PeerKeyEpochFingerprints(err)
+	if len(fingerprints) == 0 {
+		return false
+	}
+
+	publicKey := signer.PublicKey()
+
+	var recoveredSigner *tss.ThresholdSigner
+	for _, snapshot := range keepsRegistry.GetSignerSnapshots(keepAddress) {
+		if snapshot.KeyEpoch() <= signer.KeyEpoch() ||
+			!snapshot.MemberID().Equal(signer.MemberID()) ||
+			snapshot.PublicKey().X.Cmp(publicKey.X) != 0 ||
+			snapshot.PublicKey().Y.Cmp(publicKey.Y) != 0 {
+			continue
+		}
+
+		if recoveredSigner != nil &&
+			recoveredSigner.KeyEpoch() >= snapshot.KeyEpoch() {
+			continue
+		}
+
+		for _, fingerprint := range fingerprints {
+			if bytes.Equal(fingerprint, snapshot.KeyEpochFingerprint()) {
+				recoveredSigner = snapshot
+				break
+			}
+		}
+	}
+
+	if recoveredSigner == nil {
+		logger.Warningf(
+			"other members of keep [%s] hold key shares of another epoch; "+
+				"no snapshot of a newer epoch than [%d] found",
+			keepAddress.String(),
+			signer.KeyEpoch(),
+		)
+		return false
+	}
+
+	if err := keepsRegistry.ReplaceSigner(keepAddress, recoveredSigner); err != nil {
+		logger.Errorf(
+			"could not replace signer for keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+		return false
+	}
+
+	logger.Infof(
+		"recovered key shares of epoch [%d] used by other members of keep [%s]",
+		recoveredSigner.KeyEpoch(),
+		keepAddress.String(),
+	)
+
+	if err := keepsRegistry.DeleteSignerSnapshots(keepAddress, signer); err != nil {
+		logger.Warningf(
+			"could not delete previous signer snapshots for keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+	}
+
+	return true
+}
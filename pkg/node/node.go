@@ -6,6 +6,8 @@ import (
 	cecdsa "crypto/ecdsa"
 	"encoding/hex"
 	"fmt"
+	"math/big"
+	"sync"
 	"time"
 
 	"github.com/keep-network/keep-common/pkg/chain/chainutil"
@@ -19,19 +21,19 @@ import (
 	"github.com/ipfs/go-log"
 
 	"github.com/keep-network/keep-core/pkg/net"
+	"github.com/keep-network/keep-ecdsa/pkg/audit"
 	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss/params"
+	"github.com/keep-network/keep-ecdsa/pkg/metrics/collector"
+	"github.com/keep-network/keep-ecdsa/pkg/reliability"
+	"github.com/keep-network/keep-ecdsa/pkg/utils"
 )
 
 var logger = log.Logger("keep-ecdsa")
 
 const (
-	// Determines the delay which should be preserved before retrying
-	// actions within the key generation and signing process.
-	retryDelay = 1 * time.Second
-
 	// Number of blocks which should elapse before confirming
 	// the given chain state expectations.
 	blockConfirmations = uint64(12)
@@ -49,20 +51,60 @@ type Node struct {
 	networkProvider net.Provider
 	tssParamsPool   *tssPreParamsPool
 	tssConfig       *tss.Config
+	metrics         *collector.Collector
+	auditLog        *audit.Log
+	peerReliability *reliability.Tracker
+	retryPolicies   *RetryPolicies
+
+	// Number of signatures being calculated by the node by keep address.
+	signingKeepsMutex *sync.Mutex
+	signingKeeps      map[common.Address]int
+}
+
+// RetryPolicies defines backoffs between retries of failed operations
+// executed by the node. Each execution of an operation backs off
+// independently, starting from the initial backoff of the policy.
+type RetryPolicies struct {
+	// Protocol is the backoff between retries of failed protocol executions,
+	// such as key generation, signing or key resharing, and of failed TSS
+	// pre-parameters generations.
+	Protocol utils.BackoffPolicy
+	// Chain is the backoff between retries of failed chain calls.
+	Chain utils.BackoffPolicy
+	// Submission is the backoff between retries of failed on-chain
+	// submissions of a calculated signature.
+	Submission utils.BackoffPolicy
 }
 
 // NewNode initializes node struct with provided ethereum chain interface and
 // network provider. It also initializes TSS Pre-Parameters pool. But does not
-// start parameters generation. This should be called separately.
+// start parameters generation. This should be called separately. Metrics of
+// key generations and signings executed by the node are recorded in the
+// provided collector. Signatures calculated by the node are recorded in the
+// provided audit log, if it is not nil. Faults of peer members causing
+// protocol failures are recorded in the provided reliability tracker, if it
+// is not nil. Failed operations are retried according to the provided retry
+// policies.
 func NewNode(
 	ethereumChain eth.Handle,
 	networkProvider net.Provider,
 	tssConfig *tss.Config,
+	metrics *collector.Collector,
+	auditLog *audit.Log,
+	peerReliability *reliability.Tracker,
+	retryPolicies *RetryPolicies,
 ) *Node {
 	return &Node{
 		ethereumChain:   ethereumChain,
 		networkProvider: networkProvider,
 		tssConfig:       tssConfig,
+		metrics:         metrics,
+		auditLog:        auditLog,
+		peerReliability: peerReliability,
+		retryPolicies:   retryPolicies,
+
+		signingKeepsMutex: &sync.Mutex{},
+		signingKeeps:      make(map[common.Address]int),
 	}
 }
 
@@ -133,19 +175,39 @@ func createAddressFilter(
 }
 
 // GenerateSignerForKeep generates a new threshold signer with ECDSA key pair
-// and submits the public key to the on-chain keep.
+// and submits the public key to the on-chain keep. Honest threshold determines
+// the number of keep members required to calculate a signature.
 //
 // The attempt for generating signer is retried on failure until the provided
-// context is done.
+// context is done. Members proven by tss-lib to send invalid messages are
+// logged and recorded as unreliable.
 func (n *Node) GenerateSignerForKeep(
 	ctx context.Context,
 	operatorPublicKey *operator.PublicKey,
 	keepAddress common.Address,
 	members []common.Address,
+	honestThreshold uint64,
 	keepsRegistry *registry.Keeps,
 ) (*tss.ThresholdSigner, error) {
 	memberID := tss.MemberIDFromPublicKey(operatorPublicKey)
-	preParamsBox := params.NewBox(n.tssParamsPool.get())
+
+	startedAt := time.Now()
+	n.metrics.KeyGeneration.Started()
+	defer n.metrics.KeyGeneration.Finished()
+	protocolFinished := n.notifyProtocolStarted()
+	defer protocolFinished()
+
+	// A keep with only one member does not need any pre-parameters nor
+	// communication with other members; the key is generated locally.
+	isSingleSigner := len(members) == 1
+
+	preParamsBox := params.NewBox(nil)
+	if !isSingleSigner {
+		preParamsBox = n.tssParamsPool.get()
+	}
+
+	chainBackoff := utils.NewBackoff(n.retryPolicies.Chain)
+	protocolBackoff := utils.NewBackoff(n.retryPolicies.Protocol)
 
 	attemptCounter := 0
 	for {
@@ -164,7 +226,9 @@ func (n *Node) GenerateSignerForKeep(
 				keepAddress.String(),
 				err,
 			)
-			time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+			if err := chainBackoff.Wait(ctx); err != nil {
+				return nil, fmt.Errorf("key generation timeout exceeded")
+			}
 			continue
 		}
 
@@ -177,8 +241,8 @@ func (n *Node) GenerateSignerForKeep(
 		// If we are re-attempting the key generation, pre-parameters in the box
 		// could be destroyed because they were shared with other members.
 		// In this case, we need to re-generate them.
-		if preParamsBox.IsEmpty() {
-			preParamsBox = params.NewBox(n.tssParamsPool.get())
+		if preParamsBox.IsEmpty() && !isSingleSigner {
+			preParamsBox = n.tssParamsPool.get()
 		}
 
 		// Global timeout for generating a signer exceeded.
@@ -187,26 +251,44 @@ func (n *Node) GenerateSignerForKeep(
 			return nil, fmt.Errorf("key generation timeout exceeded")
 		}
 
+		n.metrics.KeyGeneration.Attempted()
+
 		// Announce signer presence. Other members of the keep need to receive
 		// the public key of this members. This member, need to receive public
 		// keys of all other members. Up to this point, only addresses from
 		// signer selection protocol are known.
 		//
 		// If signer announcement fails, we retry from the beginning.
-		memberIDs, err := n.AnnounceSignerPresence(
-			ctx,
-			operatorPublicKey,
-			keepAddress,
-			members,
-		)
-		if err != nil {
-			logger.Warningf("failed to announce signer presence: [%v]", err)
-			time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
-			continue
+		//
+		// The only member of a single-member keep has no one to announce
+		// its presence to.
+		memberIDs := []tss.MemberID{memberID}
+		if !isSingleSigner {
+			memberIDs, err = n.AnnounceSignerPresence(
+				ctx,
+				operatorPublicKey,
+				keepAddress,
+				members,
+			)
+			if err != nil {
+				logger.Warningf("failed to announce signer presence: [%v]", err)
+				n.metrics.KeyGeneration.Failed(collector.AnnounceTimeout)
+				n.recordPeerFaults(
+					keepAddress,
+					err,
+					reliability.KeyGenerationCulprit,
+				)
+				if err := protocolBackoff.Wait(ctx); err != nil {
+					return nil, fmt.Errorf("key generation timeout exceeded")
+				}
+				continue
+			}
 		}
 
 		// Generate threshold signer by generating threshold key with all other
-		// keep members.
+		// keep members. Any subset of honest threshold members can later
+		// calculate a signature, so the dishonest threshold is one less than
+		// the honest threshold.
 		//
 		// If threshold key generation fails, we retry from the beginning.
 		signer, err := tss.GenerateThresholdSigner(
@@ -214,14 +296,18 @@ func (n *Node) GenerateSignerForKeep(
 			keepAddress.Hex(),
 			memberID,
 			memberIDs,
-			uint(len(memberIDs)-1),
+			uint(honestThreshold-1),
 			n.networkProvider,
 			n.ethereumChain.Signing().PublicKeyToAddress,
 			preParamsBox,
 		)
 		if err != nil {
 			logger.Errorf("failed to generate threshold signer: [%v]", err)
-			time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+			n.metrics.KeyGeneration.Failed(protocolFailureCause(err))
+			n.recordPeerFaults(keepAddress, err, reliability.KeyGenerationCulprit)
+			if err := protocolBackoff.Wait(ctx); err != nil {
+				return nil, fmt.Errorf("key generation timeout exceeded")
+			}
 			continue
 		}
 
@@ -251,26 +337,46 @@ func (n *Node) GenerateSignerForKeep(
 
 		err = n.ethereumChain.SubmitKeepPublicKey(keepAddress, publicKey)
 		if err != nil {
+			n.metrics.KeyGeneration.Failed(collector.SubmissionError)
 			return nil, fmt.Errorf("failed to submit public key: [%v]", err)
 		}
 
+		n.metrics.KeyGeneration.Succeeded(startedAt)
+
 		go n.monitorKeepPublicKeySubmission(keepAddress, publicKey)
 
 		return signer, nil // key generation succeeded.
 	}
 }
 
-// CalculateSignature calculates a signature over a digest with threshold
-// signer and publishes the result to the keep associated with the signer.
+// CalculateSignature calculates a signature over a digest with the threshold
+// signer of the keep and publishes the result to the keep. Request block
+// number is the block in which the signature has been requested.
+// The calculated signature and its publication are recorded in the audit log.
 //
 // The attempt for generating and publishing signature is retried on failure
-// until the provided context is done.
+// until the provided context is done. The signer is taken from the registry
+// for each attempt, so that the signer holding the most recently refreshed
+// key share is used. If other members hold key shares of a newer epoch,
+// the signer is replaced with its snapshot holding key shares of that epoch
+// before the next attempt.
 func (n *Node) CalculateSignature(
 	ctx context.Context,
-	signer *tss.ThresholdSigner,
+	keepAddress common.Address,
+	keepsRegistry *registry.Keeps,
 	digest [32]byte,
+	requestBlockNumber uint64,
 ) error {
-	keepAddress := common.HexToAddress(signer.GroupID())
+	signingFinished := n.notifySigningStarted(keepAddress)
+	defer signingFinished()
+
+	startedAt := time.Now()
+	n.metrics.Signing.Started()
+	defer n.metrics.Signing.Finished()
+	protocolFinished := n.notifyProtocolStarted()
+	defer protocolFinished()
+
+	protocolBackoff := utils.NewBackoff(n.retryPolicies.Protocol)
 
 	attemptCounter := 0
 	for {
@@ -288,6 +394,15 @@ func (n *Node) CalculateSignature(
 			return fmt.Errorf("signing timeout exceeded")
 		}
 
+		signer, err := keepsRegistry.GetSigner(keepAddress)
+		if err != nil {
+			return err
+		}
+
+		n.metrics.Signing.Attempted()
+
+		protocolStartedAt := time.Now()
+
 		// Calculate the signature executing threshold signing protocol with
 		// other keep members.
 		//
@@ -298,13 +413,41 @@ func (n *Node) CalculateSignature(
 			n.networkProvider,
 			n.ethereumChain.Signing().PublicKeyToAddress,
 		)
+		if err == tss.ErrNotSigningParticipant {
+			// Enough other members are ready to calculate the signature
+			// without this member. We wait for the signature to appear
+			// on-chain and retry from the beginning if it does not.
+			logger.Infof(
+				"member has not been selected to calculate signature "+
+					"for keep [%s]; waiting for other members to publish it",
+				keepAddress.String(),
+			)
+			if n.waitForSignature(ctx, keepAddress, digest) &&
+				n.confirmSignature(keepAddress, digest) {
+				n.metrics.Signing.Succeeded(startedAt)
+				return nil
+			}
+			if err := protocolBackoff.Wait(ctx); err != nil {
+				return fmt.Errorf("signing timeout exceeded")
+			}
+			continue
+		}
 		if err != nil {
 			logger.Errorf(
 				"failed to calculate signature for keep [%s]: [%v]",
 				keepAddress.String(),
 				err,
 			)
-			time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+			n.metrics.Signing.Failed(protocolFailureCause(err))
+			n.recordPeerFaults(keepAddress, err, reliability.SigningCulprit)
+			if n.recoverKeyEpoch(keepAddress, signer, keepsRegistry, err) {
+				// Other members may be still waiting for this member, so
+				// the signing is retried immediately.
+				continue
+			}
+			if err := protocolBackoff.Wait(ctx); err != nil {
+				return fmt.Errorf("signing timeout exceeded")
+			}
 			continue
 		}
 
@@ -315,11 +458,95 @@ func (n *Node) CalculateSignature(
 			signature.RecoveryID,
 		)
 
+		// The signature is never published without being recorded in the
+		// audit log first. If it could not be recorded, this member gives
+		// up the signing and leaves the publication to other members.
+		if err := n.recordSignatureCalculated(
+			keepAddress,
+			digest,
+			requestBlockNumber,
+			protocolStartedAt,
+			time.Now(),
+			signature,
+		); err != nil {
+			return fmt.Errorf(
+				"failed to record calculated signature in the audit log; "+
+					"signature will not be published: [%v]",
+				err,
+			)
+		}
+
 		// We have the signature so now we need to publish it.
 		// This function implements internal retries so we do not need to
 		// retry here.
-		return n.publishSignature(ctx, keepAddress, digest, signature)
+		if err := n.publishSignature(ctx, keepAddress, digest, signature); err != nil {
+			return err
+		}
+
+		n.recordSignaturePublished(ctx, keepAddress, digest, requestBlockNumber)
+
+		n.metrics.Signing.Succeeded(startedAt)
+
+		return nil
+	}
+}
+
+// notifySigningStarted records that the node calculates a signature for
+// the keep. The returned function should be called once the signing ends.
+func (n *Node) notifySigningStarted(keepAddress common.Address) func() {
+	n.signingKeepsMutex.Lock()
+	defer n.signingKeepsMutex.Unlock()
+
+	n.signingKeeps[keepAddress]++
+
+	return func() {
+		n.signingKeepsMutex.Lock()
+		defer n.signingKeepsMutex.Unlock()
+
+		n.signingKeeps[keepAddress]--
+		if n.signingKeeps[keepAddress] == 0 {
+			delete(n.signingKeeps, keepAddress)
+		}
+	}
+}
+
+// isSigning returns true if the node calculates a signature for the keep.
+func (n *Node) isSigning(keepAddress common.Address) bool {
+	n.signingKeepsMutex.Lock()
+	defer n.signingKeepsMutex.Unlock()
+
+	return n.signingKeeps[keepAddress] > 0
+}
+
+// ObserveSignatureConfirmation records the time elapsed from the block in
+// which the signature has been requested until now, when the signature has
+// been confirmed on-chain.
+func (n *Node) ObserveSignatureConfirmation(requestBlockNumber uint64) {
+	requestTimestamp, err := n.ethereumChain.BlockTimestamp(
+		new(big.Int).SetUint64(requestBlockNumber),
+	)
+	if err != nil {
+		logger.Warningf(
+			"could not get timestamp of block [%v]: [%v]",
+			requestBlockNumber,
+			err,
+		)
+		return
+	}
+
+	n.metrics.SignatureConfirmation.Observe(
+		time.Since(time.Unix(int64(requestTimestamp), 0)),
+	)
+}
+
+// protocolFailureCause determines the cause of the failed key generation or
+// signing attempt based on the returned error.
+func protocolFailureCause(err error) collector.FailureCause {
+	if tss.IsReadyError(err) {
+		return collector.ReadyTimeout
 	}
+
+	return collector.TSSError
 }
 
 // publishSignature takes the provided signature and attempts to publish it to
@@ -338,7 +565,12 @@ func (n *Node) publishSignature(
 	digest [32]byte,
 	signature *ecdsa.Signature,
 ) error {
-	n.waitSignaturePublicationDelay(keepAddress)
+	if err := n.waitSignaturePublicationDelay(ctx, keepAddress); err != nil {
+		return fmt.Errorf("context timeout exceeded")
+	}
+
+	chainBackoff := utils.NewBackoff(n.retryPolicies.Chain)
+	submissionBackoff := utils.NewBackoff(n.retryPolicies.Submission)
 
 	attemptCounter := 0
 	for {
@@ -361,7 +593,9 @@ func (n *Node) publishSignature(
 				keepAddress.String(),
 				err,
 			)
-			time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+			if err := chainBackoff.Wait(ctx); err != nil {
+				return fmt.Errorf("context timeout exceeded")
+			}
 			continue
 		}
 		if !isActive {
@@ -382,7 +616,9 @@ func (n *Node) publishSignature(
 				keepAddress.String(),
 				err,
 			)
-			time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+			if err := chainBackoff.Wait(ctx); err != nil {
+				return fmt.Errorf("context timeout exceeded")
+			}
 			continue
 		}
 
@@ -407,7 +643,9 @@ func (n *Node) publishSignature(
 					keepAddress.String(),
 					err,
 				)
-				time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+				if err := chainBackoff.Wait(ctx); err != nil {
+					return fmt.Errorf("context timeout exceeded")
+				}
 				continue
 			}
 
@@ -421,19 +659,24 @@ func (n *Node) publishSignature(
 			}
 
 			// Our public key submission transaction failed. We are going to
-			// wait for some time and then retry from the beginning.
+			// back off and then retry from the beginning.
+			n.metrics.Signing.Failed(collector.SubmissionError)
 			logger.Errorf(
 				"failed to submit signature for keep [%s]: [%v]; "+
-					"will retry after 1 minute",
+					"will retry after backoff",
 				keepAddress.String(),
 				submissionErr,
 			)
-			time.Sleep(1 * time.Minute)
+			if err := submissionBackoff.Wait(ctx); err != nil {
+				return fmt.Errorf("context timeout exceeded")
+			}
 			continue
 		}
 
-		if !(n.waitForSignature(keepAddress, digest) && n.confirmSignature(keepAddress, digest)) {
-			time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+		if !(n.waitForSignature(ctx, keepAddress, digest) && n.confirmSignature(keepAddress, digest)) {
+			if err := chainBackoff.Wait(ctx); err != nil {
+				return fmt.Errorf("context timeout exceeded")
+			}
 			continue
 		}
 
@@ -443,10 +686,12 @@ func (n *Node) publishSignature(
 
 // waitSignaturePublicationDelay waits a certain amount of time appropriately
 // for the given signer index to avoid all signers publishing the same signature
-// for given keep at the same time.
+// for given keep at the same time. It returns an error if the context is done
+// before the delay elapses.
 func (n *Node) waitSignaturePublicationDelay(
+	ctx context.Context,
 	keepAddress common.Address,
-) {
+) error {
 	signerIndex, err := n.getSignerIndex(keepAddress)
 	if err != nil {
 		logger.Errorf(
@@ -455,7 +700,7 @@ func (n *Node) waitSignaturePublicationDelay(
 			keepAddress.String(),
 			err,
 		)
-		return
+		return nil
 	}
 
 	// just in case this function is not invoked in the right context
@@ -466,7 +711,7 @@ func (n *Node) waitSignaturePublicationDelay(
 				"will not be delayed",
 			keepAddress.String(),
 		)
-		return
+		return nil
 	}
 
 	delay := time.Duration(signerIndex) * signaturePublicationDelayStep
@@ -477,7 +722,12 @@ func (n *Node) waitSignaturePublicationDelay(
 		keepAddress.String(),
 	)
 
-	time.Sleep(delay)
+	select {
+	case <-time.After(delay):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 func (n *Node) getSignerIndex(keepAddress common.Address) (int, error) {
@@ -496,13 +746,14 @@ func (n *Node) getSignerIndex(keepAddress common.Address) (int, error) {
 }
 
 func (n *Node) waitForSignature(
+	parentCtx context.Context,
 	keepAddress common.Address,
 	digest [32]byte,
 ) bool {
 	const waitTimeout = 10 * time.Minute
 	const checkTick = 1 * time.Minute
 
-	ctx, cancelCtx := context.WithTimeout(context.Background(), waitTimeout)
+	ctx, cancelCtx := context.WithTimeout(parentCtx, waitTimeout)
 	defer cancelCtx()
 
 	checkTicker := time.NewTicker(checkTick)
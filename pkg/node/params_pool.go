@@ -1,36 +1,130 @@
 package node
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/binance-chain/tss-lib/ecdsa/keygen"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss/params"
+	"github.com/keep-network/keep-ecdsa/pkg/metrics/collector"
+	"github.com/keep-network/keep-ecdsa/pkg/utils"
 )
 
+// PreParamsStorage persists TSS pre-parameters of the pool, so they survive
+// the client restart.
+type PreParamsStorage interface {
+	SavePreParams(id string, content []byte) error
+	ReadPreParams() (map[string][]byte, error)
+	DeletePreParams(id string) error
+}
+
 // tssPreParamsPool is a pool holding TSS pre parameters. It autogenerates entries
 // up to the pool size. When an entry is pulled from the pool it will generate
 // new entry.
+//
+// Entries are generated by multiple workers at the same time. Each worker
+// takes a free slot of the pool before it starts generating an entry, so no
+// more entries are generated than missing in the pool. In the low priority
+// mode, workers do not start generating new entries while protocols are
+// executed by the node, unless the pool is empty and the protocol may be
+// waiting for an entry.
+//
+// If the pool has a storage, each generated entry is persisted and it is
+// deleted from the storage once the content of the box holding it is destroyed,
+// so pre-parameters shared with other clients are never reused.
 type tssPreParamsPool struct {
-	pool chan *keygen.LocalPreParams
-	new  func() (*keygen.LocalPreParams, error)
+	pool    chan *tssPreParamsEntry
+	slots   chan struct{}
+	new     func() (*keygen.LocalPreParams, error)
+	storage PreParamsStorage
+	metrics *collector.Action
+
+	retryPolicy utils.BackoffPolicy
+
+	lowPriority      bool
+	protocolsMutex   sync.Mutex
+	protocolsIdle    *sync.Cond
+	runningProtocols int
+
+	// Entries pulled from the pool whose box content has not been destroyed
+	// yet, so they can be returned to the pool.
+	lentEntriesMutex sync.Mutex
+	lentEntries      map[*params.Box]*tssPreParamsEntry
+}
+
+// tssPreParamsEntry holds pre-parameters together with the identifier under
+// which they are persisted. The identifier is empty if the pre-parameters
+// have not been persisted.
+type tssPreParamsEntry struct {
+	id     string
+	params *keygen.LocalPreParams
 }
 
-// InitializeTSSPreParamsPool generates TSS pre-parameters and stores them in a pool.
-func (n *Node) InitializeTSSPreParamsPool() {
+// InitializeTSSPreParamsPool loads TSS pre-parameters persisted in the storage
+// and generates new ones up to the target pool size.
+func (n *Node) InitializeTSSPreParamsPool(storage PreParamsStorage) {
 	poolSize := n.tssConfig.GetPreParamsTargetPoolSize()
+	concurrency := n.tssConfig.GetPreParamsGenerationConcurrency()
 
-	logger.Infof("TSS pre-parameters target pool size is [%v]", poolSize)
+	logger.Infof(
+		"TSS pre-parameters target pool size is [%v], "+
+			"generation concurrency is [%v]",
+		poolSize,
+		concurrency,
+	)
 
-	n.tssParamsPool = &tssPreParamsPool{
-		pool: make(chan *keygen.LocalPreParams, poolSize),
-		new: func() (*keygen.LocalPreParams, error) {
+	n.tssParamsPool = newTSSPreParamsPool(
+		poolSize,
+		func() (*keygen.LocalPreParams, error) {
 			return tss.GenerateTSSPreParams(
 				n.tssConfig.GetPreParamsGenerationTimeout(),
 			)
 		},
+		storage,
+		n.metrics.PreParamsGeneration,
+		n.tssConfig.PreParamsGenerationLowPriority,
+		n.retryPolicies.Protocol,
+	)
+
+	n.tssParamsPool.load()
+
+	for i := 0; i < concurrency; i++ {
+		go n.tssParamsPool.pumpPool()
+	}
+}
+
+func newTSSPreParamsPool(
+	poolSize int,
+	generate func() (*keygen.LocalPreParams, error),
+	storage PreParamsStorage,
+	metrics *collector.Action,
+	lowPriority bool,
+	retryPolicy utils.BackoffPolicy,
+) *tssPreParamsPool {
+	slots := make(chan struct{}, poolSize)
+	for i := 0; i < poolSize; i++ {
+		slots <- struct{}{}
 	}
 
-	go n.tssParamsPool.pumpPool()
+	pool := &tssPreParamsPool{
+		pool:        make(chan *tssPreParamsEntry, poolSize),
+		slots:       slots,
+		new:         generate,
+		storage:     storage,
+		metrics:     metrics,
+		retryPolicy: retryPolicy,
+		lowPriority: lowPriority,
+		lentEntries: make(map[*params.Box]*tssPreParamsEntry),
+	}
+	pool.protocolsIdle = sync.NewCond(&pool.protocolsMutex)
+
+	return pool
 }
 
 // TSSPreParamsPoolSize returns the current size of the TSS params pool.
@@ -42,34 +136,259 @@ func (n *Node) TSSPreParamsPoolSize() int {
 	return len(n.tssParamsPool.pool)
 }
 
+// load puts pre-parameters persisted in the storage into the pool. Entries
+// exceeding the pool size and entries which can not be read are deleted.
+// Entries pulled from the pool but not used before the client stopped are
+// still persisted, so the storage may hold more entries than the pool size.
+func (t *tssPreParamsPool) load() {
+	if t.storage == nil {
+		return
+	}
+
+	persisted, err := t.storage.ReadPreParams()
+	if err != nil {
+		logger.Errorf("failed to read tss pre parameters: [%v]", err)
+		return
+	}
+
+	for id, content := range persisted {
+		if len(t.pool) == cap(t.pool) {
+			logger.Infof(
+				"deleting tss pre parameters [%s] exceeding pool size",
+				id,
+			)
+			t.delete(id)
+			continue
+		}
+
+		preParams := &keygen.LocalPreParams{}
+		if err := json.Unmarshal(content, preParams); err != nil {
+			logger.Errorf(
+				"failed to unmarshal tss pre parameters [%s]: [%v]",
+				id,
+				err,
+			)
+			t.delete(id)
+			continue
+		}
+
+		<-t.slots
+		t.pool <- &tssPreParamsEntry{id, preParams}
+	}
+
+	logger.Infof("loaded [%d] tss pre parameters from storage", len(t.pool))
+}
+
+// pumpPool generates new entries of the pool whenever there is a free slot
+// in the pool. It is executed by each worker of the pool.
 func (t *tssPreParamsPool) pumpPool() {
 	for {
+		<-t.slots
+
+		params := t.generate()
+
+		logger.Infof(
+			"current tss pre parameters pool size: [%d]",
+			len(t.pool)+1,
+		)
+
+		entry := &tssPreParamsEntry{params: params}
+
+		if t.storage != nil {
+			id, err := t.persist(params)
+			if err != nil {
+				// Pre-parameters are still usable, they are just going to
+				// be lost on the client restart.
+				logger.Warningf(
+					"failed to persist tss pre parameters: [%v]",
+					err,
+				)
+			}
+			entry.id = id
+		}
+
+		t.pool <- entry
+	}
+}
+
+// generate generates new pre-parameters retrying on failure. Consecutive
+// failures, usually generation timeouts, are retried with an exponential
+// backoff of the retry policy so that the worker does not keep the machine
+// busy in vain.
+func (t *tssPreParamsPool) generate() *keygen.LocalPreParams {
+	backoff := utils.NewBackoff(t.retryPolicy)
+	failures := 0
+
+	for {
+		t.waitForIdleProtocols()
+
 		logger.Info("generating new tss pre parameters")
 
+		t.metrics.Attempted()
 		start := time.Now()
 
 		params, err := t.new()
-		if err != nil {
-			logger.Warningf(
-				"failed to generate tss pre parameters after [%s]: [%v]",
+		if err == nil {
+			logger.Infof(
+				"generated new tss pre parameters, took: [%s]",
 				time.Since(start),
-				err,
 			)
-			continue
+
+			return params
 		}
 
-		logger.Infof(
-			"generated new tss pre parameters, took: [%s], current pool size: [%d]",
+		t.metrics.Failed()
+		failures++
+
+		logger.Warningf(
+			"failed to generate tss pre parameters after [%s]; "+
+				"consecutive failures [%d]; will retry after backoff: [%v]",
 			time.Since(start),
-			len(t.pool)+1,
+			failures,
+			err,
 		)
 
-		t.pool <- params
+		// The pool lives as long as the client so the wait is never
+		// interrupted.
+		_ = backoff.Wait(context.Background())
 	}
 }
 
-// get returns TSS pre parameters from the pool. It pumps the pool after getting
-// and entry. If the pool is empty it will wait for a new entry to be generated.
-func (t *tssPreParamsPool) get() *keygen.LocalPreParams {
-	return <-t.pool
+// waitForIdleProtocols blocks until no protocol is executed by the node or
+// the pool is empty if the pool is in the low priority mode.
+func (t *tssPreParamsPool) waitForIdleProtocols() {
+	if !t.lowPriority {
+		return
+	}
+
+	t.protocolsMutex.Lock()
+	defer t.protocolsMutex.Unlock()
+
+	for t.runningProtocols > 0 && len(t.pool) > 0 {
+		t.protocolsIdle.Wait()
+	}
+}
+
+func (t *tssPreParamsPool) protocolStarted() {
+	t.protocolsMutex.Lock()
+	defer t.protocolsMutex.Unlock()
+
+	t.runningProtocols++
+}
+
+func (t *tssPreParamsPool) protocolFinished() {
+	t.protocolsMutex.Lock()
+	defer t.protocolsMutex.Unlock()
+
+	t.runningProtocols--
+	if t.runningProtocols == 0 {
+		t.protocolsIdle.Broadcast()
+	}
+}
+
+// notifyProtocolStarted lets the pool know a protocol is executed by the node,
+// so that in the low priority mode the pool does not start generating new
+// pre-parameters. The returned function should be called once the protocol
+// execution ends.
+func (n *Node) notifyProtocolStarted() func() {
+	if n.tssParamsPool == nil {
+		return func() {}
+	}
+
+	n.tssParamsPool.protocolStarted()
+	return n.tssParamsPool.protocolFinished
+}
+
+func (t *tssPreParamsPool) persist(
+	preParams *keygen.LocalPreParams,
+) (string, error) {
+	content, err := json.Marshal(preParams)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal pre parameters: [%v]", err)
+	}
+
+	idBytes := make([]byte, 16)
+	if _, err := rand.Read(idBytes); err != nil {
+		return "", fmt.Errorf("failed to generate identifier: [%v]", err)
+	}
+	id := hex.EncodeToString(idBytes)
+
+	if err := t.storage.SavePreParams(id, content); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (t *tssPreParamsPool) delete(id string) {
+	if err := t.storage.DeletePreParams(id); err != nil {
+		logger.Errorf(
+			"failed to delete tss pre parameters [%s]: [%v]",
+			id,
+			err,
+		)
+	}
+}
+
+// get returns a box with TSS pre parameters from the pool. It pumps the pool
+// after getting and entry. If the pool is empty it will wait for a new entry
+// to be generated. Persisted pre parameters are deleted from the storage once
+// the box content is destroyed.
+func (t *tssPreParamsPool) get() *params.Box {
+	entry := <-t.pool
+	t.slots <- struct{}{}
+
+	// Paused workers need to check if the pool has not become empty.
+	t.protocolsMutex.Lock()
+	t.protocolsIdle.Broadcast()
+	t.protocolsMutex.Unlock()
+
+	var box *params.Box
+	box = params.NewBoxWithDestroyHandler(entry.params, func() {
+		t.lentEntriesMutex.Lock()
+		delete(t.lentEntries, box)
+		t.lentEntriesMutex.Unlock()
+
+		if entry.id != "" {
+			t.delete(entry.id)
+		}
+	})
+
+	t.lentEntriesMutex.Lock()
+	t.lentEntries[box] = entry
+	t.lentEntriesMutex.Unlock()
+
+	return box
+}
+
+// put returns the box pulled from the pool to the pool if its content has
+// not been destroyed, that is, the pre-parameters have never been shared with
+// other clients. The entry takes the free slot of the pool so no new entry is
+// generated in its place. If there is no free slot, the box content is
+// destroyed. The box must not be used after it is returned.
+func (t *tssPreParamsPool) put(box *params.Box) {
+	if box.IsEmpty() {
+		return
+	}
+
+	t.lentEntriesMutex.Lock()
+	entry, isLent := t.lentEntries[box]
+	delete(t.lentEntries, box)
+	t.lentEntriesMutex.Unlock()
+
+	if !isLent {
+		return
+	}
+
+	select {
+	case <-t.slots:
+		t.pool <- entry
+		logger.Infof(
+			"returned unused tss pre parameters to the pool; "+
+				"current pool size: [%d]",
+			len(t.pool),
+		)
+	default:
+		box.DestroyContent()
+	}
 }
@@ -1,12 +1,15 @@
 package node
 
 import (
+	"fmt"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/binance-chain/tss-lib/ecdsa/keygen"
 	"github.com/ipfs/go-log"
+	"github.com/keep-network/keep-ecdsa/pkg/metrics/collector"
+	"github.com/keep-network/keep-ecdsa/pkg/utils"
 )
 
 func TestTSSPreParamsPool(t *testing.T) {
@@ -51,7 +54,7 @@ func TestTSSPreParamsPool(t *testing.T) {
 		t.Errorf("result is nil")
 	}
 
-	if len(tssPool.pool) != poolSize-1 {
+	if len(tssPool.pool) != poolSize-2 {
 		t.Errorf(
 			"invalid after get length\nexpected: [%d]\nactual:   [%d]",
 			poolSize-2,
@@ -167,12 +170,392 @@ func TestTSSPreParamsPoolConcurrent(t *testing.T) {
 	}
 }
 
+func TestTSSPreParamsPoolPersistence(t *testing.T) {
+	poolSize := 2
+
+	storage := newTestPreParamsStorage()
+
+	tssPool := newTestPool(poolSize)
+	tssPool.storage = storage
+
+	go tssPool.pumpPool()
+	time.Sleep(100 * time.Millisecond)
+
+	if len(storage.entries()) != poolSize {
+		t.Fatalf(
+			"invalid number of persisted entries\nexpected: [%d]\nactual:   [%d]",
+			poolSize,
+			len(storage.entries()),
+		)
+	}
+
+	// Entries are loaded by the new pool, as if the client was restarted.
+	reloadedPool := newTestPool(poolSize)
+	reloadedPool.storage = storage
+	reloadedPool.load()
+
+	if len(reloadedPool.pool) != poolSize {
+		t.Fatalf(
+			"invalid length after load\nexpected: [%d]\nactual:   [%d]",
+			poolSize,
+			len(reloadedPool.pool),
+		)
+	}
+
+	if len(storage.entries()) != poolSize {
+		t.Fatalf(
+			"invalid number of persisted entries\nexpected: [%d]\nactual:   [%d]",
+			poolSize,
+			len(storage.entries()),
+		)
+	}
+
+	box := reloadedPool.get()
+	if _, err := box.Content(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The entry pulled from the pool is still persisted until its
+	// pre-parameters are shared with other members.
+	if len(storage.entries()) != poolSize {
+		t.Fatalf(
+			"invalid number of persisted entries\nexpected: [%d]\nactual:   [%d]",
+			poolSize,
+			len(storage.entries()),
+		)
+	}
+
+	box.DestroyContent()
+
+	if len(storage.entries()) != poolSize-1 {
+		t.Fatalf(
+			"invalid number of persisted entries\nexpected: [%d]\nactual:   [%d]",
+			poolSize-1,
+			len(storage.entries()),
+		)
+	}
+}
+
+func TestTSSPreParamsPoolLoadExceedingPoolSize(t *testing.T) {
+	storage := newTestPreParamsStorage()
+	for _, id := range []string{"1", "2", "3"} {
+		if err := storage.SavePreParams(id, []byte("{}")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tssPool := newTestPool(2)
+	tssPool.storage = storage
+	tssPool.load()
+
+	if len(tssPool.pool) != 2 {
+		t.Errorf(
+			"invalid length after load\nexpected: [%d]\nactual:   [%d]",
+			2,
+			len(tssPool.pool),
+		)
+	}
+
+	if len(storage.entries()) != 2 {
+		t.Errorf(
+			"invalid number of persisted entries\nexpected: [%d]\nactual:   [%d]",
+			2,
+			len(storage.entries()),
+		)
+	}
+}
+
+func TestTSSPreParamsPoolPut(t *testing.T) {
+	storage := newTestPreParamsStorage()
+	for _, id := range []string{"1", "2"} {
+		if err := storage.SavePreParams(id, []byte("{}")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// No workers are started, so slots freed by getting entries are not
+	// taken by the generation of new entries.
+	tssPool := newTestPool(2)
+	tssPool.storage = storage
+	tssPool.load()
+
+	box := tssPool.get()
+	tssPool.put(box)
+
+	if len(tssPool.pool) != 2 {
+		t.Errorf(
+			"invalid length after put\nexpected: [%d]\nactual:   [%d]",
+			2,
+			len(tssPool.pool),
+		)
+	}
+	if len(tssPool.slots) != 0 {
+		t.Errorf(
+			"invalid number of free slots after put\nexpected: [%d]\nactual:   [%d]",
+			0,
+			len(tssPool.slots),
+		)
+	}
+
+	// Shared pre-parameters are not returned.
+	sharedBox := tssPool.get()
+	sharedBox.DestroyContent()
+	tssPool.put(sharedBox)
+
+	if len(tssPool.pool) != 1 {
+		t.Errorf(
+			"invalid length after put of shared entry\n"+
+				"expected: [%d]\nactual:   [%d]",
+			1,
+			len(tssPool.pool),
+		)
+	}
+
+	// The entry is dropped if all free slots have been already taken.
+	box = tssPool.get()
+	for len(tssPool.slots) > 0 {
+		<-tssPool.slots
+	}
+	tssPool.put(box)
+
+	if len(tssPool.pool) != 0 {
+		t.Errorf(
+			"invalid length after put with no free slot\n"+
+				"expected: [%d]\nactual:   [%d]",
+			0,
+			len(tssPool.pool),
+		)
+	}
+	if len(storage.entries()) != 0 {
+		t.Errorf(
+			"invalid number of persisted entries\nexpected: [%d]\nactual:   [%d]",
+			0,
+			len(storage.entries()),
+		)
+	}
+}
+
+func TestTSSPreParamsPoolWorkers(t *testing.T) {
+	poolSize := 4
+
+	tssPool := newTSSPreParamsPool(
+		poolSize,
+		func() (*keygen.LocalPreParams, error) {
+			time.Sleep(50 * time.Millisecond)
+			return &keygen.LocalPreParams{}, nil
+		},
+		nil,
+		&collector.Action{},
+		false,
+		testRetryPolicy,
+	)
+
+	for i := 0; i < poolSize; i++ {
+		go tssPool.pumpPool()
+	}
+
+	// All entries are generated at the same time by separate workers.
+	time.Sleep(80 * time.Millisecond)
+
+	if len(tssPool.pool) != poolSize {
+		t.Errorf(
+			"invalid length\nexpected: [%d]\nactual:   [%d]",
+			poolSize,
+			len(tssPool.pool),
+		)
+	}
+
+	// Workers do not generate more entries than missing in the pool.
+	time.Sleep(100 * time.Millisecond)
+
+	if attempts := tssPool.metrics.Attempts(); attempts != uint64(poolSize) {
+		t.Errorf(
+			"invalid number of generation attempts\nexpected: [%d]\nactual:   [%d]",
+			poolSize,
+			attempts,
+		)
+	}
+}
+
+func TestTSSPreParamsPoolLowPriority(t *testing.T) {
+	poolSize := 2
+
+	tssPool := newTSSPreParamsPool(
+		poolSize,
+		func() (*keygen.LocalPreParams, error) {
+			time.Sleep(10 * time.Millisecond)
+			return &keygen.LocalPreParams{}, nil
+		},
+		nil,
+		&collector.Action{},
+		true,
+		testRetryPolicy,
+	)
+
+	go tssPool.pumpPool()
+	time.Sleep(50 * time.Millisecond)
+
+	tssPool.protocolStarted()
+
+	tssPool.get()
+	time.Sleep(50 * time.Millisecond)
+
+	// Generation is paused while the protocol is executed.
+	if len(tssPool.pool) != poolSize-1 {
+		t.Errorf(
+			"invalid length while protocol is executed\n"+
+				"expected: [%d]\nactual:   [%d]",
+			poolSize-1,
+			len(tssPool.pool),
+		)
+	}
+
+	// Generation is resumed if the protocol waits for an entry from
+	// the empty pool.
+	tssPool.get()
+	time.Sleep(50 * time.Millisecond)
+
+	if len(tssPool.pool) != 1 {
+		t.Errorf(
+			"invalid length after pool emptied\nexpected: [%d]\nactual:   [%d]",
+			1,
+			len(tssPool.pool),
+		)
+	}
+
+	tssPool.protocolFinished()
+	time.Sleep(50 * time.Millisecond)
+
+	if len(tssPool.pool) != poolSize {
+		t.Errorf(
+			"invalid length after protocol finished\n"+
+				"expected: [%d]\nactual:   [%d]",
+			poolSize,
+			len(tssPool.pool),
+		)
+	}
+}
+
+func TestTSSPreParamsPoolGenerationFailures(t *testing.T) {
+	failures := 2
+
+	var mutex sync.Mutex
+	attempt := 0
+
+	tssPool := newTSSPreParamsPool(
+		1,
+		func() (*keygen.LocalPreParams, error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			attempt++
+			if attempt <= failures {
+				return nil, fmt.Errorf("timeout")
+			}
+			return &keygen.LocalPreParams{}, nil
+		},
+		nil,
+		&collector.Action{},
+		false,
+		utils.BackoffPolicy{
+			InitialBackoff: 10 * time.Millisecond,
+			MaxBackoff:     15 * time.Millisecond,
+		},
+	)
+
+	go tssPool.pumpPool()
+
+	// Retries are delayed by 10ms and 15ms.
+	time.Sleep(15 * time.Millisecond)
+
+	if len(tssPool.pool) != 0 {
+		t.Errorf("pool should be empty while generation is backed off")
+	}
+
+	time.Sleep(50 * time.Millisecond)
+
+	if len(tssPool.pool) != 1 {
+		t.Errorf(
+			"invalid length\nexpected: [%d]\nactual:   [%d]",
+			1,
+			len(tssPool.pool),
+		)
+	}
+
+	if attempts := tssPool.metrics.Attempts(); attempts != uint64(failures+1) {
+		t.Errorf(
+			"invalid number of generation attempts\nexpected: [%d]\nactual:   [%d]",
+			failures+1,
+			attempts,
+		)
+	}
+
+	if actual := tssPool.metrics.Failures(); actual != uint64(failures) {
+		t.Errorf(
+			"invalid number of generation failures\nexpected: [%d]\nactual:   [%d]",
+			failures,
+			actual,
+		)
+	}
+}
+
 func newTestPool(poolSize int) *tssPreParamsPool {
-	return &tssPreParamsPool{
-		pool: make(chan *keygen.LocalPreParams, poolSize),
-		new: func() (*keygen.LocalPreParams, error) {
+	return newTSSPreParamsPool(
+		poolSize,
+		func() (*keygen.LocalPreParams, error) {
 			time.Sleep(10 * time.Millisecond)
 			return &keygen.LocalPreParams{}, nil
 		},
+		nil,
+		&collector.Action{},
+		false,
+		testRetryPolicy,
+	)
+}
+
+var testRetryPolicy = utils.BackoffPolicy{
+	InitialBackoff: 10 * time.Millisecond,
+	MaxBackoff:     100 * time.Millisecond,
+}
+
+type testPreParamsStorage struct {
+	mutex     sync.Mutex
+	preParams map[string][]byte
+}
+
+func newTestPreParamsStorage() *testPreParamsStorage {
+	return &testPreParamsStorage{
+		preParams: make(map[string][]byte),
+	}
+}
+
+func (tps *testPreParamsStorage) SavePreParams(id string, content []byte) error {
+	tps.mutex.Lock()
+	defer tps.mutex.Unlock()
+
+	tps.preParams[id] = content
+	return nil
+}
+
+func (tps *testPreParamsStorage) ReadPreParams() (map[string][]byte, error) {
+	return tps.entries(), nil
+}
+
+func (tps *testPreParamsStorage) DeletePreParams(id string) error {
+	tps.mutex.Lock()
+	defer tps.mutex.Unlock()
+
+	delete(tps.preParams, id)
+	return nil
+}
+
+func (tps *testPreParamsStorage) entries() map[string][]byte {
+	tps.mutex.Lock()
+	defer tps.mutex.Unlock()
+
+	entries := make(map[string][]byte, len(tps.preParams))
+	for id, content := range tps.preParams {
+		entries[id] = content
 	}
+	return entries
 }
@@ -0,0 +1,89 @@
+package node
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+	"github.com/keep-network/keep-ecdsa/pkg/reliability"
+)
+
+// recordPeerFaults records faults of peer members found in the error returned
+// from a failed protocol execution for the keep. Members who have not
+// announced their presence or signalled their readiness are recorded, as well
+// as members blamed by tss-lib for sending invalid messages, who are recorded
+// with the provided culprit fault and the evidence of their misbehaviour.
+//
+// Culprits are not excluded from retries. The keep is activated only when all
+// its members submit the same public key, so the key has to be generated by
+// all of them. The evidence lets the operator identify the misbehaving
+// operators instead.
+func (n *Node) recordPeerFaults(
+	keepAddress common.Address,
+	err error,
+	culpritFault reliability.Fault,
+) {
+	if n.peerReliability == nil {
+		return
+	}
+
+	missingAnnouncements := tss.MissingAnnouncements(err)
+	missingAnnouncementsAddresses := make(
+		[]common.Address,
+		len(missingAnnouncements),
+	)
+	for i, memberAddress := range missingAnnouncements {
+		missingAnnouncementsAddresses[i] = common.HexToAddress(memberAddress)
+	}
+
+	n.peerReliability.Record(
+		reliability.MissedAnnouncement,
+		keepAddress,
+		missingAnnouncementsAddresses...,
+	)
+	n.peerReliability.Record(
+		reliability.MissedReadySignal,
+		keepAddress,
+		n.memberAddresses(tss.MissingReadySignals(err))...,
+	)
+	for _, culprit := range tss.Culprits(err) {
+		addresses := n.memberAddresses([]tss.MemberID{culprit.MemberID})
+		if len(addresses) != 1 {
+			continue
+		}
+
+		n.peerReliability.RecordWithEvidence(
+			culpritFault,
+			keepAddress,
+			addresses[0],
+			strings.Join(culprit.Evidence, "; "),
+		)
+	}
+}
+
+// memberAddresses converts identifiers of members to their operator
+// addresses. Identifiers which could not be converted are skipped.
+func (n *Node) memberAddresses(memberIDs []tss.MemberID) []common.Address {
+	addresses := make([]common.Address, 0, len(memberIDs))
+
+	for _, memberID := range memberIDs {
+		publicKey, err := memberID.PublicKey()
+		if err != nil {
+			logger.Errorf(
+				"could not get public key of member [%s]: [%v]",
+				memberID,
+				err,
+			)
+			continue
+		}
+
+		addresses = append(
+			addresses,
+			common.BytesToAddress(
+				n.ethereumChain.Signing().PublicKeyToAddress(*publicKey),
+			),
+		)
+	}
+
+	return addresses
+}
@@ -0,0 +1,794 @@
+package registry
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-common/pkg/encryption"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Bolt storage keeps all data in a single database file. Each keep has its
+// own bucket in the keeps bucket with the following content:
+//
+//	versions     bucket of all signer records of the keep by their version
+//	current      version of the current signer
+//	snapshot     version of the snapshot not promoted to the current signer yet
+//	journal      journal of pending operations of the keep
+//	archived_at  time at which the keep has been archived
+//
+// The index of archived keeps and reliability records of peer members are
+// stored in the meta bucket. TSS
+// pre-parameters are stored in the pre-parameters bucket by their
+// identifiers. All signers, journals, pre-parameters and records of the meta
+// bucket are encrypted.
+var (
+	keepsBucket     = []byte("keeps")
+	metaBucket      = []byte("meta")
+	preParamsBucket = []byte("pre_params")
+
+	versionsBucket = []byte("versions")
+	currentKey     = []byte("current")
+	snapshotKey    = []byte("snapshot")
+	journalKey     = []byte("journal")
+	archivedAtKey  = []byte("archived_at")
+
+	archivedKeepsKey   = []byte("archived_keeps")
+	peerReliabilityKey = []byte("peer_reliability")
+)
+
+// boltOpenTimeout is the maximum time to wait for the lock on the database
+// file held by another process.
+const boltOpenTimeout = 5 * time.Second
+
+type boltStorage struct {
+	db  *bolt.DB
+	box encryption.Box
+}
+
+// NewBoltStorage opens the bbolt database under the given path, creating it
+// if it does not exist. Signers and journals are encrypted with the given key.
+func NewBoltStorage(path string, key EncryptionKey) (Storage, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: boltOpenTimeout})
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to open bolt database [%s]: [%v]",
+			path,
+			err,
+		)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{
+			keepsBucket,
+			metaBucket,
+			preParamsBucket,
+		} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize bolt database: [%v]", err)
+	}
+
+	return &boltStorage{
+		db:  db,
+		box: encryption.NewBox(key),
+	}, nil
+}
+
+func boltFilePath(dataDir string) string {
+	return filepath.Join(dataDir, boltFileName)
+}
+
+// Save stores the signer as a new version of the keep's signer and makes it
+// current. If the most recent snapshot holds the same signer, the snapshot is
+// promoted to the current signer instead. Both happen in one transaction so
+// the current signer is never left partially updated.
+func (bs *boltStorage) Save(
+	keepAddress common.Address,
+	signer *tss.ThresholdSigner,
+) error {
+	signerBytes, err := signer.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal signer: [%v]", err)
+	}
+
+	return bs.db.Update(func(tx *bolt.Tx) error {
+		keep, err := tx.Bucket(keepsBucket).CreateBucketIfNotExists(
+			keepAddress.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+
+		versions, err := keep.CreateBucketIfNotExists(versionsBucket)
+		if err != nil {
+			return err
+		}
+
+		version, err := bs.snapshotVersion(keep, versions, signerBytes)
+		if err != nil {
+			return err
+		}
+
+		if version == nil {
+			version, err = bs.putVersion(versions, signerBytes)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := keep.Put(currentKey, version); err != nil {
+			return err
+		}
+
+		return keep.Delete(snapshotKey)
+	})
+}
+
+// snapshotVersion returns the version of the keep's snapshot if it holds
+// the given signer. It returns nil otherwise.
+func (bs *boltStorage) snapshotVersion(
+	keep *bolt.Bucket,
+	versions *bolt.Bucket,
+	signerBytes []byte,
+) ([]byte, error) {
+	version := keep.Get(snapshotKey)
+	if version == nil {
+		return nil, nil
+	}
+
+	encrypted := versions.Get(version)
+	if encrypted == nil {
+		return nil, nil
+	}
+
+	snapshotBytes, err := bs.box.Decrypt(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt snapshot: [%v]", err)
+	}
+
+	if !bytes.Equal(snapshotBytes, signerBytes) {
+		return nil, nil
+	}
+
+	// Memory returned by bolt is valid only until the transaction ends.
+	return append([]byte{}, version...), nil
+}
+
+func (bs *boltStorage) putVersion(
+	versions *bolt.Bucket,
+	signerBytes []byte,
+) ([]byte, error) {
+	encrypted, err := bs.box.Encrypt(signerBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt signer: [%v]", err)
+	}
+
+	sequence, err := versions.NextSequence()
+	if err != nil {
+		return nil, err
+	}
+
+	version := make([]byte, 8)
+	binary.BigEndian.PutUint64(version, sequence)
+
+	if err := versions.Put(version, encrypted); err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
+
+// Snapshot stores the signer as a new version of the keep's signer without
+// changing the current signer.
+func (bs *boltStorage) Snapshot(
+	keepAddress common.Address,
+	signer *tss.ThresholdSigner,
+) error {
+	signerBytes, err := signer.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal signer: [%v]", err)
+	}
+
+	return bs.db.Update(func(tx *bolt.Tx) error {
+		keep, err := tx.Bucket(keepsBucket).CreateBucketIfNotExists(
+			keepAddress.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+
+		versions, err := keep.CreateBucketIfNotExists(versionsBucket)
+		if err != nil {
+			return err
+		}
+
+		version, err := bs.putVersion(versions, signerBytes)
+		if err != nil {
+			return err
+		}
+
+		return keep.Put(snapshotKey, version)
+	})
+}
+
+type encryptedRecord struct {
+	keepAddress common.Address
+	content     []byte
+}
+
+// readRecords reads the value stored under the given key in buckets of all
+// keeps which are not archived. If versioned is true, the value is a version
+// of the signer record which is read instead.
+func (bs *boltStorage) readRecords(
+	key []byte,
+	versioned bool,
+) ([]*encryptedRecord, error) {
+	var records []*encryptedRecord
+
+	err := bs.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(keepsBucket).ForEach(func(name, _ []byte) error {
+			keep := tx.Bucket(keepsBucket).Bucket(name)
+			if keep == nil || keep.Get(archivedAtKey) != nil {
+				return nil
+			}
+
+			content := keep.Get(key)
+			if content != nil && versioned {
+				versions := keep.Bucket(versionsBucket)
+				if versions == nil {
+					return fmt.Errorf(
+						"no versions of signer for keep [%s]",
+						common.BytesToAddress(name).String(),
+					)
+				}
+				content = versions.Get(content)
+			}
+
+			if content == nil {
+				return nil
+			}
+
+			records = append(records, &encryptedRecord{
+				keepAddress: common.BytesToAddress(name),
+				// Memory returned by bolt is valid only until the
+				// transaction ends.
+				content: append([]byte{}, content...),
+			})
+
+			return nil
+		})
+	})
+
+	return records, err
+}
+
+// ReadAll reads current signers of all keeps which are not archived. All
+// signers are read in one transaction and decoded in a single goroutine.
+func (bs *boltStorage) ReadAll() (<-chan *KeepSigner, <-chan error) {
+	return bs.decodeSigners(func() ([]*encryptedRecord, error) {
+		return bs.readRecords(currentKey, true)
+	})
+}
+
+// ReadSnapshots reads all versions of signers of keeps which are not archived
+// except the current ones. Those are snapshots not promoted to the current
+// signer and signers replaced by a newer version.
+func (bs *boltStorage) ReadSnapshots() (<-chan *KeepSigner, <-chan error) {
+	return bs.decodeSigners(bs.readSnapshotRecords)
+}
+
+func (bs *boltStorage) readSnapshotRecords() ([]*encryptedRecord, error) {
+	var records []*encryptedRecord
+
+	err := bs.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(keepsBucket).ForEach(func(name, _ []byte) error {
+			keep := tx.Bucket(keepsBucket).Bucket(name)
+			if keep == nil || keep.Get(archivedAtKey) != nil {
+				return nil
+			}
+
+			versions := keep.Bucket(versionsBucket)
+			if versions == nil {
+				return nil
+			}
+
+			current := keep.Get(currentKey)
+			return versions.ForEach(func(version, content []byte) error {
+				if bytes.Equal(version, current) {
+					return nil
+				}
+
+				records = append(records, &encryptedRecord{
+					keepAddress: common.BytesToAddress(name),
+					content:     append([]byte{}, content...),
+				})
+				return nil
+			})
+		})
+	})
+
+	return records, err
+}
+
+// DeleteSnapshots deletes all versions of the keep's signer holding the given
+// signer except the current one. Those are snapshots of the signer and
+// the signer itself if it has been replaced by a newer version.
+func (bs *boltStorage) DeleteSnapshots(
+	keepAddress common.Address,
+	signer *tss.ThresholdSigner,
+) error {
+	signerBytes, err := signer.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal signer: [%v]", err)
+	}
+
+	return bs.db.Update(func(tx *bolt.Tx) error {
+		keep := tx.Bucket(keepsBucket).Bucket(keepAddress.Bytes())
+		if keep == nil {
+			return nil
+		}
+
+		versions := keep.Bucket(versionsBucket)
+		if versions == nil {
+			return nil
+		}
+
+		current := keep.Get(currentKey)
+
+		var deleted [][]byte
+		err := versions.ForEach(func(version, encrypted []byte) error {
+			if bytes.Equal(version, current) {
+				return nil
+			}
+
+			content, err := bs.box.Decrypt(encrypted)
+			if err != nil {
+				return fmt.Errorf("failed to decrypt snapshot: [%v]", err)
+			}
+
+			if bytes.Equal(content, signerBytes) {
+				deleted = append(deleted, append([]byte{}, version...))
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		// Bucket must not be modified while iterating over it.
+		for _, version := range deleted {
+			if err := versions.Delete(version); err != nil {
+				return err
+			}
+
+			if bytes.Equal(keep.Get(snapshotKey), version) {
+				if err := keep.Delete(snapshotKey); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// decodeSigners decrypts and unmarshals signers from records returned by
+// the read function.
+func (bs *boltStorage) decodeSigners(
+	readFn func() ([]*encryptedRecord, error),
+) (<-chan *KeepSigner, <-chan error) {
+	outputKeepSigner := make(chan *KeepSigner)
+	outputErrors := make(chan error)
+
+	go func() {
+		defer close(outputErrors)
+		defer close(outputKeepSigner)
+
+		records, err := readFn()
+		if err != nil {
+			outputErrors <- fmt.Errorf("failed to read signers: [%v]", err)
+			return
+		}
+
+		for _, record := range records {
+			signerBytes, err := bs.box.Decrypt(record.content)
+			if err != nil {
+				outputErrors <- fmt.Errorf(
+					"failed to decrypt signer of keep [%s]: [%v]",
+					record.keepAddress.String(),
+					err,
+				)
+				continue
+			}
+
+			signer := &tss.ThresholdSigner{}
+			if err := signer.Unmarshal(signerBytes); err != nil {
+				outputErrors <- fmt.Errorf(
+					"failed to unmarshal signer of keep [%s]: [%v]",
+					record.keepAddress.String(),
+					err,
+				)
+				continue
+			}
+
+			outputKeepSigner <- &KeepSigner{
+				KeepAddress: record.keepAddress,
+				Signer:      signer,
+			}
+		}
+	}()
+
+	return outputKeepSigner, outputErrors
+}
+
+// Archive records the time at which the keep has been archived. All versions
+// of the keep's signer are retained.
+func (bs *boltStorage) Archive(keepAddress common.Address) error {
+	return bs.db.Update(func(tx *bolt.Tx) error {
+		keep := tx.Bucket(keepsBucket).Bucket(keepAddress.Bytes())
+		if keep == nil {
+			return fmt.Errorf(
+				"keep [%s] not found in the storage",
+				keepAddress.String(),
+			)
+		}
+
+		archivedAt, err := time.Now().UTC().MarshalText()
+		if err != nil {
+			return err
+		}
+
+		return keep.Put(archivedAtKey, archivedAt)
+	})
+}
+
+func (bs *boltStorage) SaveArchivedKeeps(
+	keepsAddresses []common.Address,
+) error {
+	content, err := json.Marshal(keepsAddresses)
+	if err != nil {
+		return fmt.Errorf("failed to marshal archived keeps: [%v]", err)
+	}
+
+	return bs.putMeta(archivedKeepsKey, content)
+}
+
+func (bs *boltStorage) ReadArchivedKeeps() ([]common.Address, error) {
+	content, err := bs.getMeta(archivedKeepsKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archived keeps: [%v]", err)
+	}
+
+	if content == nil {
+		return nil, nil
+	}
+
+	var keepsAddresses []common.Address
+	if err := json.Unmarshal(content, &keepsAddresses); err != nil {
+		return nil, fmt.Errorf(
+			"failed to unmarshal archived keeps: [%v]",
+			err,
+		)
+	}
+
+	return keepsAddresses, nil
+}
+
+func (bs *boltStorage) SavePeerReliability(content []byte) error {
+	return bs.putMeta(peerReliabilityKey, content)
+}
+
+func (bs *boltStorage) ReadPeerReliability() ([]byte, error) {
+	content, err := bs.getMeta(peerReliabilityKey)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to read peer reliability records: [%v]",
+			err,
+		)
+	}
+
+	return content, nil
+}
+
+// putMeta encrypts the content and stores it in the meta bucket under
+// the given key.
+func (bs *boltStorage) putMeta(key []byte, content []byte) error {
+	encrypted, err := bs.box.Encrypt(content)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt [%s]: [%v]", key, err)
+	}
+
+	return bs.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(metaBucket).Put(key, encrypted)
+	})
+}
+
+// getMeta reads the content stored in the meta bucket under the given key and
+// decrypts it. Nil is returned if there is no content under the key.
+func (bs *boltStorage) getMeta(key []byte) ([]byte, error) {
+	var encrypted []byte
+
+	err := bs.db.View(func(tx *bolt.Tx) error {
+		if value := tx.Bucket(metaBucket).Get(key); value != nil {
+			encrypted = append([]byte{}, value...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if encrypted == nil {
+		return nil, nil
+	}
+
+	content, err := bs.box.Decrypt(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt [%s]: [%v]", key, err)
+	}
+
+	return content, nil
+}
+
+func (bs *boltStorage) SaveJournal(
+	keepAddress common.Address,
+	content []byte,
+) error {
+	encrypted, err := bs.box.Encrypt(content)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt journal: [%v]", err)
+	}
+
+	return bs.db.Update(func(tx *bolt.Tx) error {
+		keep, err := tx.Bucket(keepsBucket).CreateBucketIfNotExists(
+			keepAddress.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+
+		return keep.Put(journalKey, encrypted)
+	})
+}
+
+func (bs *boltStorage) ReadJournals() (map[common.Address][]byte, error) {
+	records, err := bs.readRecords(journalKey, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read journals: [%v]", err)
+	}
+
+	journals := make(map[common.Address][]byte)
+	for _, record := range records {
+		content, err := bs.box.Decrypt(record.content)
+		if err != nil {
+			logger.Errorf(
+				"failed to decrypt journal of keep [%s]: [%v]",
+				record.keepAddress.String(),
+				err,
+			)
+			continue
+		}
+
+		journals[record.keepAddress] = content
+	}
+
+	return journals, nil
+}
+
+func (bs *boltStorage) SavePreParams(id string, content []byte) error {
+	encrypted, err := bs.box.Encrypt(content)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt pre-parameters: [%v]", err)
+	}
+
+	return bs.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(preParamsBucket).Put([]byte(id), encrypted)
+	})
+}
+
+func (bs *boltStorage) ReadPreParams() (map[string][]byte, error) {
+	records := make(map[string][]byte)
+
+	err := bs.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(preParamsBucket).ForEach(func(id, value []byte) error {
+			records[string(id)] = append([]byte{}, value...)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pre-parameters: [%v]", err)
+	}
+
+	preParams := make(map[string][]byte)
+	for id, record := range records {
+		content, err := bs.box.Decrypt(record)
+		if err != nil {
+			logger.Errorf(
+				"failed to decrypt pre-parameters [%s]: [%v]",
+				id,
+				err,
+			)
+			continue
+		}
+
+		preParams[id] = content
+	}
+
+	return preParams, nil
+}
+
+func (bs *boltStorage) DeletePreParams(id string) error {
+	return bs.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(preParamsBucket).Delete([]byte(id))
+	})
+}
+
+func (bs *boltStorage) Close() error {
+	return bs.db.Close()
+}
+
+func rotateBoltEncryptionKey(
+	path string,
+	currentBox encryption.Box,
+	newBox encryption.Box,
+) (int, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: boltOpenTimeout})
+	if err != nil {
+		return 0, fmt.Errorf(
+			"failed to open bolt database [%s]: [%v]",
+			path,
+			err,
+		)
+	}
+	defer db.Close()
+
+	type boltRecord struct {
+		bucket *bolt.Bucket
+		key    []byte
+		value  []byte
+	}
+
+	count := 0
+
+	// All records are re-encrypted in one transaction. If any of them can
+	// not be verified, the transaction is rolled back and the database is
+	// left unchanged.
+	err = db.Update(func(tx *bolt.Tx) error {
+		keeps := tx.Bucket(keepsBucket)
+		if keeps == nil {
+			return nil
+		}
+
+		var records []*boltRecord
+
+		reencryptRecord := func(
+			bucket *bolt.Bucket,
+			key []byte,
+			value []byte,
+			isSigner bool,
+			keepName []byte,
+		) error {
+			reencrypted, err := reencrypt(isSigner, value, currentBox, newBox)
+			if err != nil {
+				return fmt.Errorf(
+					"could not re-encrypt record of keep [%s]: [%v]",
+					common.BytesToAddress(keepName).String(),
+					err,
+				)
+			}
+
+			records = append(records, &boltRecord{
+				bucket: bucket,
+				key:    append([]byte{}, key...),
+				value:  reencrypted,
+			})
+
+			return nil
+		}
+
+		err := keeps.ForEach(func(name, _ []byte) error {
+			keep := keeps.Bucket(name)
+			if keep == nil {
+				return nil
+			}
+
+			if journal := keep.Get(journalKey); journal != nil {
+				if err := reencryptRecord(
+					keep,
+					journalKey,
+					journal,
+					false,
+					name,
+				); err != nil {
+					return err
+				}
+			}
+
+			versions := keep.Bucket(versionsBucket)
+			if versions == nil {
+				return nil
+			}
+
+			return versions.ForEach(func(version, value []byte) error {
+				return reencryptRecord(versions, version, value, true, name)
+			})
+		})
+		if err != nil {
+			return err
+		}
+
+		if meta := tx.Bucket(metaBucket); meta != nil {
+			err := meta.ForEach(func(key, value []byte) error {
+				reencrypted, err := reencrypt(false, value, currentBox, newBox)
+				if err != nil {
+					return fmt.Errorf(
+						"could not re-encrypt [%s]: [%v]",
+						key,
+						err,
+					)
+				}
+
+				records = append(records, &boltRecord{
+					bucket: meta,
+					key:    append([]byte{}, key...),
+					value:  reencrypted,
+				})
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if preParams := tx.Bucket(preParamsBucket); preParams != nil {
+			err := preParams.ForEach(func(id, value []byte) error {
+				reencrypted, err := reencrypt(false, value, currentBox, newBox)
+				if err != nil {
+					return fmt.Errorf(
+						"could not re-encrypt pre-parameters [%s]: [%v]",
+						id,
+						err,
+					)
+				}
+
+				records = append(records, &boltRecord{
+					bucket: preParams,
+					key:    append([]byte{}, id...),
+					value:  reencrypted,
+				})
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, record := range records {
+			if err := record.bucket.Put(record.key, record.value); err != nil {
+				return err
+			}
+		}
+
+		count = len(records)
+
+		return nil
+	})
+
+	return count, err
+}
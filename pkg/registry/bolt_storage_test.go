@@ -0,0 +1,240 @@
+package registry
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestBoltStorageSnapshotPromotion(t *testing.T) {
+	storage, cleanup := newTestBoltStorage(t)
+	defer cleanup()
+
+	signers, err := testSigners()
+	if err != nil {
+		t.Fatalf("failed to get signers: [%v]", err)
+	}
+
+	if err := storage.Snapshot(keepAddress1, signers[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	// Snapshot is not a current signer.
+	if loaded := readAllSigners(t, storage); len(loaded) != 0 {
+		t.Fatalf("snapshot should not be loaded as a signer")
+	}
+
+	if err := storage.Save(keepAddress1, signers[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded := readAllSigners(t, storage)
+	if !reflect.DeepEqual(signers[0], loaded[keepAddress1]) {
+		t.Errorf(
+			"unexpected signer\nexpected: [%v]\nactual:   [%v]",
+			signers[0],
+			loaded[keepAddress1],
+		)
+	}
+
+	bs := storage.(*boltStorage)
+	versionsCount := 0
+	if err := bs.db.View(func(tx *bolt.Tx) error {
+		keep := tx.Bucket(keepsBucket).Bucket(keepAddress1.Bytes())
+		versionsCount = keep.Bucket(versionsBucket).Stats().KeyN
+		if keep.Get(snapshotKey) != nil {
+			t.Errorf("snapshot should be promoted")
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The snapshot holding the same signer has been promoted, no new
+	// version has been stored.
+	if versionsCount != 1 {
+		t.Errorf(
+			"unexpected number of versions\nexpected: [%v]\nactual:   [%v]",
+			1,
+			versionsCount,
+		)
+	}
+}
+
+func TestBoltStorageArchive(t *testing.T) {
+	storage, cleanup := newTestBoltStorage(t)
+	defer cleanup()
+
+	signers, err := testSigners()
+	if err != nil {
+		t.Fatalf("failed to get signers: [%v]", err)
+	}
+
+	kr := NewKeepsRegistry(storage)
+	if err := kr.RegisterSigner(keepAddress1, signers[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := kr.RegisterSigner(keepAddress2, signers[1]); err != nil {
+		t.Fatal(err)
+	}
+
+	kr.UnregisterKeep(keepAddress1)
+
+	reloaded := NewKeepsRegistry(storage)
+	reloaded.LoadExistingKeeps()
+
+	expectedKeeps := []common.Address{keepAddress2}
+	if !reflect.DeepEqual(expectedKeeps, reloaded.GetKeepsAddresses()) {
+		t.Errorf(
+			"unexpected keeps\nexpected: [%v]\nactual:   [%v]",
+			expectedKeeps,
+			reloaded.GetKeepsAddresses(),
+		)
+	}
+
+	expectedArchivedKeeps := []common.Address{keepAddress1}
+	if !reflect.DeepEqual(
+		expectedArchivedKeeps,
+		reloaded.GetArchivedKeepsAddresses(),
+	) {
+		t.Errorf(
+			"unexpected archived keeps\nexpected: [%v]\nactual:   [%v]",
+			expectedArchivedKeeps,
+			reloaded.GetArchivedKeepsAddresses(),
+		)
+	}
+}
+
+func TestBoltStorageJournal(t *testing.T) {
+	storage, cleanup := newTestBoltStorage(t)
+	defer cleanup()
+
+	journal := NewJournal(storage)
+	if err := journal.RecordSigningStarted(keepAddress1, [32]byte{1}); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded := NewJournal(storage)
+	reloaded.Load()
+
+	if len(reloaded.PendingSignings()[keepAddress1]) != 1 {
+		t.Errorf("signing should be pending")
+	}
+
+	kr := NewKeepsRegistry(storage)
+	kr.LoadExistingKeeps()
+
+	if len(kr.GetKeepsAddresses()) != 0 {
+		t.Errorf("journal should not be loaded as a signer")
+	}
+}
+
+func TestBoltStorageEncryptsMeta(t *testing.T) {
+	storage, cleanup := newTestBoltStorage(t)
+	defer cleanup()
+
+	peerReliability := []byte("{\"records\":{}}")
+	if err := storage.SavePeerReliability(peerReliability); err != nil {
+		t.Fatal(err)
+	}
+	if err := storage.SaveArchivedKeeps(
+		[]common.Address{keepAddress1},
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	err := storage.(*boltStorage).db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(metaBucket).ForEach(func(key, value []byte) error {
+			if bytes.Contains(value, []byte("records")) ||
+				bytes.Contains(
+					bytes.ToLower(value),
+					bytes.ToLower([]byte(keepAddress1.Hex()[2:])),
+				) {
+				t.Errorf("record [%s] is not encrypted", key)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reloadedPeerReliability, err := storage.ReadPeerReliability()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(peerReliability, reloadedPeerReliability) {
+		t.Errorf(
+			"unexpected peer reliability\nexpected: [%s]\nactual:   [%s]",
+			peerReliability,
+			reloadedPeerReliability,
+		)
+	}
+
+	archivedKeeps, err := storage.ReadArchivedKeeps()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual([]common.Address{keepAddress1}, archivedKeeps) {
+		t.Errorf(
+			"unexpected archived keeps\nexpected: [%v]\nactual:   [%v]",
+			[]common.Address{keepAddress1},
+			archivedKeeps,
+		)
+	}
+}
+
+func newTestBoltStorage(t *testing.T) (Storage, func()) {
+	dir, err := ioutil.TempDir("", "bolt-storage-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	storage, err := NewBoltStorage(filepath.Join(dir, boltFileName), EncryptionKey{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return storage, func() {
+		storage.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func readAllSigners(t *testing.T, storage Storage) map[common.Address]*tss.ThresholdSigner {
+	signersChannel, errorsChannel := storage.ReadAll()
+	return collectSigners(t, signersChannel, errorsChannel)
+}
+
+func collectSigners(
+	t *testing.T,
+	signersChannel <-chan *KeepSigner,
+	errorsChannel <-chan error,
+) map[common.Address]*tss.ThresholdSigner {
+	signers := make(map[common.Address]*tss.ThresholdSigner)
+	for signersChannel != nil || errorsChannel != nil {
+		select {
+		case keepSigner, ok := <-signersChannel:
+			if !ok {
+				signersChannel = nil
+				continue
+			}
+			signers[keepSigner.KeepAddress] = keepSigner.Signer
+		case err, ok := <-errorsChannel:
+			if !ok {
+				errorsChannel = nil
+				continue
+			}
+			t.Errorf("unexpected error: [%v]", err)
+		}
+	}
+
+	return signers
+}
@@ -0,0 +1,1084 @@
+package registry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-common/pkg/encryption"
+	"github.com/keep-network/keep-common/pkg/persistence"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+)
+
+// archivedKeepsDirectory is the name of the storage directory holding the index
+// of keeps archived by the client. Archived signers are not available through
+// the persistence handle so the index is the only way to find out which keeps
+// the client has been a member of.
+const archivedKeepsDirectory = "archived_keeps"
+
+// archivedKeepsFileName is the name of the file with the index of archived keeps.
+const archivedKeepsFileName = "index"
+
+// peerReliabilityDirectory is the name of the storage directory holding
+// reliability records of peer members.
+const peerReliabilityDirectory = "peer_reliability"
+
+// peerReliabilityFileName is the name of the file with reliability records
+// of peer members.
+const peerReliabilityFileName = "records"
+
+// diskStorage is the storage keeping each keep in a separate directory using
+// keep-common persistence handle.
+type diskStorage struct {
+	handle persistence.Handle
+
+	// Persistence handle does not expose snapshots so they are read
+	// directly from the data directory. Both fields are set only if
+	// the storage has been created for the data directory.
+	dataDir string
+	box     encryption.Box
+}
+
+// NewDiskStorage creates storage using the given persistence handle.
+func NewDiskStorage(handle persistence.Handle) Storage {
+	return &diskStorage{
+		handle: handle,
+	}
+}
+
+func newEncryptedDiskStorage(dataDir string, key EncryptionKey) (Storage, error) {
+	handle, err := persistence.NewDiskHandle(dataDir)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed while creating a storage disk handler: [%v]",
+			err,
+		)
+	}
+
+	box := encryption.NewBox(key)
+
+	completed, err := completeDiskKeyRotation(dataDir, box)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to complete interrupted key rotation: [%v]",
+			err,
+		)
+	}
+	if completed > 0 {
+		logger.Infof(
+			"completed interrupted key rotation of [%d] records",
+			completed,
+		)
+	}
+
+	return &diskStorage{
+		handle:  newEncryptedHandle(handle, box),
+		dataDir: dataDir,
+		box:     box,
+	}, nil
+}
+
+func (ds *diskStorage) Save(
+	keepAddress common.Address,
+	signer *tss.ThresholdSigner,
+) error {
+	signerBytes, err := signer.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal signer: [%v]", err)
+	}
+
+	return ds.handle.Save(
+		signerBytes,
+		keepAddress.String(),
+		// Take just the first 20 bytes of member ID so that we don't produce
+		// too long file names.
+		fmt.Sprintf("/membership_%.40s", signer.MemberID().String()),
+	)
+}
+
+func (ds *diskStorage) Snapshot(
+	keepAddress common.Address,
+	signer *tss.ThresholdSigner,
+) error {
+	signerBytes, err := signer.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal signer: [%v]", err)
+	}
+
+	return ds.handle.Snapshot(
+		signerBytes,
+		keepAddress.String(),
+		// Take just the first 20 bytes of member ID so that we don't produce
+		// too long file names.
+		fmt.Sprintf("/membership_%.40s", signer.MemberID().String()),
+	)
+}
+
+func (ds *diskStorage) ReadAll() (<-chan *KeepSigner, <-chan error) {
+	outputKeepSigner := make(chan *KeepSigner)
+	outputErrors := make(chan error)
+
+	inputData, inputErrors := ds.handle.ReadAll()
+
+	// We have two goroutines reading from data and errors channels at the same
+	// time. The reason for that is because we don't know in what order
+	// producers write information to channels.
+	// The third goroutine waits for those two goroutines to finish and it
+	// closes the output channels. Channels are not closed by two other goroutines
+	// because data goroutine writes both to output signers and errors
+	// channel and we want to avoid a situation when we close the errors channel
+	// and errors goroutine tries to write to it. The same the other way round.
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	// Close channels when signers and errors goroutines are done.
+	go func() {
+		wg.Wait()
+		close(outputKeepSigner)
+		close(outputErrors)
+	}()
+
+	// Errors goroutine - pass thru errors from input channel to output channel
+	// unchanged.
+	go func() {
+		for err := range inputErrors {
+			outputErrors <- err
+		}
+		wg.Done()
+	}()
+
+	// Signers goroutine reads data from input channel, tries to unmarshal
+	// the data to Signer and write the unmarshalled Signer to the output signers
+	// channel. In case of an error, goroutine writes that error to an output
+	// errors channel.
+	go func() {
+		for descriptor := range inputData {
+			// Journal of pending operations is stored next to the signer
+			// but it is not a signer.
+			if isJournalFile(descriptor.Name()) {
+				continue
+			}
+
+			// Index of archived keeps and reliability records of peers
+			// are not keep's directories.
+			if isArchivedKeepsIndex(descriptor.Directory()) ||
+				isPeerReliabilityRecords(descriptor.Directory()) {
+				continue
+			}
+
+			content, err := descriptor.Content()
+			if err != nil {
+				outputErrors <- fmt.Errorf(
+					"failed to decode content from file [%v] in directory [%v]: [%v]",
+					descriptor.Name(),
+					descriptor.Directory(),
+					err,
+				)
+				continue
+			}
+
+			if !common.IsHexAddress(descriptor.Directory()) {
+				outputErrors <- fmt.Errorf(
+					"directory name [%v] is not valid ethereum address",
+					descriptor.Directory(),
+				)
+				continue
+			}
+			keepAddress := common.HexToAddress(descriptor.Directory())
+
+			signer := &tss.ThresholdSigner{}
+			err = signer.Unmarshal(content)
+			if err != nil {
+				outputErrors <- fmt.Errorf(
+					"failed to unmarshal signer from file [%v] in directory [%v]: [%v]",
+					descriptor.Name(),
+					descriptor.Directory(),
+					err,
+				)
+				continue
+			}
+
+			outputKeepSigner <- &KeepSigner{
+				KeepAddress: keepAddress,
+				Signer:      signer,
+			}
+		}
+
+		wg.Done()
+	}()
+
+	return outputKeepSigner, outputErrors
+}
+
+func (ds *diskStorage) ReadSnapshots() (<-chan *KeepSigner, <-chan error) {
+	outputKeepSigner := make(chan *KeepSigner)
+	outputErrors := make(chan error)
+
+	go func() {
+		defer close(outputErrors)
+		defer close(outputKeepSigner)
+
+		if ds.dataDir == "" {
+			outputErrors <- fmt.Errorf("snapshots are not available")
+			return
+		}
+
+		snapshotsPath := filepath.Join(ds.dataDir, snapshotDirectory)
+		keepsDirectories, err := ioutil.ReadDir(snapshotsPath)
+		if err != nil {
+			outputErrors <- fmt.Errorf(
+				"could not read the directory [%s]: [%v]",
+				snapshotsPath,
+				err,
+			)
+			return
+		}
+
+		for _, keepDirectory := range keepsDirectories {
+			if !keepDirectory.IsDir() ||
+				!common.IsHexAddress(keepDirectory.Name()) {
+				continue
+			}
+			keepAddress := common.HexToAddress(keepDirectory.Name())
+
+			keepPath := filepath.Join(snapshotsPath, keepDirectory.Name())
+			files, err := ioutil.ReadDir(keepPath)
+			if err != nil {
+				outputErrors <- fmt.Errorf(
+					"could not read the directory [%s]: [%v]",
+					keepPath,
+					err,
+				)
+				continue
+			}
+
+			for _, file := range files {
+				signer, err := ds.readSnapshot(filepath.Join(keepPath, file.Name()))
+				if err != nil {
+					outputErrors <- fmt.Errorf(
+						"failed to read snapshot [%s] of keep [%s]: [%v]",
+						file.Name(),
+						keepAddress.String(),
+						err,
+					)
+					continue
+				}
+
+				outputKeepSigner <- &KeepSigner{
+					KeepAddress: keepAddress,
+					Signer:      signer,
+				}
+			}
+		}
+	}()
+
+	return outputKeepSigner, outputErrors
+}
+
+// DeleteSnapshots removes snapshot files of the keep holding the given signer.
+// The current signer is stored in a separate directory and is not affected.
+func (ds *diskStorage) DeleteSnapshots(
+	keepAddress common.Address,
+	signer *tss.ThresholdSigner,
+) error {
+	if ds.dataDir == "" {
+		return fmt.Errorf("snapshots are not available")
+	}
+
+	signerBytes, err := signer.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal signer: [%v]", err)
+	}
+
+	keepPath := filepath.Join(
+		ds.dataDir,
+		snapshotDirectory,
+		keepAddress.String(),
+	)
+	files, err := ioutil.ReadDir(keepPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf(
+			"could not read the directory [%s]: [%v]",
+			keepPath,
+			err,
+		)
+	}
+
+	for _, file := range files {
+		path := filepath.Join(keepPath, file.Name())
+
+		content, err := ds.readFile(path)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to read snapshot [%s] of keep [%s]: [%v]",
+				file.Name(),
+				keepAddress.String(),
+				err,
+			)
+		}
+
+		if !bytes.Equal(content, signerBytes) {
+			continue
+		}
+
+		if err := os.Remove(path); err != nil {
+			return fmt.Errorf(
+				"could not remove snapshot [%s] of keep [%s]: [%v]",
+				file.Name(),
+				keepAddress.String(),
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (ds *diskStorage) readSnapshot(path string) (*tss.ThresholdSigner, error) {
+	// #nosec G304 (file path provided as taint input)
+	// The path is read from the storage directory.
+	encrypted, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := ds.box.Decrypt(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt: [%v]", err)
+	}
+
+	signer := &tss.ThresholdSigner{}
+	if err := signer.Unmarshal(content); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal signer: [%v]", err)
+	}
+
+	return signer, nil
+}
+
+func (ds *diskStorage) Archive(keepAddress common.Address) error {
+	return ds.handle.Archive(keepAddress.String())
+}
+
+func (ds *diskStorage) SaveArchivedKeeps(
+	keepsAddresses []common.Address,
+) error {
+	content, err := json.Marshal(keepsAddresses)
+	if err != nil {
+		return fmt.Errorf("failed to marshal archived keeps: [%v]", err)
+	}
+
+	return ds.handle.Save(
+		content,
+		archivedKeepsDirectory,
+		"/"+archivedKeepsFileName,
+	)
+}
+
+func (ds *diskStorage) ReadArchivedKeeps() ([]common.Address, error) {
+	content, err := ds.readCurrentFile(
+		archivedKeepsDirectory,
+		archivedKeepsFileName,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archived keeps: [%v]", err)
+	}
+	if content == nil {
+		return nil, nil
+	}
+
+	var keepsAddresses []common.Address
+	if err := json.Unmarshal(content, &keepsAddresses); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal archived keeps: [%v]", err)
+	}
+
+	return keepsAddresses, nil
+}
+
+func (ds *diskStorage) SavePeerReliability(content []byte) error {
+	return ds.handle.Save(
+		content,
+		peerReliabilityDirectory,
+		"/"+peerReliabilityFileName,
+	)
+}
+
+func (ds *diskStorage) ReadPeerReliability() ([]byte, error) {
+	content, err := ds.readCurrentFile(
+		peerReliabilityDirectory,
+		peerReliabilityFileName,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to read peer reliability records: [%v]",
+			err,
+		)
+	}
+
+	return content, nil
+}
+
+func (ds *diskStorage) SaveJournal(
+	keepAddress common.Address,
+	content []byte,
+) error {
+	return ds.handle.Save(
+		content,
+		keepAddress.String(),
+		"/"+journalFileName,
+	)
+}
+
+func (ds *diskStorage) ReadJournals() (map[common.Address][]byte, error) {
+	journals := make(map[common.Address][]byte)
+
+	if ds.dataDir == "" {
+		for _, descriptor := range ds.scanHandle(
+			func(directory, name string) bool { return isJournalFile(name) },
+		) {
+			if !common.IsHexAddress(descriptor.Directory()) {
+				logger.Errorf(
+					"journal directory name [%v] is not valid ethereum address",
+					descriptor.Directory(),
+				)
+				continue
+			}
+			keepAddress := common.HexToAddress(descriptor.Directory())
+
+			content, err := descriptor.Content()
+			if err != nil {
+				logger.Errorf(
+					"failed to read journal of keep [%s]: [%v]",
+					keepAddress.String(),
+					err,
+				)
+				continue
+			}
+
+			journals[keepAddress] = content
+		}
+
+		return journals, nil
+	}
+
+	// Journals are stored next to signers so only journal files of keep
+	// directories are read, signers are not touched.
+	paths, err := filepath.Glob(
+		filepath.Join(ds.dataDir, currentDirectory, "*", journalFileName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find journals: [%v]", err)
+	}
+
+	for _, path := range paths {
+		directory := filepath.Base(filepath.Dir(path))
+		if !common.IsHexAddress(directory) {
+			logger.Errorf(
+				"journal directory name [%v] is not valid ethereum address",
+				directory,
+			)
+			continue
+		}
+		keepAddress := common.HexToAddress(directory)
+
+		content, err := ds.readFile(path)
+		if err != nil {
+			logger.Errorf(
+				"failed to read journal of keep [%s]: [%v]",
+				keepAddress.String(),
+				err,
+			)
+			continue
+		}
+
+		journals[keepAddress] = content
+	}
+
+	return journals, nil
+}
+
+// readCurrentFile reads and decrypts the file with the given name from the
+// given directory of current data. Nil content is returned if the file does
+// not exist.
+func (ds *diskStorage) readCurrentFile(directory, name string) ([]byte, error) {
+	if ds.dataDir == "" {
+		var content []byte
+		for _, descriptor := range ds.scanHandle(
+			func(descriptorDirectory, descriptorName string) bool {
+				return descriptorDirectory == directory &&
+					strings.TrimPrefix(descriptorName, "/") == name
+			},
+		) {
+			data, err := descriptor.Content()
+			if err != nil {
+				return nil, err
+			}
+			content = data
+		}
+
+		return content, nil
+	}
+
+	content, err := ds.readFile(
+		filepath.Join(ds.dataDir, currentDirectory, directory, name),
+	)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	return content, err
+}
+
+// readFile reads and decrypts the file under the given path.
+func (ds *diskStorage) readFile(path string) ([]byte, error) {
+	// #nosec G304 (file path provided as taint input)
+	// The path is built from the storage directory.
+	encrypted, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := ds.box.Decrypt(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt: [%v]", err)
+	}
+
+	return content, nil
+}
+
+// scanHandle reads all files of the persistence handle and returns those
+// accepted by the filter. The handle does not support reading single files so
+// the scan is used only if the storage has not been created for the data
+// directory.
+func (ds *diskStorage) scanHandle(
+	accept func(directory, name string) bool,
+) []persistence.DataDescriptor {
+	inputData, inputErrors := ds.handle.ReadAll()
+
+	var descriptors []persistence.DataDescriptor
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		for err := range inputErrors {
+			logger.Errorf("could not read from storage: [%v]", err)
+		}
+		wg.Done()
+	}()
+
+	go func() {
+		for descriptor := range inputData {
+			if accept(descriptor.Directory(), descriptor.Name()) {
+				descriptors = append(descriptors, descriptor)
+			}
+		}
+		wg.Done()
+	}()
+
+	wg.Wait()
+
+	return descriptors
+}
+
+// preParamsDirectory is the name of the data directory holding TSS
+// pre-parameters. It is kept outside of the persistence handle directories
+// so that each entry can be deleted separately.
+const preParamsDirectory = "tss_pre_params"
+
+func (ds *diskStorage) preParamsPath() (string, error) {
+	if ds.dataDir == "" {
+		return "", fmt.Errorf("pre-parameters storage is not available")
+	}
+
+	return filepath.Join(ds.dataDir, preParamsDirectory), nil
+}
+
+func (ds *diskStorage) SavePreParams(id string, content []byte) error {
+	preParamsPath, err := ds.preParamsPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(preParamsPath, 0700); err != nil {
+		return fmt.Errorf(
+			"could not create directory [%s]: [%v]",
+			preParamsPath,
+			err,
+		)
+	}
+
+	encrypted, err := ds.box.Encrypt(content)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt pre-parameters: [%v]", err)
+	}
+
+	// The file is written atomically by renaming a temporary file, so
+	// a partially written entry is never loaded.
+	path := filepath.Join(preParamsPath, id)
+	temporaryPath := path + ".tmp"
+	if err := ioutil.WriteFile(temporaryPath, encrypted, 0600); err != nil {
+		return fmt.Errorf("could not write file [%s]: [%v]", temporaryPath, err)
+	}
+
+	if err := os.Rename(temporaryPath, path); err != nil {
+		return fmt.Errorf("could not write file [%s]: [%v]", path, err)
+	}
+
+	return nil
+}
+
+func (ds *diskStorage) ReadPreParams() (map[string][]byte, error) {
+	preParamsPath, err := ds.preParamsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := ioutil.ReadDir(preParamsPath)
+	if os.IsNotExist(err) {
+		return map[string][]byte{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf(
+			"could not read directory [%s]: [%v]",
+			preParamsPath,
+			err,
+		)
+	}
+
+	preParams := make(map[string][]byte)
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) == ".tmp" {
+			continue
+		}
+
+		path := filepath.Join(preParamsPath, file.Name())
+
+		// #nosec G304 (file path provided as taint input)
+		// The path is read from the storage directory.
+		encrypted, err := ioutil.ReadFile(path)
+		if err != nil {
+			logger.Errorf("could not read file [%s]: [%v]", path, err)
+			continue
+		}
+
+		content, err := ds.box.Decrypt(encrypted)
+		if err != nil {
+			logger.Errorf(
+				"failed to decrypt pre-parameters [%s]: [%v]",
+				file.Name(),
+				err,
+			)
+			continue
+		}
+
+		preParams[file.Name()] = content
+	}
+
+	return preParams, nil
+}
+
+func (ds *diskStorage) DeletePreParams(id string) error {
+	preParamsPath, err := ds.preParamsPath()
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(preParamsPath, id)
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("could not delete file [%s]: [%v]", path, err)
+	}
+
+	return nil
+}
+
+// Close does nothing as the persistence handle holds no resources.
+func (ds *diskStorage) Close() error {
+	return nil
+}
+
+func isArchivedKeepsIndex(directory string) bool {
+	return directory == archivedKeepsDirectory
+}
+
+func isPeerReliabilityRecords(directory string) bool {
+	return directory == peerReliabilityDirectory
+}
+
+// snapshotDirectory is the directory of the disk persistence holding snapshots
+// of signers.
+const snapshotDirectory = "snapshot"
+
+// currentDirectory is the directory of the disk persistence holding current
+// data of keeps and indexes of the storage.
+const currentDirectory = "current"
+
+// diskDirectories are directories of the disk persistence holding current,
+// snapshot and archived data of keeps.
+var diskDirectories = []string{currentDirectory, snapshotDirectory, "archive"}
+
+// diskRecord is a file re-encrypted by the key rotation. Paths of records
+// and information if they are signers are persisted in the rotation marker.
+type diskRecord struct {
+	Path     string `json:"path"`
+	IsSigner bool   `json:"isSigner"`
+
+	content []byte
+}
+
+// rotateDiskEncryptionKey re-encrypts all files of the disk storage. All
+// re-encrypted files are staged first and the original files are replaced only
+// once all of them are written. If the previous rotation to the same new key
+// has been interrupted while replacing files, it is completed instead.
+func rotateDiskEncryptionKey(
+	dataDir string,
+	currentBox encryption.Box,
+	newBox encryption.Box,
+) (int, error) {
+	completed, err := completeDiskKeyRotation(dataDir, newBox)
+	if err != nil {
+		return 0, err
+	}
+	if completed > 0 {
+		logger.Infof(
+			"completed interrupted key rotation of [%d] records",
+			completed,
+		)
+		return completed, nil
+	}
+
+	records, err := collectDiskRecords(dataDir, currentBox, newBox)
+	if err != nil {
+		return 0, err
+	}
+
+	// All records have been verified. They are staged first and replace
+	// the original files only once all of them are written.
+	if err := stageDiskRecords(dataDir, records); err != nil {
+		return 0, err
+	}
+
+	return commitDiskKeyRotation(dataDir, records)
+}
+
+// collectDiskRecords reads all files of the disk storage and re-encrypts them
+// with the new box. Each file is verified by decrypting it with the current box
+// and the new ciphertext is verified as well.
+func collectDiskRecords(
+	dataDir string,
+	currentBox encryption.Box,
+	newBox encryption.Box,
+) ([]*diskRecord, error) {
+	var records []*diskRecord
+
+	for _, directory := range diskDirectories {
+		keepsDirectories, err := ioutil.ReadDir(filepath.Join(dataDir, directory))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not read directory [%s]: [%v]",
+				directory,
+				err,
+			)
+		}
+
+		for _, keepDirectory := range keepsDirectories {
+			if !keepDirectory.IsDir() {
+				continue
+			}
+
+			keepPath := filepath.Join(dataDir, directory, keepDirectory.Name())
+			files, err := ioutil.ReadDir(keepPath)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"could not read directory [%s]: [%v]",
+					keepPath,
+					err,
+				)
+			}
+
+			for _, file := range files {
+				path := filepath.Join(keepPath, file.Name())
+
+				// #nosec G304 (file path provided as taint input)
+				// The path is read from the storage directory.
+				encrypted, err := ioutil.ReadFile(path)
+				if err != nil {
+					return nil, fmt.Errorf(
+						"could not read file [%s]: [%v]",
+						path,
+						err,
+					)
+				}
+
+				isSigner := !isJournalFile(file.Name()) &&
+					!isArchivedKeepsIndex(keepDirectory.Name()) &&
+					!isPeerReliabilityRecords(keepDirectory.Name())
+
+				content, err := reencrypt(isSigner, encrypted, currentBox, newBox)
+				if err != nil {
+					return nil, fmt.Errorf(
+						"could not re-encrypt file [%s]: [%v]",
+						path,
+						err,
+					)
+				}
+
+				records = append(records, &diskRecord{
+					Path:     path,
+					IsSigner: isSigner,
+					content:  content,
+				})
+			}
+		}
+	}
+
+	preParamsPath := filepath.Join(dataDir, preParamsDirectory)
+	preParamsFiles, err := ioutil.ReadDir(preParamsPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf(
+			"could not read directory [%s]: [%v]",
+			preParamsPath,
+			err,
+		)
+	}
+
+	for _, file := range preParamsFiles {
+		if file.IsDir() || filepath.Ext(file.Name()) == ".tmp" {
+			continue
+		}
+
+		path := filepath.Join(preParamsPath, file.Name())
+
+		// #nosec G304 (file path provided as taint input)
+		// The path is read from the storage directory.
+		encrypted, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("could not read file [%s]: [%v]", path, err)
+		}
+
+		content, err := reencrypt(false, encrypted, currentBox, newBox)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not re-encrypt file [%s]: [%v]",
+				path,
+				err,
+			)
+		}
+
+		records = append(records, &diskRecord{Path: path, content: content})
+	}
+
+	return records, nil
+}
+
+// rotationMarkerFileName is the name of the file in the data directory
+// listing files re-encrypted by the key rotation. It is written once all
+// re-encrypted files are staged next to the original ones and removed once
+// all of them replaced the original ones. If the marker exists, the rotation
+// has been interrupted while replacing files and it has to be completed.
+const rotationMarkerFileName = "encryption_key_rotation"
+
+// rotatedFileExtension is the extension of files staged by the key rotation.
+const rotatedFileExtension = ".rotated"
+
+// stageDiskRecords writes re-encrypted records next to the original files and
+// then writes the rotation marker listing them. The original files are not
+// modified.
+func stageDiskRecords(dataDir string, records []*diskRecord) error {
+	for _, record := range records {
+		if err := writeFileSynced(
+			record.Path+rotatedFileExtension,
+			record.content,
+		); err != nil {
+			return err
+		}
+	}
+
+	marker, err := json.Marshal(records)
+	if err != nil {
+		return fmt.Errorf("could not marshal rotation marker: [%v]", err)
+	}
+
+	markerPath := filepath.Join(dataDir, rotationMarkerFileName)
+	temporaryPath := markerPath + ".tmp"
+	if err := writeFileSynced(temporaryPath, marker); err != nil {
+		return err
+	}
+
+	if err := os.Rename(temporaryPath, markerPath); err != nil {
+		return fmt.Errorf(
+			"could not write rotation marker [%s]: [%v]",
+			markerPath,
+			err,
+		)
+	}
+
+	return nil
+}
+
+// commitDiskKeyRotation replaces the original files with the staged ones and
+// removes the rotation marker. Records already replaced are skipped, so it can
+// be called again if it has been interrupted. The number of records of
+// the rotation is returned.
+func commitDiskKeyRotation(dataDir string, records []*diskRecord) (int, error) {
+	for _, record := range records {
+		temporaryPath := record.Path + rotatedFileExtension
+		if _, err := os.Stat(temporaryPath); os.IsNotExist(err) {
+			continue
+		}
+
+		if err := os.Rename(temporaryPath, record.Path); err != nil {
+			return 0, fmt.Errorf(
+				"could not replace file [%s]: [%v]",
+				record.Path,
+				err,
+			)
+		}
+	}
+
+	markerPath := filepath.Join(dataDir, rotationMarkerFileName)
+	if err := os.Remove(markerPath); err != nil {
+		return 0, fmt.Errorf(
+			"could not remove rotation marker [%s]: [%v]",
+			markerPath,
+			err,
+		)
+	}
+
+	return len(records), nil
+}
+
+// completeDiskKeyRotation completes the key rotation interrupted while
+// replacing files, if there is one. Files staged by the interrupted rotation
+// are verified with the given box first, so the rotation is completed only
+// if it re-encrypted files with the key of the box. Staged files of a rotation
+// interrupted before all of them were written are removed, as the original
+// files were not modified. The number of records of the completed rotation
+// is returned.
+func completeDiskKeyRotation(dataDir string, box encryption.Box) (int, error) {
+	markerPath := filepath.Join(dataDir, rotationMarkerFileName)
+
+	// #nosec G304 (file path provided as taint input)
+	// The path is read from the storage directory.
+	marker, err := ioutil.ReadFile(markerPath)
+	if os.IsNotExist(err) {
+		return 0, removeStagedDiskRecords(dataDir)
+	}
+	if err != nil {
+		return 0, fmt.Errorf(
+			"could not read rotation marker [%s]: [%v]",
+			markerPath,
+			err,
+		)
+	}
+
+	var records []*diskRecord
+	if err := json.Unmarshal(marker, &records); err != nil {
+		return 0, fmt.Errorf(
+			"could not unmarshal rotation marker [%s]: [%v]",
+			markerPath,
+			err,
+		)
+	}
+
+	for _, record := range records {
+		temporaryPath := record.Path + rotatedFileExtension
+
+		// #nosec G304 (file path provided as taint input)
+		// The path is read from the rotation marker in the storage directory.
+		encrypted, err := ioutil.ReadFile(temporaryPath)
+		if os.IsNotExist(err) {
+			// The file has been already replaced.
+			continue
+		}
+		if err != nil {
+			return 0, fmt.Errorf(
+				"could not read file [%s]: [%v]",
+				temporaryPath,
+				err,
+			)
+		}
+
+		content, err := box.Decrypt(encrypted)
+		if err == nil {
+			err = verifyRecord(record.IsSigner, content)
+		}
+		if err != nil {
+			return 0, fmt.Errorf(
+				"storage has a key rotation interrupted while replacing "+
+					"files which can not be completed with the given key; "+
+					"use the new key of that rotation: [%v]",
+				err,
+			)
+		}
+	}
+
+	return commitDiskKeyRotation(dataDir, records)
+}
+
+// removeStagedDiskRecords removes files staged by a key rotation interrupted
+// before the rotation marker has been written.
+func removeStagedDiskRecords(dataDir string) error {
+	patterns := []string{
+		filepath.Join(dataDir, preParamsDirectory, "*"+rotatedFileExtension),
+	}
+	for _, directory := range diskDirectories {
+		patterns = append(
+			patterns,
+			filepath.Join(dataDir, directory, "*", "*"+rotatedFileExtension),
+		)
+	}
+
+	for _, pattern := range patterns {
+		paths, err := filepath.Glob(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern [%s]: [%v]", pattern, err)
+		}
+
+		for _, path := range paths {
+			logger.Warningf(
+				"removing file [%s] of an interrupted key rotation",
+				path,
+			)
+			if err := os.Remove(path); err != nil {
+				return fmt.Errorf(
+					"could not remove file [%s]: [%v]",
+					path,
+					err,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+func writeFileSynced(path string, content []byte) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("could not create file [%s]: [%v]", path, err)
+	}
+
+	if _, err := file.Write(content); err != nil {
+		file.Close()
+		return fmt.Errorf("could not write file [%s]: [%v]", path, err)
+	}
+
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return fmt.Errorf("could not sync file [%s]: [%v]", path, err)
+	}
+
+	return file.Close()
+}
@@ -0,0 +1,286 @@
+package registry
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/keep-network/keep-common/pkg/encryption"
+	"github.com/keep-network/keep-common/pkg/persistence"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+	"golang.org/x/crypto/scrypt"
+)
+
+// KeyLength is the byte size of the storage encryption key.
+const KeyLength = encryption.KeyLength
+
+// EncryptionKey is the key encrypting signers and journals in the storage.
+type EncryptionKey [KeyLength]byte
+
+// saltFileName is the name of the file in the data directory holding the salt
+// used to derive the encryption key from a passphrase.
+const saltFileName = "encryption_salt"
+
+// SaltLength is the byte size of the salt used to derive a key from
+// a passphrase.
+const SaltLength = 32
+
+// Parameters of the scrypt key derivation function used to derive a key
+// from a passphrase.
+const (
+	scryptN = 1 << 18
+	scryptR = 8
+	scryptP = 1
+)
+
+// EncryptionKeySource describes where the storage encryption key comes from.
+// Sources are checked in the order of fields and the first one set is used.
+type EncryptionKeySource struct {
+	// KeyFile is the file holding the hex-encoded key.
+	KeyFile string
+	// Key is the hex-encoded key.
+	Key string
+	// Passphrase is the passphrase the key is derived from with scrypt.
+	// Salt is generated on the first use and kept in the data directory.
+	Passphrase string
+	// Password is the Ethereum key file password the key has been derived
+	// from before a dedicated key could be configured. It is used only if
+	// none of the other sources is set.
+	Password string
+}
+
+// IsDedicated returns true if the source defines a key dedicated to the
+// storage, not derived from the Ethereum key file password.
+func (eks *EncryptionKeySource) IsDedicated() bool {
+	return eks.KeyFile != "" || eks.Key != "" || eks.Passphrase != ""
+}
+
+// ResolveEncryptionKey returns the storage encryption key from the given
+// source. Data directory is used to keep the salt of the key derived from
+// a passphrase.
+func ResolveEncryptionKey(
+	source *EncryptionKeySource,
+	dataDir string,
+) (EncryptionKey, error) {
+	switch {
+	case source.KeyFile != "":
+		content, err := ioutil.ReadFile(source.KeyFile)
+		if err != nil {
+			return EncryptionKey{}, fmt.Errorf(
+				"failed to read encryption key file: [%v]",
+				err,
+			)
+		}
+
+		return decodeEncryptionKey(strings.TrimSpace(string(content)))
+	case source.Key != "":
+		return decodeEncryptionKey(source.Key)
+	case source.Passphrase != "":
+		salt, err := readOrCreateSalt(dataDir)
+		if err != nil {
+			return EncryptionKey{}, err
+		}
+
+		return DeriveKey(source.Passphrase, salt)
+	case source.Password != "":
+		// The same derivation as in keep-common encrypted persistence so
+		// the data stored before a dedicated key could be configured can
+		// still be read.
+		return sha256.Sum256([]byte(source.Password)), nil
+	default:
+		return EncryptionKey{}, fmt.Errorf("encryption key is not configured")
+	}
+}
+
+// DeriveKey derives the key from the passphrase and the salt with scrypt.
+// The same derivation is used for the storage and for backup archives.
+func DeriveKey(passphrase string, salt []byte) (EncryptionKey, error) {
+	derived, err := scrypt.Key(
+		[]byte(passphrase),
+		salt,
+		scryptN,
+		scryptR,
+		scryptP,
+		KeyLength,
+	)
+	if err != nil {
+		return EncryptionKey{}, fmt.Errorf("failed to derive key: [%v]", err)
+	}
+
+	var key EncryptionKey
+	copy(key[:], derived)
+	return key, nil
+}
+
+func decodeEncryptionKey(keyHex string) (EncryptionKey, error) {
+	keyBytes, err := hex.DecodeString(strings.TrimPrefix(keyHex, "0x"))
+	if err != nil {
+		return EncryptionKey{}, fmt.Errorf(
+			"failed to decode encryption key: [%v]",
+			err,
+		)
+	}
+
+	if len(keyBytes) != KeyLength {
+		return EncryptionKey{}, fmt.Errorf(
+			"encryption key must be [%d] bytes long but is [%d]",
+			KeyLength,
+			len(keyBytes),
+		)
+	}
+
+	var key EncryptionKey
+	copy(key[:], keyBytes)
+	return key, nil
+}
+
+func readOrCreateSalt(dataDir string) ([]byte, error) {
+	saltPath := filepath.Join(dataDir, saltFileName)
+
+	content, err := ioutil.ReadFile(saltPath)
+	if err == nil {
+		return hex.DecodeString(strings.TrimSpace(string(content)))
+	}
+	if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read encryption salt: [%v]", err)
+	}
+
+	salt := make([]byte, SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate encryption salt: [%v]", err)
+	}
+
+	if err := ioutil.WriteFile(
+		saltPath,
+		[]byte(hex.EncodeToString(salt)),
+		0600,
+	); err != nil {
+		return nil, fmt.Errorf("failed to write encryption salt: [%v]", err)
+	}
+
+	return salt, nil
+}
+
+// encryptedHandle is a persistence handle encrypting all the data with the
+// given box before they are passed to the delegate handle.
+type encryptedHandle struct {
+	delegate persistence.Handle
+	box      encryption.Box
+}
+
+func newEncryptedHandle(
+	delegate persistence.Handle,
+	box encryption.Box,
+) persistence.Handle {
+	return &encryptedHandle{delegate, box}
+}
+
+func (eh *encryptedHandle) Save(data []byte, directory string, name string) error {
+	encrypted, err := eh.box.Encrypt(data)
+	if err != nil {
+		return err
+	}
+
+	return eh.delegate.Save(encrypted, directory, name)
+}
+
+func (eh *encryptedHandle) Snapshot(data []byte, directory string, name string) error {
+	encrypted, err := eh.box.Encrypt(data)
+	if err != nil {
+		return err
+	}
+
+	return eh.delegate.Snapshot(encrypted, directory, name)
+}
+
+func (eh *encryptedHandle) ReadAll() (<-chan persistence.DataDescriptor, <-chan error) {
+	outputData := make(chan persistence.DataDescriptor)
+
+	inputData, inputErrors := eh.delegate.ReadAll()
+
+	go func() {
+		defer close(outputData)
+		for descriptor := range inputData {
+			outputData <- &decryptingDescriptor{descriptor, eh.box}
+		}
+	}()
+
+	return outputData, inputErrors
+}
+
+func (eh *encryptedHandle) Archive(directory string) error {
+	return eh.delegate.Archive(directory)
+}
+
+type decryptingDescriptor struct {
+	persistence.DataDescriptor
+	box encryption.Box
+}
+
+func (dd *decryptingDescriptor) Content() ([]byte, error) {
+	content, err := dd.DataDescriptor.Content()
+	if err != nil {
+		return nil, err
+	}
+
+	return dd.box.Decrypt(content)
+}
+
+// verifyRecord checks if the decrypted record can be unmarshalled. Signers
+// are unmarshalled with their protobuf definition, all other records, like
+// journals, are JSON documents.
+func verifyRecord(isSigner bool, content []byte) error {
+	if !isSigner {
+		if !json.Valid(content) {
+			return fmt.Errorf("record is not a valid JSON document")
+		}
+		return nil
+	}
+
+	signer := &tss.ThresholdSigner{}
+	if err := signer.Unmarshal(content); err != nil {
+		return fmt.Errorf("failed to unmarshal signer: [%v]", err)
+	}
+
+	return nil
+}
+
+// reencrypt decrypts the record with the current box, verifies it and
+// encrypts it with the new box. The new ciphertext is verified as well.
+func reencrypt(
+	isSigner bool,
+	encrypted []byte,
+	currentBox encryption.Box,
+	newBox encryption.Box,
+) ([]byte, error) {
+	content, err := currentBox.Decrypt(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt: [%v]", err)
+	}
+
+	if err := verifyRecord(isSigner, content); err != nil {
+		return nil, err
+	}
+
+	reencrypted, err := newBox.Encrypt(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt: [%v]", err)
+	}
+
+	decrypted, err := newBox.Decrypt(reencrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt with the new key: [%v]", err)
+	}
+
+	if err := verifyRecord(isSigner, decrypted); err != nil {
+		return nil, fmt.Errorf("verification with the new key failed: [%v]", err)
+	}
+
+	return reencrypted, nil
+}
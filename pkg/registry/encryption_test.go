@@ -0,0 +1,424 @@
+package registry
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-common/pkg/encryption"
+	"github.com/keep-network/keep-common/pkg/persistence"
+)
+
+func TestResolveEncryptionKey(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "encryption-key-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dataDir)
+
+	key := EncryptionKey{1, 2, 3}
+	keyHex := hex.EncodeToString(key[:])
+
+	keyFile := filepath.Join(dataDir, "key")
+	if err := ioutil.WriteFile(keyFile, []byte(keyHex+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = map[string]struct {
+		source        *EncryptionKeySource
+		expectedKey   EncryptionKey
+		expectedError bool
+	}{
+		"key file": {
+			source:      &EncryptionKeySource{KeyFile: keyFile, Password: "pass"},
+			expectedKey: key,
+		},
+		"key": {
+			source:      &EncryptionKeySource{Key: "0x" + keyHex},
+			expectedKey: key,
+		},
+		"too short key": {
+			source:        &EncryptionKeySource{Key: "0102"},
+			expectedError: true,
+		},
+		"no source": {
+			source:        &EncryptionKeySource{},
+			expectedError: true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			actualKey, err := ResolveEncryptionKey(test.source, dataDir)
+			if test.expectedError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if actualKey != test.expectedKey {
+				t.Errorf(
+					"unexpected key\nexpected: [%x]\nactual:   [%x]",
+					test.expectedKey,
+					actualKey,
+				)
+			}
+		})
+	}
+}
+
+func TestResolveEncryptionKeyFromPassphrase(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "encryption-key-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dataDir)
+
+	source := &EncryptionKeySource{Passphrase: "correct horse battery staple"}
+
+	key1, err := ResolveEncryptionKey(source, dataDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Salt is persisted so the same key is derived again.
+	key2, err := ResolveEncryptionKey(source, dataDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if key1 != key2 {
+		t.Errorf("keys derived from the same passphrase differ")
+	}
+}
+
+func TestPasswordKeyCompatibleWithEncryptedPersistence(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "encryption-key-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dataDir)
+
+	signers, err := testSigners()
+	if err != nil {
+		t.Fatalf("failed to get signers: [%v]", err)
+	}
+
+	handle, err := persistence.NewDiskHandle(dataDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	legacyStorage := NewDiskStorage(
+		persistence.NewEncryptedPersistence(handle, "password"),
+	)
+	if err := legacyStorage.Save(keepAddress1, signers[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := ResolveEncryptionKey(
+		&EncryptionKeySource{Password: "password"},
+		dataDir,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	storage, err := NewStorage(DiskBackend, dataDir, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loaded := readAllSigners(t, storage)
+	if !reflect.DeepEqual(signers[0], loaded[keepAddress1]) {
+		t.Errorf(
+			"unexpected signer\nexpected: [%v]\nactual:   [%v]",
+			signers[0],
+			loaded[keepAddress1],
+		)
+	}
+}
+
+func TestRotateEncryptionKey(t *testing.T) {
+	for _, backend := range []string{DiskBackend, BoltBackend} {
+		t.Run(backend, func(t *testing.T) {
+			dataDir, err := ioutil.TempDir("", "rotate-key-test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dataDir)
+
+			signers, err := testSigners()
+			if err != nil {
+				t.Fatalf("failed to get signers: [%v]", err)
+			}
+
+			currentKey := EncryptionKey{1}
+			newKey := EncryptionKey{2}
+
+			storage, err := NewStorage(backend, dataDir, currentKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			kr := NewKeepsRegistry(storage)
+			if err := kr.SnapshotSigner(keepAddress1, signers[0]); err != nil {
+				t.Fatal(err)
+			}
+			if err := kr.RegisterSigner(keepAddress1, signers[0]); err != nil {
+				t.Fatal(err)
+			}
+			if err := kr.RegisterSigner(keepAddress2, signers[1]); err != nil {
+				t.Fatal(err)
+			}
+			kr.UnregisterKeep(keepAddress2)
+
+			journal := NewJournal(storage)
+			if err := journal.RecordSigningStarted(keepAddress1, [32]byte{1}); err != nil {
+				t.Fatal(err)
+			}
+
+			preParams := []byte("{\"P\":1}")
+			if err := storage.SavePreParams("1", preParams); err != nil {
+				t.Fatal(err)
+			}
+
+			peerReliability := []byte("{}")
+			if err := storage.SavePeerReliability(peerReliability); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := storage.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			// Rotation with a wrong current key must not change anything.
+			if _, err := RotateEncryptionKey(
+				backend,
+				dataDir,
+				EncryptionKey{3},
+				newKey,
+			); err == nil {
+				t.Fatal("expected error for a wrong current key")
+			}
+
+			count, err := RotateEncryptionKey(backend, dataDir, currentKey, newKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Current and archived signers, the journal and pre-parameters.
+			// Disk backend keeps the snapshot as a separate record as well.
+			if count < 4 {
+				t.Errorf(
+					"unexpected number of re-encrypted records\n"+
+						"expected: [>= %v]\nactual:   [%v]",
+					4,
+					count,
+				)
+			}
+
+			storage, err = NewStorage(backend, dataDir, newKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer storage.Close()
+
+			loaded := readAllSigners(t, storage)
+			if !reflect.DeepEqual(signers[0], loaded[keepAddress1]) {
+				t.Errorf(
+					"unexpected signer\nexpected: [%v]\nactual:   [%v]",
+					signers[0],
+					loaded[keepAddress1],
+				)
+			}
+
+			reloaded := NewJournal(storage)
+			reloaded.Load()
+			if len(reloaded.PendingSignings()[keepAddress1]) != 1 {
+				t.Errorf("signing should be pending")
+			}
+
+			reloadedPreParams, err := storage.ReadPreParams()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(preParams, reloadedPreParams["1"]) {
+				t.Errorf(
+					"unexpected pre-parameters\nexpected: [%s]\nactual:   [%s]",
+					preParams,
+					reloadedPreParams["1"],
+				)
+			}
+
+			archivedKeeps, err := storage.ReadArchivedKeeps()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(
+				[]common.Address{keepAddress2},
+				archivedKeeps,
+			) {
+				t.Errorf(
+					"unexpected archived keeps\nexpected: [%v]\nactual:   [%v]",
+					[]common.Address{keepAddress2},
+					archivedKeeps,
+				)
+			}
+
+			reloadedPeerReliability, err := storage.ReadPeerReliability()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(peerReliability, reloadedPeerReliability) {
+				t.Errorf(
+					"unexpected peer reliability\nexpected: [%s]\nactual:   [%s]",
+					peerReliability,
+					reloadedPeerReliability,
+				)
+			}
+		})
+	}
+}
+
+func TestRotateDiskEncryptionKeyInterrupted(t *testing.T) {
+	var tests = map[string]struct {
+		// Number of staged files replacing the original ones before
+		// the rotation has been interrupted; -1 means the rotation has been
+		// interrupted before the rotation marker has been written.
+		replacedFiles int
+		expectedKey   EncryptionKey
+	}{
+		"interrupted while staging files": {
+			replacedFiles: -1,
+			expectedKey:   EncryptionKey{1},
+		},
+		"interrupted before replacing files": {
+			replacedFiles: 0,
+			expectedKey:   EncryptionKey{2},
+		},
+		"interrupted while replacing files": {
+			replacedFiles: 1,
+			expectedKey:   EncryptionKey{2},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			dataDir, err := ioutil.TempDir("", "rotate-key-test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dataDir)
+
+			signers, err := testSigners()
+			if err != nil {
+				t.Fatalf("failed to get signers: [%v]", err)
+			}
+
+			currentKey := EncryptionKey{1}
+			newKey := EncryptionKey{2}
+
+			storage, err := NewStorage(DiskBackend, dataDir, currentKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			kr := NewKeepsRegistry(storage)
+			if err := kr.RegisterSigner(keepAddress1, signers[0]); err != nil {
+				t.Fatal(err)
+			}
+			if err := storage.SavePreParams("1", []byte("{}")); err != nil {
+				t.Fatal(err)
+			}
+
+			records, err := collectDiskRecords(
+				dataDir,
+				encryption.NewBox(currentKey),
+				encryption.NewBox(newKey),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := stageDiskRecords(dataDir, records); err != nil {
+				t.Fatal(err)
+			}
+
+			if test.replacedFiles < 0 {
+				if err := os.Remove(
+					filepath.Join(dataDir, rotationMarkerFileName),
+				); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for i := 0; i < test.replacedFiles; i++ {
+				record := records[i]
+				if err := os.Rename(
+					record.Path+rotatedFileExtension,
+					record.Path,
+				); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			// The storage opened with the key of the interrupted rotation
+			// completes it.
+			if _, err := NewStorage(
+				DiskBackend,
+				dataDir,
+				EncryptionKey{3},
+			); err == nil && test.replacedFiles >= 0 {
+				t.Fatal("expected error for a wrong key")
+			}
+
+			storage, err = NewStorage(DiskBackend, dataDir, test.expectedKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			loaded := readAllSigners(t, storage)
+			if !reflect.DeepEqual(signers[0], loaded[keepAddress1]) {
+				t.Errorf(
+					"unexpected signer\nexpected: [%v]\nactual:   [%v]",
+					signers[0],
+					loaded[keepAddress1],
+				)
+			}
+
+			preParams, err := storage.ReadPreParams()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(preParams) != 1 {
+				t.Errorf(
+					"unexpected number of pre-parameters\n"+
+						"expected: [1]\nactual:   [%d]",
+					len(preParams),
+				)
+			}
+
+			staged, err := filepath.Glob(
+				filepath.Join(dataDir, "*", "*", "*"+rotatedFileExtension),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(staged) != 0 {
+				t.Errorf("staged files have not been removed: [%v]", staged)
+			}
+			if _, err := os.Stat(
+				filepath.Join(dataDir, rotationMarkerFileName),
+			); !os.IsNotExist(err) {
+				t.Errorf("rotation marker has not been removed")
+			}
+		})
+	}
+}
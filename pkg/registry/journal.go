@@ -0,0 +1,288 @@
+package registry
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// journalFileName is the name of the file holding pending operations of the
+// keep. The file is stored in the keep's directory, next to the keep's signer,
+// so it gets archived together with the signer once the keep is closed.
+const journalFileName = "journal"
+
+// PendingKeyGeneration is a key generation started for a keep but not
+// completed yet.
+type PendingKeyGeneration struct {
+	Members         []common.Address
+	HonestThreshold uint64
+	StartedAt       time.Time
+}
+
+// PendingSigning is a signature calculation started for a keep and digest but
+// not completed yet.
+type PendingSigning struct {
+	Digest    [32]byte
+	StartedAt time.Time
+}
+
+// journalRecord holds all pending operations for one keep.
+type journalRecord struct {
+	KeyGeneration *PendingKeyGeneration      `json:",omitempty"`
+	Signings      map[string]*PendingSigning `json:",omitempty"` // digest hex -> signing
+	// KeyRefreshedAt is the time of the last completed key refresh.
+	KeyRefreshedAt *time.Time `json:",omitempty"`
+}
+
+func (jr *journalRecord) isEmpty() bool {
+	return jr.KeyGeneration == nil &&
+		len(jr.Signings) == 0 &&
+		jr.KeyRefreshedAt == nil
+}
+
+// copy returns a copy of the record which can be updated without affecting
+// the original one. Pending operations are never modified in place so they
+// are shared between the copies.
+func (jr *journalRecord) copy() *journalRecord {
+	recordCopy := &journalRecord{
+		KeyGeneration:  jr.KeyGeneration,
+		KeyRefreshedAt: jr.KeyRefreshedAt,
+	}
+
+	if jr.Signings != nil {
+		recordCopy.Signings = make(map[string]*PendingSigning, len(jr.Signings))
+		for digest, signing := range jr.Signings {
+			recordCopy.Signings[digest] = signing
+		}
+	}
+
+	return recordCopy
+}
+
+// Journal is a durable record of key generations and signings which have been
+// started by the client and have not been completed yet. The journal lets the
+// client resume, or abandon, the operations interrupted by a restart. The
+// journal also records when key shares of the keep were last refreshed.
+//
+// Journal entries are stored in the same storage as keep signers. Storage
+// does not support removing data so all pending operations of the keep are
+// kept in one record rewritten on every change.
+type Journal struct {
+	mutex   *sync.Mutex
+	records map[common.Address]*journalRecord
+
+	storage Storage
+}
+
+// NewJournal returns an empty journal using the given storage. Entries
+// already persisted can be loaded with Load.
+func NewJournal(storage Storage) *Journal {
+	return &Journal{
+		mutex:   &sync.Mutex{},
+		records: make(map[common.Address]*journalRecord),
+		storage: storage,
+	}
+}
+
+// Load reads all pending operations persisted in the storage. Records which
+// can not be read are skipped and reported in logs.
+func (j *Journal) Load() {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	journals, err := j.storage.ReadJournals()
+	if err != nil {
+		logger.Errorf("could not load journal from storage: [%v]", err)
+	}
+
+	for keepAddress, content := range journals {
+		record := &journalRecord{}
+		if err := json.Unmarshal(content, record); err != nil {
+			logger.Errorf(
+				"failed to unmarshal journal of keep [%s]: [%v]",
+				keepAddress.String(),
+				err,
+			)
+			continue
+		}
+
+		if !record.isEmpty() {
+			j.records[keepAddress] = record
+		}
+	}
+
+	logger.Infof(
+		"loaded pending operations of [%d] keeps from the local storage",
+		len(j.records),
+	)
+}
+
+// RecordKeyGenerationStarted persists the key generation for the given keep
+// as pending.
+func (j *Journal) RecordKeyGenerationStarted(
+	keepAddress common.Address,
+	members []common.Address,
+	honestThreshold uint64,
+) error {
+	return j.update(keepAddress, func(record *journalRecord) {
+		record.KeyGeneration = &PendingKeyGeneration{
+			Members:         members,
+			HonestThreshold: honestThreshold,
+			StartedAt:       time.Now(),
+		}
+	})
+}
+
+// RecordKeyGenerationCompleted removes the pending key generation for the given
+// keep from the journal. It should be called no matter if the key generation
+// succeeded or has been abandoned.
+func (j *Journal) RecordKeyGenerationCompleted(keepAddress common.Address) error {
+	return j.update(keepAddress, func(record *journalRecord) {
+		record.KeyGeneration = nil
+	})
+}
+
+// RecordSigningStarted persists the signing of the digest for the given keep
+// as pending.
+func (j *Journal) RecordSigningStarted(
+	keepAddress common.Address,
+	digest [32]byte,
+) error {
+	return j.update(keepAddress, func(record *journalRecord) {
+		if record.Signings == nil {
+			record.Signings = make(map[string]*PendingSigning)
+		}
+
+		record.Signings[hex.EncodeToString(digest[:])] = &PendingSigning{
+			Digest:    digest,
+			StartedAt: time.Now(),
+		}
+	})
+}
+
+// RecordSigningCompleted removes the pending signing of the digest for the
+// given keep from the journal. It should be called no matter if the signing
+// succeeded or has been abandoned.
+func (j *Journal) RecordSigningCompleted(
+	keepAddress common.Address,
+	digest [32]byte,
+) error {
+	return j.update(keepAddress, func(record *journalRecord) {
+		delete(record.Signings, hex.EncodeToString(digest[:]))
+	})
+}
+
+// RecordKeyRefreshed persists the current time as the time of the last key
+// refresh for the given keep.
+func (j *Journal) RecordKeyRefreshed(keepAddress common.Address) error {
+	return j.update(keepAddress, func(record *journalRecord) {
+		refreshedAt := time.Now()
+		record.KeyRefreshedAt = &refreshedAt
+	})
+}
+
+// LastKeyRefresh returns the time of the last key refresh for the given keep.
+// The second returned value is false if key shares of the keep have never
+// been refreshed.
+func (j *Journal) LastKeyRefresh(keepAddress common.Address) (time.Time, bool) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	record, exists := j.records[keepAddress]
+	if !exists || record.KeyRefreshedAt == nil {
+		return time.Time{}, false
+	}
+
+	return *record.KeyRefreshedAt, true
+}
+
+// PendingKeyGenerations returns all pending key generations by keep address.
+func (j *Journal) PendingKeyGenerations() map[common.Address]*PendingKeyGeneration {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	keyGenerations := make(map[common.Address]*PendingKeyGeneration)
+	for keepAddress, record := range j.records {
+		if record.KeyGeneration != nil {
+			keyGenerations[keepAddress] = record.KeyGeneration
+		}
+	}
+
+	return keyGenerations
+}
+
+// PendingSignings returns all pending signings by keep address.
+func (j *Journal) PendingSignings() map[common.Address][]*PendingSigning {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	signings := make(map[common.Address][]*PendingSigning)
+	for keepAddress, record := range j.records {
+		for _, signing := range record.Signings {
+			signings[keepAddress] = append(signings[keepAddress], signing)
+		}
+	}
+
+	return signings
+}
+
+// update applies the update to a copy of the keep's record and persists it.
+// The in-memory journal is updated only once the record has been persisted
+// so it never holds changes which would be lost on restart.
+func (j *Journal) update(
+	keepAddress common.Address,
+	updateFn func(record *journalRecord),
+) error {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	record := &journalRecord{}
+	if currentRecord, exists := j.records[keepAddress]; exists {
+		record = currentRecord.copy()
+	}
+
+	wasEmpty := record.isEmpty()
+
+	updateFn(record)
+
+	// Nothing has been pending and nothing is pending now. There is no
+	// reason to write anything, especially for keeps which could have been
+	// already archived.
+	if wasEmpty && record.isEmpty() {
+		return nil
+	}
+
+	content, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to marshal journal of keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+	}
+
+	if err := j.storage.SaveJournal(keepAddress, content); err != nil {
+		return fmt.Errorf(
+			"could not persist journal of keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+	}
+
+	if record.isEmpty() {
+		delete(j.records, keepAddress)
+	} else {
+		j.records[keepAddress] = record
+	}
+
+	return nil
+}
+
+func isJournalFile(name string) bool {
+	return strings.TrimPrefix(name, "/") == journalFileName
+}
@@ -0,0 +1,256 @@
+package registry
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-common/pkg/persistence"
+)
+
+func TestJournalKeyGeneration(t *testing.T) {
+	handle := newInMemoryPersistenceHandle()
+
+	members := []common.Address{keepAddress2, keepAddress3}
+
+	journal := NewJournal(NewDiskStorage(handle))
+	if err := journal.RecordKeyGenerationStarted(
+		keepAddress1,
+		members,
+		2,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded := NewJournal(NewDiskStorage(handle))
+	reloaded.Load()
+
+	pending := reloaded.PendingKeyGenerations()
+	if len(pending) != 1 {
+		t.Fatalf(
+			"unexpected number of pending key generations\n"+
+				"expected: [%d]\nactual:   [%d]",
+			1,
+			len(pending),
+		)
+	}
+
+	keyGeneration, ok := pending[keepAddress1]
+	if !ok {
+		t.Fatalf("key generation for keep [%s] is not pending", keepAddress1.String())
+	}
+	if !reflect.DeepEqual(members, keyGeneration.Members) {
+		t.Errorf(
+			"unexpected members\nexpected: [%v]\nactual:   [%v]",
+			members,
+			keyGeneration.Members,
+		)
+	}
+	if keyGeneration.HonestThreshold != 2 {
+		t.Errorf(
+			"unexpected honest threshold\nexpected: [%d]\nactual:   [%d]",
+			2,
+			keyGeneration.HonestThreshold,
+		)
+	}
+
+	if err := reloaded.RecordKeyGenerationCompleted(keepAddress1); err != nil {
+		t.Fatal(err)
+	}
+
+	completed := NewJournal(NewDiskStorage(handle))
+	completed.Load()
+
+	if len(completed.PendingKeyGenerations()) != 0 {
+		t.Errorf("no key generation should be pending")
+	}
+}
+
+func TestJournalSigning(t *testing.T) {
+	handle := newInMemoryPersistenceHandle()
+
+	digest1 := [32]byte{1}
+	digest2 := [32]byte{2}
+
+	journal := NewJournal(NewDiskStorage(handle))
+	if err := journal.RecordSigningStarted(keepAddress1, digest1); err != nil {
+		t.Fatal(err)
+	}
+	if err := journal.RecordSigningStarted(keepAddress1, digest2); err != nil {
+		t.Fatal(err)
+	}
+	if err := journal.RecordSigningCompleted(keepAddress1, digest1); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded := NewJournal(NewDiskStorage(handle))
+	reloaded.Load()
+
+	pending := reloaded.PendingSignings()[keepAddress1]
+	if len(pending) != 1 {
+		t.Fatalf(
+			"unexpected number of pending signings\n"+
+				"expected: [%d]\nactual:   [%d]",
+			1,
+			len(pending),
+		)
+	}
+	if pending[0].Digest != digest2 {
+		t.Errorf(
+			"unexpected pending digest\nexpected: [%x]\nactual:   [%x]",
+			digest2,
+			pending[0].Digest,
+		)
+	}
+}
+
+func TestJournalKeyRefresh(t *testing.T) {
+	handle := newInMemoryPersistenceHandle()
+
+	journal := NewJournal(NewDiskStorage(handle))
+	if _, refreshed := journal.LastKeyRefresh(keepAddress1); refreshed {
+		t.Errorf("key should not be refreshed")
+	}
+
+	before := time.Now()
+	if err := journal.RecordKeyRefreshed(keepAddress1); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded := NewJournal(NewDiskStorage(handle))
+	reloaded.Load()
+
+	refreshedAt, refreshed := reloaded.LastKeyRefresh(keepAddress1)
+	if !refreshed {
+		t.Fatalf("key refresh for keep [%s] not recorded", keepAddress1.String())
+	}
+	if refreshedAt.Before(before) {
+		t.Errorf(
+			"unexpected key refresh time\nexpected after: [%v]\nactual:         [%v]",
+			before,
+			refreshedAt,
+		)
+	}
+
+	if len(reloaded.PendingKeyGenerations()) != 0 {
+		t.Errorf("no key generation should be pending")
+	}
+	if len(reloaded.PendingSignings()) != 0 {
+		t.Errorf("no signing should be pending")
+	}
+}
+
+func TestJournalNotUpdatedWhenNotPersisted(t *testing.T) {
+	handle := newInMemoryPersistenceHandle()
+
+	digest1 := [32]byte{1}
+	digest2 := [32]byte{2}
+
+	journal := NewJournal(NewDiskStorage(handle))
+	if err := journal.RecordSigningStarted(keepAddress1, digest1); err != nil {
+		t.Fatal(err)
+	}
+
+	handle.saveErr = fmt.Errorf("disk is full")
+
+	if err := journal.RecordSigningCompleted(keepAddress1, digest1); err == nil {
+		t.Errorf("expected error when the journal can not be persisted")
+	}
+	if err := journal.RecordSigningStarted(keepAddress1, digest2); err == nil {
+		t.Errorf("expected error when the journal can not be persisted")
+	}
+
+	pending := journal.PendingSignings()[keepAddress1]
+	if len(pending) != 1 || pending[0].Digest != digest1 {
+		t.Errorf(
+			"only the persisted signing should be pending\n"+
+				"expected: [%x]\nactual:   [%v]",
+			digest1,
+			pending,
+		)
+	}
+}
+
+func TestJournalIsNotLoadedAsSigner(t *testing.T) {
+	handle := newInMemoryPersistenceHandle()
+
+	journal := NewJournal(NewDiskStorage(handle))
+	if err := journal.RecordSigningStarted(keepAddress1, [32]byte{1}); err != nil {
+		t.Fatal(err)
+	}
+
+	kr := NewKeepsRegistry(NewDiskStorage(handle))
+	kr.LoadExistingKeeps()
+
+	if len(kr.GetKeepsAddresses()) != 0 {
+		t.Errorf("journal should not be loaded as a signer")
+	}
+}
+
+// inMemoryPersistenceHandle is a persistence handle keeping the most recently
+// saved data for each directory and file name.
+type inMemoryPersistenceHandle struct {
+	data    map[string]map[string][]byte // directory -> name -> data
+	saveErr error
+}
+
+func newInMemoryPersistenceHandle() *inMemoryPersistenceHandle {
+	return &inMemoryPersistenceHandle{
+		data: make(map[string]map[string][]byte),
+	}
+}
+
+func (imph *inMemoryPersistenceHandle) Save(
+	data []byte,
+	directory string,
+	name string,
+) error {
+	if imph.saveErr != nil {
+		return imph.saveErr
+	}
+
+	if _, ok := imph.data[directory]; !ok {
+		imph.data[directory] = make(map[string][]byte)
+	}
+
+	imph.data[directory][name] = data
+
+	return nil
+}
+
+func (imph *inMemoryPersistenceHandle) Snapshot(
+	data []byte,
+	directory string,
+	name string,
+) error {
+	return nil
+}
+
+func (imph *inMemoryPersistenceHandle) ReadAll() (
+	<-chan persistence.DataDescriptor,
+	<-chan error,
+) {
+	outputData := make(chan persistence.DataDescriptor)
+	outputErrors := make(chan error)
+
+	go func() {
+		for directory, files := range imph.data {
+			for name, content := range files {
+				outputData <- &testDataDescriptor{name, directory, content}
+			}
+		}
+
+		close(outputData)
+		close(outputErrors)
+	}()
+
+	return outputData, outputErrors
+}
+
+func (imph *inMemoryPersistenceHandle) Archive(directory string) error {
+	delete(imph.data, directory)
+
+	return nil
+}
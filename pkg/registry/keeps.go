@@ -6,7 +6,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ipfs/go-log"
-	"github.com/keep-network/keep-common/pkg/persistence"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
 )
 
@@ -17,15 +16,26 @@ type Keeps struct {
 	myKeepsMutex *sync.RWMutex
 	myKeeps      map[common.Address]*tss.ThresholdSigner
 
-	storage storage
+	// archivedKeeps are keeps the client has been a member of which are no
+	// longer active. Signers of those keeps are archived but the operator
+	// may still have to interact with them, for example to withdraw rewards.
+	archivedKeeps map[common.Address]bool
+
+	// loadErrors are errors of records which could not be loaded from
+	// the storage by the last call to LoadExistingKeeps.
+	loadErrors []error
+
+	storage Storage
 }
 
-// NewKeepsRegistry returns an empty keeps registry.
-func NewKeepsRegistry(persistence persistence.Handle) *Keeps {
+// NewKeepsRegistry returns an empty keeps registry persisting signers in the
+// given storage.
+func NewKeepsRegistry(storage Storage) *Keeps {
 	return &Keeps{
-		myKeepsMutex: &sync.RWMutex{},
-		myKeeps:      make(map[common.Address]*tss.ThresholdSigner),
-		storage:      newStorage(persistence),
+		myKeepsMutex:  &sync.RWMutex{},
+		myKeeps:       make(map[common.Address]*tss.ThresholdSigner),
+		archivedKeeps: make(map[common.Address]bool),
+		storage:       storage,
 	}
 }
 
@@ -45,7 +55,39 @@ func (k *Keeps) RegisterSigner(
 		)
 	}
 
-	err := k.storage.save(keepAddress, signer)
+	err := k.storage.Save(keepAddress, signer)
+	if err != nil {
+		return fmt.Errorf(
+			"could not persist signer for keep [%s] in the storage: [%v]",
+			keepAddress.String(),
+			err,
+		)
+	}
+
+	k.myKeeps[keepAddress] = signer
+
+	return nil
+}
+
+// ReplaceSigner replaces the signer registered for the given keep, for example
+// with a signer holding a refreshed key share. The previous signer is
+// overwritten in the storage, so it should be snapshotted before if it needs
+// to be kept.
+func (k *Keeps) ReplaceSigner(
+	keepAddress common.Address,
+	signer *tss.ThresholdSigner,
+) error {
+	k.myKeepsMutex.Lock()
+	defer k.myKeepsMutex.Unlock()
+
+	if _, exists := k.myKeeps[keepAddress]; !exists {
+		return fmt.Errorf(
+			"signer for keep [%s] not registered",
+			keepAddress.String(),
+		)
+	}
+
+	err := k.storage.Save(keepAddress, signer)
 	if err != nil {
 		return fmt.Errorf(
 			"could not persist signer for keep [%s] in the storage: [%v]",
@@ -59,11 +101,51 @@ func (k *Keeps) RegisterSigner(
 	return nil
 }
 
+// GetSignerSnapshots reads snapshots of signers of the keep from the storage.
+// Snapshots which could not be read are skipped and logged.
+func (k *Keeps) GetSignerSnapshots(
+	keepAddress common.Address,
+) []*tss.ThresholdSigner {
+	signersChannel, errorsChannel := k.storage.ReadSnapshots()
+
+	snapshots := []*tss.ThresholdSigner{}
+	for signersChannel != nil || errorsChannel != nil {
+		select {
+		case keepSigner, ok := <-signersChannel:
+			if !ok {
+				signersChannel = nil
+				continue
+			}
+			if keepSigner.KeepAddress == keepAddress {
+				snapshots = append(snapshots, keepSigner.Signer)
+			}
+		case err, ok := <-errorsChannel:
+			if !ok {
+				errorsChannel = nil
+				continue
+			}
+			logger.Errorf("could not read signer snapshot: [%v]", err)
+		}
+	}
+
+	return snapshots
+}
+
+// DeleteSignerSnapshots deletes snapshots of the given signer of the keep, for
+// example once the signer has been replaced with a signer holding a refreshed
+// key share and is no longer needed.
+func (k *Keeps) DeleteSignerSnapshots(
+	keepAddress common.Address,
+	signer *tss.ThresholdSigner,
+) error {
+	return k.storage.DeleteSnapshots(keepAddress, signer)
+}
+
 func (k *Keeps) SnapshotSigner(
 	keepAddress common.Address,
 	signer *tss.ThresholdSigner,
 ) error {
-	return k.storage.snapshot(keepAddress, signer)
+	return k.storage.Snapshot(keepAddress, signer)
 }
 
 // UnregisterKeep archives threeshold signer info for the given keep address.
@@ -71,7 +153,18 @@ func (k *Keeps) UnregisterKeep(keepAddress common.Address) {
 	k.myKeepsMutex.Lock()
 	defer k.myKeepsMutex.Unlock()
 
-	err := k.storage.archive(keepAddress.String())
+	// The archived keep is recorded before the signer is archived so that
+	// the keep can not get lost if the client is stopped in between.
+	k.archivedKeeps[keepAddress] = true
+	archivedKeeps := make([]common.Address, 0, len(k.archivedKeeps))
+	for archivedKeep := range k.archivedKeeps {
+		archivedKeeps = append(archivedKeeps, archivedKeep)
+	}
+	if err := k.storage.SaveArchivedKeeps(archivedKeeps); err != nil {
+		logger.Errorf("could not persist archived keeps: [%v]", err)
+	}
+
+	err := k.storage.Archive(keepAddress)
 	if err != nil {
 		logger.Errorf("could not archive keep to the storage: [%v]", err)
 	}
@@ -119,13 +212,57 @@ func (k *Keeps) GetKeepsAddresses() []common.Address {
 	return keepsAddresses
 }
 
-// LoadExistingKeeps iterates over all signers stored on disk and loads them
-// into memory
+// GetArchivedKeepsAddresses returns addresses of all keeps the client has been
+// a member of and which have been archived.
+func (k *Keeps) GetArchivedKeepsAddresses() []common.Address {
+	k.myKeepsMutex.RLock()
+	defer k.myKeepsMutex.RUnlock()
+
+	keepsAddresses := make([]common.Address, 0)
+
+	for keepAddress := range k.archivedKeeps {
+		// Archiving could have failed after the keep has been recorded
+		// as archived.
+		if _, isActive := k.myKeeps[keepAddress]; isActive {
+			continue
+		}
+
+		keepsAddresses = append(keepsAddresses, keepAddress)
+	}
+
+	return keepsAddresses
+}
+
+// LoadErrors returns errors of records which could not be loaded from the
+// storage by the last call to LoadExistingKeeps.
+func (k *Keeps) LoadErrors() []error {
+	k.myKeepsMutex.RLock()
+	defer k.myKeepsMutex.RUnlock()
+
+	return append([]error{}, k.loadErrors...)
+}
+
+// LoadExistingKeeps iterates over all signers stored in the storage and loads
+// them into memory
 func (k *Keeps) LoadExistingKeeps() {
 	k.myKeepsMutex.Lock()
 	defer k.myKeepsMutex.Unlock()
 
-	keepSignersChannel, errorsChannel := k.storage.readAll()
+	k.loadErrors = nil
+
+	archivedKeeps, err := k.storage.ReadArchivedKeeps()
+	if err != nil {
+		logger.Errorf("could not load archived keeps from storage: [%v]", err)
+		k.loadErrors = append(
+			k.loadErrors,
+			fmt.Errorf("could not load archived keeps: [%v]", err),
+		)
+	}
+	for _, keepAddress := range archivedKeeps {
+		k.archivedKeeps[keepAddress] = true
+	}
+
+	keepSignersChannel, errorsChannel := k.storage.ReadAll()
 
 	// Two goroutines read from signers and errors channels and either adds
 	// signers to the keeps registry or outputs an error to stderr.
@@ -136,18 +273,30 @@ func (k *Keeps) LoadExistingKeeps() {
 	var wg sync.WaitGroup
 	wg.Add(2)
 
+	var loadErrorsMutex sync.Mutex
+	recordLoadError := func(err error) {
+		loadErrorsMutex.Lock()
+		defer loadErrorsMutex.Unlock()
+
+		k.loadErrors = append(k.loadErrors, err)
+	}
+
 	go func() {
 		for keepSigner := range keepSignersChannel {
-			if _, exists := k.myKeeps[keepSigner.keepAddress]; exists {
+			if _, exists := k.myKeeps[keepSigner.KeepAddress]; exists {
 				logger.Errorf(
 					"signer for keep [%s] already loaded; "+
 						"possible duplicate in the storage layer",
-					keepSigner.keepAddress.String(),
+					keepSigner.KeepAddress.String(),
 				)
+				recordLoadError(fmt.Errorf(
+					"duplicate signer for keep [%s]",
+					keepSigner.KeepAddress.String(),
+				))
 				continue
 			}
 
-			k.myKeeps[keepSigner.keepAddress] = keepSigner.signer
+			k.myKeeps[keepSigner.KeepAddress] = keepSigner.Signer
 		}
 
 		wg.Done()
@@ -156,6 +305,7 @@ func (k *Keeps) LoadExistingKeeps() {
 	go func() {
 		for err := range errorsChannel {
 			logger.Errorf("could not load signer from storage: [%v]", err)
+			recordLoadError(err)
 		}
 
 		wg.Done()
@@ -28,7 +28,7 @@ var (
 
 func TestRegisterSigner(t *testing.T) {
 	persistenceMock := &persistenceHandleMock{}
-	kr := NewKeepsRegistry(persistenceMock)
+	kr := NewKeepsRegistry(NewDiskStorage(persistenceMock))
 
 	signer1, err := newTestSigner(0)
 	if err != nil {
@@ -74,7 +74,7 @@ func TestRegisterSigner(t *testing.T) {
 
 func TestRegisterSignerDuplicate(t *testing.T) {
 	persistenceMock := &persistenceHandleMock{}
-	kr := NewKeepsRegistry(persistenceMock)
+	kr := NewKeepsRegistry(NewDiskStorage(persistenceMock))
 
 	signer1, err := newTestSigner(0)
 	if err != nil {
@@ -102,7 +102,7 @@ func TestRegisterSignerDuplicate(t *testing.T) {
 
 func TestSnapshotSigner(t *testing.T) {
 	persistenceMock := &persistenceHandleMock{}
-	kr := NewKeepsRegistry(persistenceMock)
+	kr := NewKeepsRegistry(NewDiskStorage(persistenceMock))
 
 	signer1, err := newTestSigner(0)
 	if err != nil {
@@ -145,9 +145,73 @@ func TestSnapshotSigner(t *testing.T) {
 	}
 }
 
+func TestReplaceSigner(t *testing.T) {
+	persistenceMock := &persistenceHandleMock{}
+	kr := NewKeepsRegistry(NewDiskStorage(persistenceMock))
+
+	signer1, err := newTestSigner(0)
+	if err != nil {
+		t.Fatalf("failed to get signer: [%v]", err)
+	}
+
+	signer2, err := newTestSigner(1)
+	if err != nil {
+		t.Fatalf("failed to get signer: [%v]", err)
+	}
+
+	err = kr.ReplaceSigner(keepAddress1, signer2)
+	expectedError := fmt.Errorf(
+		"signer for keep [%s] not registered",
+		keepAddress1.String(),
+	)
+	if !reflect.DeepEqual(expectedError, err) {
+		t.Errorf(
+			"unexpected error\nexpected: [%v]\nactual:   [%v]",
+			expectedError,
+			err,
+		)
+	}
+
+	if err := kr.RegisterSigner(keepAddress1, signer1); err != nil {
+		t.Fatalf("failed to register signer: [%v]", err)
+	}
+
+	if err := kr.ReplaceSigner(keepAddress1, signer2); err != nil {
+		t.Fatalf("failed to replace signer: [%v]", err)
+	}
+
+	signer, err := kr.GetSigner(keepAddress1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signer != signer2 {
+		t.Errorf("signer has not been replaced")
+	}
+
+	expectedSignerBytes, err := signer2.Marshal()
+	if err != nil {
+		t.Fatalf("failed to marshal signer: [%v]", err)
+	}
+
+	if len(persistenceMock.persistedGroups) != 2 {
+		t.Fatalf(
+			"unexpected number of persisted groups\nexpected: [%d]\nactual:   [%d]",
+			2,
+			len(persistenceMock.persistedGroups),
+		)
+	}
+
+	if !reflect.DeepEqual(
+		expectedSignerBytes,
+		persistenceMock.persistedGroups[1].data,
+	) {
+		t.Errorf("replaced signer has not been persisted")
+	}
+}
+
 func TestUnregisterSigner(t *testing.T) {
 	persistenceMock := &persistenceHandleMock{}
-	kr := NewKeepsRegistry(persistenceMock)
+	kr := NewKeepsRegistry(NewDiskStorage(persistenceMock))
 
 	signer1, err := newTestSigner(0)
 	if err != nil {
@@ -178,9 +242,42 @@ func TestUnregisterSigner(t *testing.T) {
 	}
 }
 
+func TestUnregisterKeepRecordsArchivedKeep(t *testing.T) {
+	handle := newInMemoryPersistenceHandle()
+	kr := NewKeepsRegistry(NewDiskStorage(handle))
+
+	signer1, err := newTestSigner(0)
+	if err != nil {
+		t.Fatalf("failed to get signer: [%v]", err)
+	}
+
+	if err := kr.RegisterSigner(keepAddress1, signer1); err != nil {
+		t.Fatalf("failed to register signer: [%v]", err)
+	}
+
+	kr.UnregisterKeep(keepAddress1)
+
+	reloaded := NewKeepsRegistry(NewDiskStorage(handle))
+	reloaded.LoadExistingKeeps()
+
+	if len(reloaded.GetKeepsAddresses()) != 0 {
+		t.Errorf("archived keep should not be loaded as an active one")
+	}
+
+	expectedArchivedKeeps := []common.Address{keepAddress1}
+	archivedKeeps := reloaded.GetArchivedKeepsAddresses()
+	if !reflect.DeepEqual(expectedArchivedKeeps, archivedKeeps) {
+		t.Errorf(
+			"unexpected archived keeps\nexpected: [%v]\nactual:   [%v]",
+			expectedArchivedKeeps,
+			archivedKeeps,
+		)
+	}
+}
+
 func TestGetSigner(t *testing.T) {
 	persistenceMock := &persistenceHandleMock{}
-	kr := NewKeepsRegistry(persistenceMock)
+	kr := NewKeepsRegistry(NewDiskStorage(persistenceMock))
 
 	signers, err := testSigners()
 	if err != nil {
@@ -246,7 +343,7 @@ func TestLoadExistingGroups(t *testing.T) {
 	signer1 := signers[0]
 	signer2 := signers[1]
 
-	kr := NewKeepsRegistry(persistenceMock)
+	kr := NewKeepsRegistry(NewDiskStorage(persistenceMock))
 
 	if len(kr.GetKeepsAddresses()) != 0 {
 		t.Fatal("unexpected keeps number at start")
@@ -2,154 +2,131 @@ package registry
 
 import (
 	"fmt"
-	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/keep-network/keep-common/pkg/persistence"
+	"github.com/keep-network/keep-common/pkg/encryption"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
 )
 
-type storage interface {
-	save(keepAddress common.Address, signer *tss.ThresholdSigner) error
-	snapshot(keepAddress common.Address, signer *tss.ThresholdSigner) error
-	readAll() (<-chan *keepSigner, <-chan error)
-	archive(keepAddress string) error
+// Storage is the backend persisting signers of keeps the client is a member
+// of, together with the index of archived keeps, the journal of pending
+// operations, the pool of TSS pre-parameters and reliability records of peer
+// members.
+type Storage interface {
+	// Save persists the signer as the current signer of the keep.
+	Save(keepAddress common.Address, signer *tss.ThresholdSigner) error
+	// Snapshot persists the signer as a snapshot of the keep's signer. The
+	// snapshot is not loaded as a current signer but lets to recover the
+	// key share if the signer could not be saved.
+	Snapshot(keepAddress common.Address, signer *tss.ThresholdSigner) error
+	// ReadAll reads current signers of all keeps which are not archived.
+	// Both returned channels are closed once all signers are read.
+	ReadAll() (<-chan *KeepSigner, <-chan error)
+	// ReadSnapshots reads snapshots of signers of all keeps which are not
+	// archived. Both returned channels are closed once all snapshots are
+	// read.
+	ReadSnapshots() (<-chan *KeepSigner, <-chan error)
+	// DeleteSnapshots deletes snapshots of the keep holding the given signer.
+	// The current signer of the keep is never deleted. Deleting snapshots
+	// which do not exist is not an error.
+	DeleteSnapshots(keepAddress common.Address, signer *tss.ThresholdSigner) error
+	// Archive marks all data of the keep as archived. Archived signers are
+	// not returned from ReadAll.
+	Archive(keepAddress common.Address) error
+	// SaveArchivedKeeps persists the index of keeps archived by the client.
+	SaveArchivedKeeps(keepsAddresses []common.Address) error
+	// ReadArchivedKeeps reads the index of keeps archived by the client.
+	ReadArchivedKeeps() ([]common.Address, error)
+	// SaveJournal persists the serialized journal of pending operations of
+	// the keep, replacing the previous one.
+	SaveJournal(keepAddress common.Address, content []byte) error
+	// ReadJournals reads serialized journals of pending operations of all
+	// keeps which are not archived.
+	ReadJournals() (map[common.Address][]byte, error)
+	// SavePreParams persists serialized TSS pre-parameters under the given
+	// identifier.
+	SavePreParams(id string, content []byte) error
+	// ReadPreParams reads all persisted serialized TSS pre-parameters by
+	// their identifiers.
+	ReadPreParams() (map[string][]byte, error)
+	// DeletePreParams deletes persisted TSS pre-parameters with the given
+	// identifier. Deleting pre-parameters which do not exist is not an error.
+	DeletePreParams(id string) error
+	// SavePeerReliability persists serialized reliability records of peer
+	// members, replacing the previous ones.
+	SavePeerReliability(content []byte) error
+	// ReadPeerReliability reads serialized reliability records of peer
+	// members. It returns nil if no records have been persisted.
+	ReadPeerReliability() ([]byte, error)
+	// Close releases resources held by the storage.
+	Close() error
 }
 
-type persistentStorage struct {
-	handle persistence.Handle
+// KeepSigner is the signer of the keep read from the storage.
+type KeepSigner struct {
+	KeepAddress common.Address
+	Signer      *tss.ThresholdSigner
 }
 
-func newStorage(persistence persistence.Handle) storage {
-	return &persistentStorage{
-		handle: persistence,
-	}
-}
+// Storage backends which could be configured for the client.
+const (
+	// DiskBackend stores each keep in a separate directory on disk using
+	// keep-common persistence.
+	DiskBackend = "disk"
+	// BoltBackend stores all keeps in a single embedded bbolt database file.
+	BoltBackend = "bolt"
+)
 
-func (ps *persistentStorage) save(
-	keepAddress common.Address,
-	signer *tss.ThresholdSigner,
-) error {
-	signerBytes, err := signer.Marshal()
-	if err != nil {
-		return fmt.Errorf("failed to marshal signer: [%v]", err)
+// boltFileName is the name of the bbolt database file created in the data
+// directory.
+const boltFileName = "keep-ecdsa.db"
+
+// NewStorage creates storage of the given backend keeping data in the given
+// directory. Data are encrypted with the given key. Empty backend selects
+// the disk backend.
+func NewStorage(
+	backend string,
+	dataDir string,
+	key EncryptionKey,
+) (Storage, error) {
+	switch backend {
+	case "", DiskBackend:
+		return newEncryptedDiskStorage(dataDir, key)
+	case BoltBackend:
+		return NewBoltStorage(boltFilePath(dataDir), key)
+	default:
+		return nil, fmt.Errorf("unknown storage backend [%v]", backend)
 	}
-
-	return ps.handle.Save(
-		signerBytes,
-		keepAddress.String(),
-		// Take just the first 20 bytes of member ID so that we don't produce
-		// too long file names.
-		fmt.Sprintf("/membership_%.40s", signer.MemberID().String()),
-	)
 }
 
-func (ps *persistentStorage) snapshot(
-	keepAddress common.Address,
-	signer *tss.ThresholdSigner,
-) error {
-	signerBytes, err := signer.Marshal()
-	if err != nil {
-		return fmt.Errorf("failed to marshal signer: [%v]", err)
+// RotateEncryptionKey re-encrypts all records of the storage of the given
+// backend with the new key. Current, snapshot and archived signers are
+// re-encrypted and each of them is verified by unmarshalling it. Journals
+// and TSS pre-parameters are re-encrypted as well. All records are verified
+// before any of them is re-encrypted so the storage is not modified if any
+// of the records can not be read. Storage must not be used by the client
+// during the rotation. The number of re-encrypted records is returned.
+//
+// The rotation of the bolt storage is executed in a single transaction.
+// The disk storage stages all re-encrypted files before replacing the original
+// ones. If the rotation is interrupted while replacing files, it is completed
+// when the storage is opened with the new key or the rotation to the new key
+// is executed again.
+func RotateEncryptionKey(
+	backend string,
+	dataDir string,
+	currentKey EncryptionKey,
+	newKey EncryptionKey,
+) (int, error) {
+	currentBox := encryption.NewBox(currentKey)
+	newBox := encryption.NewBox(newKey)
+
+	switch backend {
+	case "", DiskBackend:
+		return rotateDiskEncryptionKey(dataDir, currentBox, newBox)
+	case BoltBackend:
+		return rotateBoltEncryptionKey(boltFilePath(dataDir), currentBox, newBox)
+	default:
+		return 0, fmt.Errorf("unknown storage backend [%v]", backend)
 	}
-
-	return ps.handle.Snapshot(
-		signerBytes,
-		keepAddress.String(),
-		// Take just the first 20 bytes of member ID so that we don't produce
-		// too long file names.
-		fmt.Sprintf("/membership_%.40s", signer.MemberID().String()),
-	)
-}
-
-type keepSigner struct {
-	keepAddress common.Address
-	signer      *tss.ThresholdSigner
-}
-
-func (ps *persistentStorage) readAll() (<-chan *keepSigner, <-chan error) {
-	outputKeepSigner := make(chan *keepSigner)
-	outputErrors := make(chan error)
-
-	inputData, inputErrors := ps.handle.ReadAll()
-
-	// We have two goroutines reading from data and errors channels at the same
-	// time. The reason for that is because we don't know in what order
-	// producers write information to channels.
-	// The third goroutine waits for those two goroutines to finish and it
-	// closes the output channels. Channels are not closed by two other goroutines
-	// because data goroutine writes both to output signers and errors
-	// channel and we want to avoid a situation when we close the errors channel
-	// and errors goroutine tries to write to it. The same the other way round.
-	var wg sync.WaitGroup
-	wg.Add(2)
-
-	// Close channels when signers and errors goroutines are done.
-	go func() {
-		wg.Wait()
-		close(outputKeepSigner)
-		close(outputErrors)
-	}()
-
-	// Errors goroutine - pass thru errors from input channel to output channel
-	// unchanged.
-	go func() {
-		for err := range inputErrors {
-			outputErrors <- err
-		}
-		wg.Done()
-	}()
-
-	// Signers goroutine reads data from input channel, tries to unmarshal
-	// the data to Signer and write the unmarshalled Signer to the output signers
-	// channel. In case of an error, goroutine writes that error to an output
-	// errors channel.
-	go func() {
-		for descriptor := range inputData {
-			content, err := descriptor.Content()
-			if err != nil {
-				outputErrors <- fmt.Errorf(
-					"failed to decode content from file [%v] in directory [%v]: [%v]",
-					descriptor.Name(),
-					descriptor.Directory(),
-					err,
-				)
-				continue
-			}
-
-			if !common.IsHexAddress(descriptor.Directory()) {
-				outputErrors <- fmt.Errorf(
-					"directory name [%v] is not valid ethereum address",
-					descriptor.Directory(),
-				)
-				continue
-			}
-			keepAddress := common.HexToAddress(descriptor.Directory())
-
-			signer := &tss.ThresholdSigner{}
-			err = signer.Unmarshal(content)
-			if err != nil {
-				outputErrors <- fmt.Errorf(
-					"failed to unmarshal signer from file [%v] in directory [%v]: [%v]",
-					descriptor.Name(),
-					descriptor.Directory(),
-					err,
-				)
-				continue
-			}
-
-			outputKeepSigner <- &keepSigner{
-				keepAddress: keepAddress,
-				signer:      signer,
-			}
-		}
-
-		wg.Done()
-	}()
-
-	return outputKeepSigner, outputErrors
-}
-
-func (ps *persistentStorage) archive(keepAddress string) error {
-	return ps.handle.Archive(keepAddress)
 }
@@ -0,0 +1,305 @@
+package registry
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestReadSnapshots(t *testing.T) {
+	for _, backend := range []string{DiskBackend, BoltBackend} {
+		t.Run(backend, func(t *testing.T) {
+			dataDir, err := ioutil.TempDir("", "snapshots-test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dataDir)
+
+			signers, err := testSigners()
+			if err != nil {
+				t.Fatalf("failed to get signers: [%v]", err)
+			}
+
+			storage, err := NewStorage(backend, dataDir, EncryptionKey{1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer storage.Close()
+
+			if err := storage.Save(keepAddress1, signers[0]); err != nil {
+				t.Fatal(err)
+			}
+			if err := storage.Snapshot(keepAddress2, signers[1]); err != nil {
+				t.Fatal(err)
+			}
+
+			signersChannel, errorsChannel := storage.ReadSnapshots()
+			snapshots := collectSigners(t, signersChannel, errorsChannel)
+
+			if len(snapshots) != 1 {
+				t.Fatalf(
+					"unexpected number of snapshots\nexpected: [%v]\nactual:   [%v]",
+					1,
+					len(snapshots),
+				)
+			}
+
+			if !reflect.DeepEqual(signers[1], snapshots[keepAddress2]) {
+				t.Errorf(
+					"unexpected snapshot\nexpected: [%v]\nactual:   [%v]",
+					signers[1],
+					snapshots[keepAddress2],
+				)
+			}
+		})
+	}
+}
+
+func TestDeleteSnapshots(t *testing.T) {
+	for _, backend := range []string{DiskBackend, BoltBackend} {
+		t.Run(backend, func(t *testing.T) {
+			dataDir, err := ioutil.TempDir("", "delete-snapshots-test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dataDir)
+
+			signers, err := testSigners()
+			if err != nil {
+				t.Fatalf("failed to get signers: [%v]", err)
+			}
+			oldSigner, refreshedSigner := signers[0], signers[1]
+
+			storage, err := NewStorage(backend, dataDir, EncryptionKey{1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer storage.Close()
+
+			// Follow the sequence of the key refresh.
+			if err := storage.Save(keepAddress1, oldSigner); err != nil {
+				t.Fatal(err)
+			}
+			if err := storage.Snapshot(keepAddress1, oldSigner); err != nil {
+				t.Fatal(err)
+			}
+			if err := storage.Snapshot(keepAddress1, refreshedSigner); err != nil {
+				t.Fatal(err)
+			}
+			if err := storage.Save(keepAddress1, refreshedSigner); err != nil {
+				t.Fatal(err)
+			}
+			if err := storage.Snapshot(keepAddress2, oldSigner); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := storage.DeleteSnapshots(keepAddress1, oldSigner); err != nil {
+				t.Fatal(err)
+			}
+			// Deleting snapshots which do not exist is not an error.
+			if err := storage.DeleteSnapshots(keepAddress1, oldSigner); err != nil {
+				t.Fatal(err)
+			}
+
+			remaining := make(map[common.Address]int)
+			signersChannel, errorsChannel := storage.ReadSnapshots()
+			for signersChannel != nil || errorsChannel != nil {
+				select {
+				case keepSigner, ok := <-signersChannel:
+					if !ok {
+						signersChannel = nil
+						continue
+					}
+					if reflect.DeepEqual(oldSigner, keepSigner.Signer) {
+						remaining[keepSigner.KeepAddress]++
+					}
+				case err, ok := <-errorsChannel:
+					if !ok {
+						errorsChannel = nil
+						continue
+					}
+					t.Errorf("unexpected error: [%v]", err)
+				}
+			}
+
+			if remaining[keepAddress1] != 0 {
+				t.Errorf(
+					"old signer should be deleted\nremaining snapshots: [%v]",
+					remaining[keepAddress1],
+				)
+			}
+			if remaining[keepAddress2] != 1 {
+				t.Errorf(
+					"snapshot of other keep should not be deleted\n"+
+						"remaining snapshots: [%v]",
+					remaining[keepAddress2],
+				)
+			}
+		})
+	}
+}
+
+func TestPreParams(t *testing.T) {
+	for _, backend := range []string{DiskBackend, BoltBackend} {
+		t.Run(backend, func(t *testing.T) {
+			dataDir, err := ioutil.TempDir("", "pre-params-test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dataDir)
+
+			storage, err := NewStorage(backend, dataDir, EncryptionKey{1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer storage.Close()
+
+			if err := storage.SavePreParams("1", []byte("{\"P\":1}")); err != nil {
+				t.Fatal(err)
+			}
+			if err := storage.SavePreParams("2", []byte("{\"P\":2}")); err != nil {
+				t.Fatal(err)
+			}
+
+			// Pre-parameters must not be loaded as signers.
+			if signers := readAllSigners(t, storage); len(signers) != 0 {
+				t.Errorf("pre-parameters should not be loaded as signers")
+			}
+
+			if err := storage.DeletePreParams("1"); err != nil {
+				t.Fatal(err)
+			}
+			// Deleting pre-parameters which do not exist is not an error.
+			if err := storage.DeletePreParams("3"); err != nil {
+				t.Fatal(err)
+			}
+
+			preParams, err := storage.ReadPreParams()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expectedPreParams := map[string][]byte{"2": []byte("{\"P\":2}")}
+			if !reflect.DeepEqual(expectedPreParams, preParams) {
+				t.Errorf(
+					"unexpected pre-parameters\nexpected: [%s]\nactual:   [%s]",
+					expectedPreParams,
+					preParams,
+				)
+			}
+		})
+	}
+}
+
+func TestPeerReliability(t *testing.T) {
+	for _, backend := range []string{DiskBackend, BoltBackend} {
+		t.Run(backend, func(t *testing.T) {
+			dataDir, err := ioutil.TempDir("", "peer-reliability-test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dataDir)
+
+			storage, err := NewStorage(backend, dataDir, EncryptionKey{1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer storage.Close()
+
+			content, err := storage.ReadPeerReliability()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if content != nil {
+				t.Errorf("unexpected records before saving: [%s]", content)
+			}
+
+			if err := storage.SavePeerReliability([]byte("{}")); err != nil {
+				t.Fatal(err)
+			}
+			if err := storage.SavePeerReliability([]byte("{\"a\":1}")); err != nil {
+				t.Fatal(err)
+			}
+
+			// Reliability records must not be loaded as signers.
+			if signers := readAllSigners(t, storage); len(signers) != 0 {
+				t.Errorf("reliability records should not be loaded as signers")
+			}
+
+			content, err = storage.ReadPeerReliability()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expectedContent := []byte("{\"a\":1}")
+			if !reflect.DeepEqual(expectedContent, content) {
+				t.Errorf(
+					"unexpected records\nexpected: [%s]\nactual:   [%s]",
+					expectedContent,
+					content,
+				)
+			}
+		})
+	}
+}
+
+func TestJournals(t *testing.T) {
+	for _, backend := range []string{DiskBackend, BoltBackend} {
+		t.Run(backend, func(t *testing.T) {
+			dataDir, err := ioutil.TempDir("", "journals-test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dataDir)
+
+			storage, err := NewStorage(backend, dataDir, EncryptionKey{1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer storage.Close()
+
+			journals, err := storage.ReadJournals()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(journals) != 0 {
+				t.Errorf("unexpected journals before saving: [%v]", journals)
+			}
+
+			keepAddress1 := common.HexToAddress("0x0000000000000000000000000000000000000001")
+			keepAddress2 := common.HexToAddress("0x0000000000000000000000000000000000000002")
+
+			if err := storage.SaveJournal(keepAddress1, []byte("{}")); err != nil {
+				t.Fatal(err)
+			}
+			if err := storage.SaveJournal(keepAddress2, []byte("{\"a\":1}")); err != nil {
+				t.Fatal(err)
+			}
+			if err := storage.SaveArchivedKeeps(
+				[]common.Address{keepAddress1},
+			); err != nil {
+				t.Fatal(err)
+			}
+
+			journals, err = storage.ReadJournals()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expectedJournals := map[common.Address][]byte{
+				keepAddress1: []byte("{}"),
+				keepAddress2: []byte("{\"a\":1}"),
+			}
+			if !reflect.DeepEqual(expectedJournals, journals) {
+				t.Errorf(
+					"unexpected journals\nexpected: [%s]\nactual:   [%s]",
+					expectedJournals,
+					journals,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,320 @@
+package registry
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+)
+
+// Statuses of keeps in the verification report.
+const (
+	// KeepHealthy means the signer of the keep is consistent with the chain.
+	KeepHealthy = "healthy"
+	// KeepCorrupted means the threshold key of the signer is not internally
+	// consistent.
+	KeepCorrupted = "corrupted"
+	// KeepOrphaned means the signer is stored locally but the keep on-chain
+	// does not have the operator as a member or has a different public key.
+	KeepOrphaned = "orphaned"
+	// KeepArchivedButActive means the signer of the keep has been archived
+	// but the keep is still active on-chain.
+	KeepArchivedButActive = "archived-but-active"
+	// KeepActiveButMissing means the operator is a member of the keep active
+	// on-chain but there is no signer for it stored locally.
+	KeepActiveButMissing = "active-but-missing"
+)
+
+// SignerChain is the chain interface used to validate signers of keeps.
+type SignerChain interface {
+	GetMembers(keepAddress common.Address) ([]common.Address, error)
+	GetPublicKey(keepAddress common.Address) ([]uint8, error)
+}
+
+// VerificationChain is the chain interface used to verify signers of keeps.
+type VerificationChain interface {
+	SignerChain
+
+	GetKeepCount() (*big.Int, error)
+	GetKeepAtIndex(keepIndex *big.Int) (common.Address, error)
+	IsActive(keepAddress common.Address) (bool, error)
+}
+
+// SignerValidationError is returned when the signer of the keep is not
+// consistent with its threshold key or with the keep on-chain. The status
+// is the status of the keep in the verification report.
+type SignerValidationError struct {
+	Status  string
+	Details string
+}
+
+func (sve *SignerValidationError) Error() string {
+	return sve.Details
+}
+
+// ValidateSigner checks if the signer of the keep is internally consistent,
+// if the operator is a member of the keep and if the public key of the signer
+// matches the keep public key. If allowMissingPublicKey is set, the public key
+// is compared only if it has already been submitted on-chain. Inconsistencies
+// are returned as SignerValidationError, failures of the chain as other errors.
+func ValidateSigner(
+	chain SignerChain,
+	keepAddress common.Address,
+	signer *tss.ThresholdSigner,
+	operator common.Address,
+	allowMissingPublicKey bool,
+) error {
+	if err := signer.VerifyKey(); err != nil {
+		return &SignerValidationError{KeepCorrupted, err.Error()}
+	}
+
+	members, err := chain.GetMembers(keepAddress)
+	if err != nil {
+		return &SignerValidationError{
+			KeepOrphaned,
+			fmt.Sprintf("could not get keep members: [%v]", err),
+		}
+	}
+
+	if !containsAddress(members, operator) {
+		return &SignerValidationError{
+			KeepOrphaned,
+			"operator is not a member of the keep",
+		}
+	}
+
+	publicKey, err := eth.SerializePublicKey(signer.PublicKey())
+	if err != nil {
+		return &SignerValidationError{
+			KeepCorrupted,
+			fmt.Sprintf("could not serialize public key: [%v]", err),
+		}
+	}
+
+	keepPublicKey, err := chain.GetPublicKey(keepAddress)
+	if err != nil {
+		return fmt.Errorf(
+			"could not get public key of keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+	}
+
+	if bytes.Equal(keepPublicKey, publicKey[:]) {
+		return nil
+	}
+
+	if isEmptyPublicKey(keepPublicKey) {
+		if allowMissingPublicKey {
+			return nil
+		}
+
+		return &SignerValidationError{
+			KeepOrphaned,
+			"public key not submitted on-chain",
+		}
+	}
+
+	return &SignerValidationError{
+		KeepOrphaned,
+		fmt.Sprintf(
+			"signer public key [%x] does not match keep public key [%x]",
+			publicKey,
+			keepPublicKey,
+		),
+	}
+}
+
+// KeepReport is the result of the verification of a single keep.
+type KeepReport struct {
+	KeepAddress common.Address
+	Status      string
+	Details     string
+}
+
+// VerificationReport is the result of the verification of all keeps.
+type VerificationReport struct {
+	Keeps []*KeepReport
+	// LoadErrors are errors of records which could not be loaded from the
+	// storage at all; they are reported as corrupted.
+	LoadErrors []error
+}
+
+// IsHealthy returns true if all records were loaded and all keeps are
+// healthy.
+func (vr *VerificationReport) IsHealthy() bool {
+	if len(vr.LoadErrors) > 0 {
+		return false
+	}
+
+	for _, keep := range vr.Keeps {
+		if keep.Status != KeepHealthy {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Verify checks signers of keeps loaded from the storage against their
+// threshold keys and the chain. Signers are expected to be loaded with
+// LoadExistingKeeps before. To find active keeps for which there is no
+// signer stored, the given number of the most recently created keeps is
+// scanned; if the number is zero, all keeps are scanned.
+func (k *Keeps) Verify(
+	chain VerificationChain,
+	operator common.Address,
+	keepsToScan int64,
+) (*VerificationReport, error) {
+	report := &VerificationReport{
+		LoadErrors: k.LoadErrors(),
+	}
+
+	for _, keepAddress := range k.GetKeepsAddresses() {
+		signer, err := k.GetSigner(keepAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		keepReport := &KeepReport{KeepAddress: keepAddress}
+		report.Keeps = append(report.Keeps, keepReport)
+
+		err = ValidateSigner(chain, keepAddress, signer, operator, false)
+		if validationErr, ok := err.(*SignerValidationError); ok {
+			keepReport.Status = validationErr.Status
+			keepReport.Details = validationErr.Details
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		keepReport.Status = KeepHealthy
+	}
+
+	for _, keepAddress := range k.GetArchivedKeepsAddresses() {
+		isActive, err := chain.IsActive(keepAddress)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not check if keep [%s] is active: [%v]",
+				keepAddress.String(),
+				err,
+			)
+		}
+
+		if isActive {
+			report.Keeps = append(report.Keeps, &KeepReport{
+				KeepAddress: keepAddress,
+				Status:      KeepArchivedButActive,
+				Details:     "keep is active on-chain",
+			})
+		}
+	}
+
+	missingKeeps, err := k.findMissingKeeps(chain, operator, keepsToScan)
+	if err != nil {
+		return nil, err
+	}
+	report.Keeps = append(report.Keeps, missingKeeps...)
+
+	sort.Slice(report.Keeps, func(i, j int) bool {
+		return bytes.Compare(
+			report.Keeps[i].KeepAddress.Bytes(),
+			report.Keeps[j].KeepAddress.Bytes(),
+		) < 0
+	})
+
+	return report, nil
+}
+
+func (k *Keeps) findMissingKeeps(
+	chain VerificationChain,
+	operator common.Address,
+	keepsToScan int64,
+) ([]*KeepReport, error) {
+	keepCount, err := chain.GetKeepCount()
+	if err != nil {
+		return nil, fmt.Errorf("could not get keep count: [%v]", err)
+	}
+
+	firstIndex := int64(0)
+	if keepsToScan > 0 && keepCount.Int64() > keepsToScan {
+		firstIndex = keepCount.Int64() - keepsToScan
+	}
+
+	archivedKeeps := make(map[common.Address]bool)
+	for _, keepAddress := range k.GetArchivedKeepsAddresses() {
+		archivedKeeps[keepAddress] = true
+	}
+
+	var reports []*KeepReport
+	for index := keepCount.Int64() - 1; index >= firstIndex; index-- {
+		keepIndex := big.NewInt(index)
+		keepAddress, err := chain.GetKeepAtIndex(keepIndex)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not get keep at index [%v]: [%v]",
+				keepIndex,
+				err,
+			)
+		}
+
+		if k.HasSigner(keepAddress) || archivedKeeps[keepAddress] {
+			continue
+		}
+
+		members, err := chain.GetMembers(keepAddress)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not get members of keep [%s]: [%v]",
+				keepAddress.String(),
+				err,
+			)
+		}
+
+		if !containsAddress(members, operator) {
+			continue
+		}
+
+		isActive, err := chain.IsActive(keepAddress)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not check if keep [%s] is active: [%v]",
+				keepAddress.String(),
+				err,
+			)
+		}
+
+		if isActive {
+			reports = append(reports, &KeepReport{
+				KeepAddress: keepAddress,
+				Status:      KeepActiveButMissing,
+				Details:     "no signer stored for the keep",
+			})
+		}
+	}
+
+	return reports, nil
+}
+
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
+
+func isEmptyPublicKey(publicKey []byte) bool {
+	for _, b := range publicKey {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
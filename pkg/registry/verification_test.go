@@ -0,0 +1,109 @@
+package registry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/local"
+)
+
+func TestVerify(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	chain := local.Connect(ctx)
+	operator := chain.Address()
+	otherMember := common.HexToAddress("0x65ea55c1f10491038425725dc00dffeab2a1e28a")
+	keepAddress4 := common.HexToAddress("0xb58fbff9b9c4a8b4a4a4a9ba0b4e8a4e1a8e4c2f")
+
+	handle := newInMemoryPersistenceHandle()
+	keepsRegistry := NewKeepsRegistry(NewDiskStorage(handle))
+
+	signer, err := newTestSigner(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey, err := eth.SerializePublicKey(signer.PublicKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// healthy keep
+	chain.OpenKeep(keepAddress1, []common.Address{operator, otherMember})
+	if err := chain.SubmitKeepPublicKey(keepAddress1, publicKey); err != nil {
+		t.Fatal(err)
+	}
+	if err := keepsRegistry.RegisterSigner(keepAddress1, signer); err != nil {
+		t.Fatal(err)
+	}
+
+	// orphaned keep; the operator is not a member
+	chain.OpenKeep(keepAddress2, []common.Address{otherMember})
+	if err := keepsRegistry.RegisterSigner(keepAddress2, signer); err != nil {
+		t.Fatal(err)
+	}
+
+	// active keep with no signer stored
+	chain.OpenKeep(keepAddress3, []common.Address{operator, otherMember})
+
+	// archived keep which is still active
+	chain.OpenKeep(keepAddress4, []common.Address{operator, otherMember})
+	if err := keepsRegistry.RegisterSigner(keepAddress4, signer); err != nil {
+		t.Fatal(err)
+	}
+	keepsRegistry.UnregisterKeep(keepAddress4)
+
+	// corrupted record which cannot be loaded
+	if err := handle.Save([]byte{0x01}, "not-a-keep", "/membership_1"); err != nil {
+		t.Fatal(err)
+	}
+
+	loadedRegistry := NewKeepsRegistry(NewDiskStorage(handle))
+	loadedRegistry.LoadExistingKeeps()
+
+	report, err := loadedRegistry.Verify(chain, operator, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.IsHealthy() {
+		t.Errorf("report should not be healthy")
+	}
+
+	if len(report.LoadErrors) != 1 {
+		t.Errorf(
+			"unexpected number of load errors\nexpected: [%d]\nactual:   [%d]",
+			1,
+			len(report.LoadErrors),
+		)
+	}
+
+	expectedStatuses := map[common.Address]string{
+		keepAddress1: KeepHealthy,
+		keepAddress2: KeepOrphaned,
+		keepAddress3: KeepActiveButMissing,
+		keepAddress4: KeepArchivedButActive,
+	}
+
+	actualStatuses := make(map[common.Address]string)
+	for _, keepReport := range report.Keeps {
+		actualStatuses[keepReport.KeepAddress] = keepReport.Status
+	}
+
+	if len(actualStatuses) != len(expectedStatuses) {
+		t.Errorf("unexpected keeps in the report: [%v]", actualStatuses)
+	}
+	for keepAddress, expectedStatus := range expectedStatuses {
+		if actualStatuses[keepAddress] != expectedStatus {
+			t.Errorf(
+				"unexpected status of keep [%s]\nexpected: [%s]\nactual:   [%s]",
+				keepAddress.String(),
+				expectedStatus,
+				actualStatuses[keepAddress],
+			)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+// Package reliability contains the tracker of faults of peer members of keeps
+// the operator is a member of. Faults are recorded per operator address, so
+// the operator whose client causes protocols of keeps to fail can be told
+// apart from others.
+package reliability
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ipfs/go-log"
+)
+
+var logger = log.Logger("keep-reliability")
+
+// Fault is a kind of misbehaviour of a peer member recorded by the tracker.
+type Fault string
+
+const (
+	// MissedAnnouncement is recorded for members who have not announced their
+	// presence before the announce protocol timed out.
+	MissedAnnouncement Fault = "missed_announcement"
+	// MissedReadySignal is recorded for members who have not signalled their
+	// readiness before the readiness signaling protocol timed out.
+	MissedReadySignal Fault = "missed_ready_signal"
+	// KeyGenerationCulprit is recorded for members blamed by tss-lib for
+	// sending invalid messages during key generation.
+	KeyGenerationCulprit Fault = "keygen_culprit"
+	// SigningCulprit is recorded for members blamed by tss-lib for sending
+	// invalid messages during signing.
+	SigningCulprit Fault = "signing_culprit"
+)
+
+// Faults are all kinds of faults recorded by the tracker.
+var Faults = []Fault{
+	MissedAnnouncement,
+	MissedReadySignal,
+	KeyGenerationCulprit,
+	SigningCulprit,
+}
+
+// Peer is the reliability record of a peer operator.
+type Peer struct {
+	// Number of recorded faults of the peer by their kind.
+	Faults map[Fault]uint64 `json:"faults"`
+	// The most recent fault of the peer, the keep in which it occurred and
+	// the time at which it has been recorded.
+	LastFault     Fault          `json:"lastFault"`
+	LastFaultKeep common.Address `json:"lastFaultKeep"`
+	LastFaultAt   time.Time      `json:"lastFaultAt"`
+	// Evidence of the most recent fault of each kind proven by the protocol,
+	// such as the invalid message of a culprit blamed by tss-lib.
+	Evidence map[Fault]string `json:"evidence,omitempty"`
+}
+
+// Storage persists reliability records of peers, so they survive the client
+// restart.
+type Storage interface {
+	SavePeerReliability(content []byte) error
+	ReadPeerReliability() ([]byte, error)
+}
+
+// Tracker records faults of peer operators. Records are persisted in the
+// storage after each recorded fault.
+type Tracker struct {
+	mutex   sync.RWMutex
+	peers   map[common.Address]*Peer
+	storage Storage
+	now     func() time.Time
+}
+
+// NewTracker creates a new tracker of peer faults loading records persisted
+// in the provided storage. If storage is nil, records are not persisted.
+func NewTracker(storage Storage) *Tracker {
+	tracker := &Tracker{
+		peers:   make(map[common.Address]*Peer),
+		storage: storage,
+		now:     time.Now,
+	}
+
+	if err := tracker.load(); err != nil {
+		logger.Errorf("could not load peer reliability records: [%v]", err)
+	}
+
+	return tracker
+}
+
+func (t *Tracker) load() error {
+	if t.storage == nil {
+		return nil
+	}
+
+	content, err := t.storage.ReadPeerReliability()
+	if err != nil {
+		return err
+	}
+
+	if len(content) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(content, &t.peers); err != nil {
+		return fmt.Errorf("failed to unmarshal records: [%v]", err)
+	}
+
+	logger.Infof("loaded reliability records of [%d] peers", len(t.peers))
+
+	return nil
+}
+
+// Record records the fault of the given peers which occurred in the keep.
+func (t *Tracker) Record(
+	fault Fault,
+	keepAddress common.Address,
+	peers ...common.Address,
+) {
+	if len(peers) == 0 {
+		return
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for _, peerAddress := range peers {
+		t.record(fault, keepAddress, peerAddress, "")
+	}
+
+	if err := t.persist(); err != nil {
+		logger.Errorf("could not persist peer reliability records: [%v]", err)
+	}
+}
+
+// RecordWithEvidence records the fault of the given peer which occurred in
+// the keep together with the evidence proving it. The evidence is kept until
+// another fault of the same kind is recorded with an evidence.
+func (t *Tracker) RecordWithEvidence(
+	fault Fault,
+	keepAddress common.Address,
+	peerAddress common.Address,
+	evidence string,
+) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.record(fault, keepAddress, peerAddress, evidence)
+
+	if err := t.persist(); err != nil {
+		logger.Errorf("could not persist peer reliability records: [%v]", err)
+	}
+}
+
+// record records the fault of the peer. It has to be called with the mutex
+// locked.
+func (t *Tracker) record(
+	fault Fault,
+	keepAddress common.Address,
+	peerAddress common.Address,
+	evidence string,
+) {
+	peer, ok := t.peers[peerAddress]
+	if !ok {
+		peer = &Peer{Faults: make(map[Fault]uint64)}
+		t.peers[peerAddress] = peer
+	}
+
+	peer.Faults[fault]++
+	peer.LastFault = fault
+	peer.LastFaultKeep = keepAddress
+	peer.LastFaultAt = t.now()
+
+	if evidence != "" {
+		if peer.Evidence == nil {
+			peer.Evidence = make(map[Fault]string)
+		}
+		peer.Evidence[fault] = evidence
+
+		logger.Warningf(
+			"recorded fault [%s] of operator [%s] in keep [%s]; "+
+				"the operator has [%d] faults of this kind; evidence: [%s]",
+			fault,
+			peerAddress.String(),
+			keepAddress.String(),
+			peer.Faults[fault],
+			evidence,
+		)
+		return
+	}
+
+	logger.Warningf(
+		"recorded fault [%s] of operator [%s] in keep [%s]; "+
+			"the operator has [%d] faults of this kind",
+		fault,
+		peerAddress.String(),
+		keepAddress.String(),
+		peer.Faults[fault],
+	)
+}
+
+func (t *Tracker) persist() error {
+	if t.storage == nil {
+		return nil
+	}
+
+	content, err := json.Marshal(t.peers)
+	if err != nil {
+		return fmt.Errorf("failed to marshal records: [%v]", err)
+	}
+
+	return t.storage.SavePeerReliability(content)
+}
+
+// Peers returns copies of reliability records of all peers with at least
+// one recorded fault.
+func (t *Tracker) Peers() map[common.Address]Peer {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	peers := make(map[common.Address]Peer, len(t.peers))
+	for peerAddress, peer := range t.peers {
+		peerCopy := *peer
+		peerCopy.Faults = make(map[Fault]uint64, len(peer.Faults))
+		for fault, count := range peer.Faults {
+			peerCopy.Faults[fault] = count
+		}
+		if peer.Evidence != nil {
+			peerCopy.Evidence = make(map[Fault]string, len(peer.Evidence))
+			for fault, evidence := range peer.Evidence {
+				peerCopy.Evidence[fault] = evidence
+			}
+		}
+
+		peers[peerAddress] = peerCopy
+	}
+
+	return peers
+}
+
+// Total returns the number of recorded faults of the given kind of all peers.
+func (t *Tracker) Total(fault Fault) uint64 {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	total := uint64(0)
+	for _, peer := range t.peers {
+		total += peer.Faults[fault]
+	}
+
+	return total
+}
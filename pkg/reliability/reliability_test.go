@@ -0,0 +1,156 @@
+package reliability
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type testStorage struct {
+	content []byte
+}
+
+func (ts *testStorage) SavePeerReliability(content []byte) error {
+	ts.content = content
+	return nil
+}
+
+func (ts *testStorage) ReadPeerReliability() ([]byte, error) {
+	return ts.content, nil
+}
+
+func TestTrackerRecord(t *testing.T) {
+	tracker := NewTracker(nil)
+
+	now := time.Unix(1600000000, 0)
+	tracker.now = func() time.Time { return now }
+
+	keepAddress := common.HexToAddress("0x1")
+	peer1 := common.HexToAddress("0xA")
+	peer2 := common.HexToAddress("0xB")
+
+	tracker.Record(MissedAnnouncement, keepAddress, peer1, peer2)
+	tracker.Record(MissedReadySignal, keepAddress, peer1)
+	tracker.Record(KeyGenerationCulprit, keepAddress, peer1)
+	tracker.Record(KeyGenerationCulprit, keepAddress)
+
+	expectedPeers := map[common.Address]Peer{
+		peer1: {
+			Faults: map[Fault]uint64{
+				MissedAnnouncement:   1,
+				MissedReadySignal:    1,
+				KeyGenerationCulprit: 1,
+			},
+			LastFault:     KeyGenerationCulprit,
+			LastFaultKeep: keepAddress,
+			LastFaultAt:   now,
+		},
+		peer2: {
+			Faults: map[Fault]uint64{
+				MissedAnnouncement: 1,
+			},
+			LastFault:     MissedAnnouncement,
+			LastFaultKeep: keepAddress,
+			LastFaultAt:   now,
+		},
+	}
+
+	if peers := tracker.Peers(); !reflect.DeepEqual(expectedPeers, peers) {
+		t.Errorf(
+			"unexpected peers\nexpected: [%+v]\nactual:   [%+v]",
+			expectedPeers,
+			peers,
+		)
+	}
+
+	expectedTotals := map[Fault]uint64{
+		MissedAnnouncement:   2,
+		MissedReadySignal:    1,
+		KeyGenerationCulprit: 1,
+		SigningCulprit:       0,
+	}
+	for fault, expectedTotal := range expectedTotals {
+		if total := tracker.Total(fault); total != expectedTotal {
+			t.Errorf(
+				"unexpected total of [%s]\nexpected: [%d]\nactual:   [%d]",
+				fault,
+				expectedTotal,
+				total,
+			)
+		}
+	}
+}
+
+func TestTrackerPersistence(t *testing.T) {
+	storage := &testStorage{}
+
+	keepAddress := common.HexToAddress("0x1")
+	peer := common.HexToAddress("0xA")
+
+	tracker := NewTracker(storage)
+	tracker.Record(SigningCulprit, keepAddress, peer)
+	tracker.Record(SigningCulprit, keepAddress, peer)
+
+	restoredTracker := NewTracker(storage)
+
+	restoredPeer, ok := restoredTracker.Peers()[peer]
+	if !ok {
+		t.Fatalf("peer record has not been restored")
+	}
+
+	if restoredPeer.Faults[SigningCulprit] != 2 {
+		t.Errorf(
+			"unexpected number of restored faults\nexpected: [%d]\nactual:   [%d]",
+			2,
+			restoredPeer.Faults[SigningCulprit],
+		)
+	}
+
+	// Records returned from the tracker are copies.
+	restoredPeer.Faults[SigningCulprit] = 10
+	if total := restoredTracker.Total(SigningCulprit); total != 2 {
+		t.Errorf(
+			"unexpected total\nexpected: [%d]\nactual:   [%d]",
+			2,
+			total,
+		)
+	}
+}
+
+func TestTrackerRecordWithEvidence(t *testing.T) {
+	storage := &testStorage{}
+
+	keepAddress := common.HexToAddress("0x1")
+	peer := common.HexToAddress("0xA")
+
+	tracker := NewTracker(storage)
+	tracker.RecordWithEvidence(
+		KeyGenerationCulprit,
+		keepAddress,
+		peer,
+		"task [keygen], round [2]: [invalid share]",
+	)
+	tracker.Record(MissedReadySignal, keepAddress, peer)
+
+	expectedEvidence := map[Fault]string{
+		KeyGenerationCulprit: "task [keygen], round [2]: [invalid share]",
+	}
+
+	restoredPeer := NewTracker(storage).Peers()[peer]
+	if !reflect.DeepEqual(expectedEvidence, restoredPeer.Evidence) {
+		t.Errorf(
+			"unexpected evidence\nexpected: [%v]\nactual:   [%v]",
+			expectedEvidence,
+			restoredPeer.Evidence,
+		)
+	}
+	if restoredPeer.Faults[KeyGenerationCulprit] != 1 {
+		t.Errorf(
+			"unexpected number of faults\nexpected: [%d]\nactual:   [%d]",
+			1,
+			restoredPeer.Faults[KeyGenerationCulprit],
+		)
+	}
+}
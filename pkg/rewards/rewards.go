@@ -0,0 +1,191 @@
+// Package rewards contains the withdrawer of ETH rewards earned by the operator
+// as a member of keeps.
+package rewards
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ipfs/go-log"
+
+	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/utils"
+)
+
+var logger = log.Logger("keep-rewards")
+
+// pendingWithdrawalTimeout is the time after which the withdrawal submitted
+// for a keep is considered lost if the balance has not been withdrawn yet.
+// Until then, the keep is skipped to not submit the same withdrawal twice.
+const pendingWithdrawalTimeout = 1 * time.Hour
+
+// KeepsSource provides addresses of keeps the operator is a member of.
+type KeepsSource interface {
+	// GetKeepsAddresses returns addresses of active keeps.
+	GetKeepsAddresses() []common.Address
+	// GetArchivedKeepsAddresses returns addresses of keeps which are no longer
+	// active.
+	GetArchivedKeepsAddresses() []common.Address
+}
+
+// Withdrawer periodically checks the member ETH balance of the operator in all
+// keeps it is a member of, both active and archived, and withdraws the balance
+// to the beneficiary once it reaches the threshold.
+type Withdrawer struct {
+	chain     eth.Handle
+	keeps     KeepsSource
+	threshold *big.Int
+
+	mutex              sync.Mutex
+	pendingWithdrawals map[common.Address]time.Time
+	withdrawalsCount   uint64
+	withdrawnValue     *big.Int
+}
+
+// NewWithdrawer creates a new rewards withdrawer for keeps from the given
+// source. Balances lower than the threshold are not withdrawn.
+func NewWithdrawer(
+	chain eth.Handle,
+	keeps KeepsSource,
+	threshold *big.Int,
+) *Withdrawer {
+	return &Withdrawer{
+		chain:              chain,
+		keeps:              keeps,
+		threshold:          threshold,
+		pendingWithdrawals: make(map[common.Address]time.Time),
+		withdrawnValue:     big.NewInt(0),
+	}
+}
+
+// Start checks the balances right away and then periodically with the given
+// interval until the context is done. Checks which failed for any of the keeps
+// are retried according to the provided retry policy.
+func (w *Withdrawer) Start(
+	ctx context.Context,
+	checkInterval time.Duration,
+	retryPolicy utils.BackoffPolicy,
+) {
+	logger.Infof(
+		"starting rewards withdrawals with [%v] wei threshold "+
+			"and [%v] check interval",
+		w.threshold,
+		checkInterval,
+	)
+
+	go func() {
+		backoff := utils.NewBackoff(retryPolicy)
+
+		for {
+			if err := w.CheckBalances(); err != nil {
+				logger.Warningf(
+					"rewards balances check failed: [%v]; "+
+						"will retry after backoff",
+					err,
+				)
+				if err := backoff.Wait(ctx); err != nil {
+					return
+				}
+				continue
+			}
+
+			backoff.Reset()
+
+			select {
+			case <-time.After(checkInterval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// CheckBalances checks the member ETH balance of the operator in all keeps
+// and withdraws balances which reached the threshold. It returns an error if
+// the check failed for any of the keeps.
+func (w *Withdrawer) CheckBalances() error {
+	keeps := append(
+		w.keeps.GetKeepsAddresses(),
+		w.keeps.GetArchivedKeepsAddresses()...,
+	)
+
+	failedKeeps := 0
+	for _, keepAddress := range keeps {
+		if err := w.checkBalance(keepAddress); err != nil {
+			logger.Errorf(
+				"failed to withdraw rewards from keep [%s]: [%v]",
+				keepAddress.String(),
+				err,
+			)
+			failedKeeps++
+		}
+	}
+
+	if failedKeeps > 0 {
+		return fmt.Errorf("check failed for [%d] keeps", failedKeeps)
+	}
+
+	return nil
+}
+
+func (w *Withdrawer) checkBalance(keepAddress common.Address) error {
+	member := w.chain.Address()
+
+	balance, err := w.chain.GetMemberETHBalance(keepAddress, member)
+	if err != nil {
+		return err
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if balance.Cmp(w.threshold) < 0 {
+		delete(w.pendingWithdrawals, keepAddress)
+		return nil
+	}
+
+	if submittedAt, ok := w.pendingWithdrawals[keepAddress]; ok &&
+		time.Since(submittedAt) < pendingWithdrawalTimeout {
+		logger.Debugf(
+			"withdrawal of rewards from keep [%s] is still pending",
+			keepAddress.String(),
+		)
+		return nil
+	}
+
+	if err := w.chain.WithdrawMemberETHBalance(keepAddress, member); err != nil {
+		return err
+	}
+
+	w.pendingWithdrawals[keepAddress] = time.Now()
+	w.withdrawalsCount++
+	w.withdrawnValue.Add(w.withdrawnValue, balance)
+
+	logger.Infof(
+		"submitted withdrawal of [%v] wei of rewards from keep [%s]",
+		balance,
+		keepAddress.String(),
+	)
+
+	return nil
+}
+
+// WithdrawalsCount returns the number of withdrawals submitted so far.
+func (w *Withdrawer) WithdrawalsCount() uint64 {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	return w.withdrawalsCount
+}
+
+// WithdrawnValue returns the total value in wei of withdrawals submitted so far.
+func (w *Withdrawer) WithdrawnValue() *big.Int {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	return new(big.Int).Set(w.withdrawnValue)
+}
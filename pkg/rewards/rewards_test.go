@@ -0,0 +1,172 @@
+package rewards
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/local"
+)
+
+var (
+	activeKeepAddress   = common.HexToAddress("0x770a9E2F2Aa1eC2d3Ca916Fc3e6A55058A898632")
+	archivedKeepAddress = common.HexToAddress("0x8B3e2A8e7cBc1A96E2B6f1dD7C63a8B2A7C9Ea4d")
+
+	threshold = big.NewInt(1000)
+)
+
+func TestCheckBalances(t *testing.T) {
+	chain, withdrawer := newTestWithdrawer()
+
+	setBalance(t, chain, activeKeepAddress, big.NewInt(1500))
+	setBalance(t, chain, archivedKeepAddress, big.NewInt(2000))
+
+	if err := withdrawer.CheckBalances(); err != nil {
+		t.Fatal(err)
+	}
+
+	assertBalance(t, chain, activeKeepAddress, big.NewInt(0))
+	assertBalance(t, chain, archivedKeepAddress, big.NewInt(0))
+
+	if withdrawer.WithdrawalsCount() != 2 {
+		t.Errorf(
+			"unexpected number of withdrawals\nexpected: [%v]\nactual:   [%v]",
+			2,
+			withdrawer.WithdrawalsCount(),
+		)
+	}
+
+	expectedValue := big.NewInt(3500)
+	if withdrawer.WithdrawnValue().Cmp(expectedValue) != 0 {
+		t.Errorf(
+			"unexpected withdrawn value\nexpected: [%v]\nactual:   [%v]",
+			expectedValue,
+			withdrawer.WithdrawnValue(),
+		)
+	}
+}
+
+func TestCheckBalances_BelowThreshold(t *testing.T) {
+	chain, withdrawer := newTestWithdrawer()
+
+	setBalance(t, chain, activeKeepAddress, big.NewInt(999))
+
+	if err := withdrawer.CheckBalances(); err != nil {
+		t.Fatal(err)
+	}
+
+	assertBalance(t, chain, activeKeepAddress, big.NewInt(999))
+
+	if withdrawer.WithdrawalsCount() != 0 {
+		t.Errorf("balance below the threshold should not be withdrawn")
+	}
+}
+
+func TestCheckBalances_PendingWithdrawal(t *testing.T) {
+	chain, withdrawer := newTestWithdrawer()
+
+	setBalance(t, chain, activeKeepAddress, big.NewInt(1500))
+	if err := withdrawer.CheckBalances(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The balance is the same as before the withdrawal, as if the withdrawal
+	// transaction was not mined yet.
+	setBalance(t, chain, activeKeepAddress, big.NewInt(1500))
+	if err := withdrawer.CheckBalances(); err != nil {
+		t.Fatal(err)
+	}
+
+	assertBalance(t, chain, activeKeepAddress, big.NewInt(1500))
+
+	if withdrawer.WithdrawalsCount() != 1 {
+		t.Errorf(
+			"unexpected number of withdrawals\nexpected: [%v]\nactual:   [%v]",
+			1,
+			withdrawer.WithdrawalsCount(),
+		)
+	}
+}
+
+func TestCheckBalances_FailedKeep(t *testing.T) {
+	chain, withdrawer := newTestWithdrawer()
+
+	unknownKeepAddress := common.HexToAddress("0x1a2B3c4D5e6F7a8B9c0D1e2F3a4B5c6D7e8F9a0B")
+	withdrawer.keeps = &testKeepsSource{
+		active:   []common.Address{unknownKeepAddress, activeKeepAddress},
+		archived: []common.Address{archivedKeepAddress},
+	}
+
+	setBalance(t, chain, activeKeepAddress, big.NewInt(1500))
+
+	if err := withdrawer.CheckBalances(); err == nil {
+		t.Fatal("expected error for the keep which could not be checked")
+	}
+
+	// Other keeps are checked despite the failure.
+	assertBalance(t, chain, activeKeepAddress, big.NewInt(0))
+}
+
+func newTestWithdrawer() (local.Chain, *Withdrawer) {
+	chain := local.Connect(context.Background())
+
+	chain.OpenKeep(activeKeepAddress, []common.Address{chain.Address()})
+	chain.OpenKeep(archivedKeepAddress, []common.Address{chain.Address()})
+
+	keeps := &testKeepsSource{
+		active:   []common.Address{activeKeepAddress},
+		archived: []common.Address{archivedKeepAddress},
+	}
+
+	return chain, NewWithdrawer(chain, keeps, threshold)
+}
+
+func setBalance(
+	t *testing.T,
+	chain local.Chain,
+	keepAddress common.Address,
+	balance *big.Int,
+) {
+	if err := chain.SetMemberETHBalance(
+		keepAddress,
+		chain.Address(),
+		balance,
+	); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func assertBalance(
+	t *testing.T,
+	chain local.Chain,
+	keepAddress common.Address,
+	expected *big.Int,
+) {
+	actual, err := chain.GetMemberETHBalance(keepAddress, chain.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected.Cmp(actual) != 0 {
+		t.Errorf(
+			"unexpected balance of keep [%s]\nexpected: [%v]\nactual:   [%v]",
+			keepAddress.String(),
+			expected,
+			actual,
+		)
+	}
+}
+
+type testKeepsSource struct {
+	active   []common.Address
+	archived []common.Address
+}
+
+func (tks *testKeepsSource) GetKeepsAddresses() []common.Address {
+	return tks.active
+}
+
+func (tks *testKeepsSource) GetArchivedKeepsAddresses() []common.Address {
+	return tks.archived
+}
@@ -20,6 +20,11 @@ func DoWithRetry(
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
+	backoff := NewBackoff(BackoffPolicy{
+		InitialBackoff: backoffTime,
+		MaxBackoff:     backoffMax,
+	})
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -30,15 +35,9 @@ func DoWithRetry(
 				return nil
 			}
 
-			timedOut := backoffWait(ctx, backoffTime)
-			if timedOut {
+			if err := backoff.Wait(ctx); err != nil {
 				return fmt.Errorf("retry timeout [%v] exceeded", timeout)
 			}
-
-			backoffTime = calculateBackoff(
-				backoffTime,
-				backoffMax,
-			)
 		}
 	}
 }
@@ -88,6 +87,11 @@ func ConfirmWithTimeout(
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
+	backoff := NewBackoff(BackoffPolicy{
+		InitialBackoff: backoffTime,
+		MaxBackoff:     backoffMax,
+	})
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -101,15 +105,9 @@ func ConfirmWithTimeout(
 				return false, err
 			}
 
-			timedOut := backoffWait(ctx, backoffTime)
-			if timedOut {
+			if err := backoff.Wait(ctx); err != nil {
 				return false, nil
 			}
-
-			backoffTime = calculateBackoff(
-				backoffTime,
-				backoffMax,
-			)
 		}
 	}
 }
@@ -146,6 +144,50 @@ func ConfirmWithTimeoutDefaultBackoff(
 	)
 }
 
+// BackoffPolicy defines the exponential backoff applied between consecutive
+// retries of an operation. The first retry is delayed by InitialBackoff and
+// each next delay is doubled, with a jitter, up to MaxBackoff.
+type BackoffPolicy struct {
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Backoff tracks the delay before the next retry of an operation retried
+// according to the backoff policy. It is not safe for concurrent use; each
+// execution of the operation should use its own backoff.
+type Backoff struct {
+	policy BackoffPolicy
+	next   time.Duration
+}
+
+// NewBackoff creates a new backoff starting from the initial backoff of
+// the policy.
+func NewBackoff(policy BackoffPolicy) *Backoff {
+	return &Backoff{
+		policy: policy,
+		next:   policy.InitialBackoff,
+	}
+}
+
+// Wait blocks for the current backoff delay and increases the delay of
+// the next wait. It returns the context error as soon as the context is done,
+// without waiting for the rest of the delay.
+func (b *Backoff) Wait(ctx context.Context) error {
+	if timedOut := backoffWait(ctx, b.next); timedOut {
+		return ctx.Err()
+	}
+
+	b.next = calculateBackoff(b.next, b.policy.MaxBackoff)
+
+	return nil
+}
+
+// Reset makes the next wait start from the initial backoff of the policy
+// again. It should be called once the retried operation succeeds.
+func (b *Backoff) Reset() {
+	b.next = b.policy.InitialBackoff
+}
+
 func calculateBackoff(
 	backoffPrev time.Duration,
 	backoffMax time.Duration,
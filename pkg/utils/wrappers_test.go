@@ -223,3 +223,72 @@ func TestCalculateBackoffMax(t *testing.T) {
 		)
 	}
 }
+
+func TestBackoffWait(t *testing.T) {
+	backoff := NewBackoff(BackoffPolicy{
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     30 * time.Millisecond,
+	})
+
+	expectedMinWaits := []time.Duration{
+		10 * time.Millisecond,
+		20 * time.Millisecond,
+		30 * time.Millisecond,
+		30 * time.Millisecond,
+	}
+
+	for i, expectedMinWait := range expectedMinWaits {
+		start := time.Now()
+
+		if err := backoff.Wait(context.Background()); err != nil {
+			t.Fatalf("unexpected error: [%v]", err)
+		}
+
+		if wait := time.Since(start); wait < expectedMinWait {
+			t.Errorf(
+				"wait [%v] [%v] shorter than the expected minimum [%v]",
+				i,
+				wait,
+				expectedMinWait,
+			)
+		}
+	}
+
+	backoff.Reset()
+
+	if backoff.next != 10*time.Millisecond {
+		t.Errorf(
+			"expected backoff of [%v] after reset; has [%v]",
+			10*time.Millisecond,
+			backoff.next,
+		)
+	}
+}
+
+func TestBackoffWaitContextDone(t *testing.T) {
+	backoff := NewBackoff(BackoffPolicy{
+		InitialBackoff: time.Minute,
+		MaxBackoff:     time.Minute,
+	})
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		50*time.Millisecond,
+	)
+	defer cancel()
+
+	start := time.Now()
+
+	err := backoff.Wait(ctx)
+	if err != context.DeadlineExceeded {
+		t.Errorf(
+			"unexpected error\nexpected: [%v]\nactual:   [%v]",
+			context.DeadlineExceeded,
+			err,
+		)
+	}
+
+	if wait := time.Since(start); wait > time.Second {
+		t.Errorf("wait [%v] did not stop when the context was done", wait)
+	}
+}